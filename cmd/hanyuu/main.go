@@ -22,6 +22,7 @@ import (
 	"github.com/R-a-dio/valkyrie/telemetry"
 	"github.com/R-a-dio/valkyrie/tracker"
 	"github.com/R-a-dio/valkyrie/util/graceful"
+	"github.com/R-a-dio/valkyrie/webhook"
 	"github.com/R-a-dio/valkyrie/website"
 	"github.com/google/subcommands"
 	"github.com/rs/zerolog"
@@ -222,6 +223,15 @@ var listenerTrackerCmd = cmd{
 	execute: withConfig(tracker.Execute),
 }
 
+var webhookCmd = cmd{
+	name:     "webhook",
+	synopsis: "runs the webhook publisher",
+	usage: `webhook:
+	run the webhook publisher
+	`,
+	execute: withConfig(webhook.Execute),
+}
+
 func main() {
 	// setup configuration file as top-level flag
 	flag.StringVar(&configFile, "config", "hanyuu.toml", "filepath to configuration file")
@@ -246,6 +256,7 @@ func main() {
 	subcommands.Register(balancerCmd, "")
 	subcommands.Register(proxyCmd, "")
 	subcommands.Register(listenerTrackerCmd, "")
+	subcommands.Register(webhookCmd, "")
 
 	subcommands.Register(listenerLogCmd, "jobs")
 	subcommands.Register(requestCountCmd, "jobs")
@@ -12,6 +12,7 @@ import (
 func init() {
 	subcommands.Register(streamerCmd, "")
 	subcommands.Register(verifierCmd, "jobs")
+	subcommands.Register(loudnessCmd, "jobs")
 }
 
 var streamerCmd = cmd{
@@ -32,3 +33,13 @@ var verifierCmd = cmd{
 	`,
 	execute: withConfig(jobs.ExecuteVerifier),
 }
+
+var loudnessCmd = cmd{
+	name:     "loudness",
+	synopsis: "analyzes the loudness of tracks that have not been analyzed yet",
+	usage: `loudness:
+	runs a loudness analysis with ffmpeg on all usable tracks that don't
+	have one stored yet, so that the streamer can skip it during playback
+	`,
+	execute: withConfig(jobs.ExecuteLoudness),
+}
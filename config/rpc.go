@@ -36,6 +36,11 @@ func (m *managerService) CurrentStatus(ctx context.Context) (eventstream.Stream[
 	return m.fn().CurrentStatus(ctx)
 }
 
+// Events implements radio.ManagerService.
+func (m *managerService) Events(ctx context.Context) (eventstream.Stream[radio.ManagerEvent], error) {
+	return m.fn().Events(ctx)
+}
+
 // CurrentThread implements radio.ManagerService.
 func (m *managerService) CurrentThread(ctx context.Context) (eventstream.Stream[string], error) {
 	return m.fn().CurrentThread(ctx)
@@ -86,8 +91,8 @@ func (s *streamerService) Queue(ctx context.Context) ([]radio.QueueEntry, error)
 }
 
 // RequestSong implements radio.StreamerService.
-func (s *streamerService) RequestSong(ctx context.Context, song radio.Song, identifier string) error {
-	return s.fn().RequestSong(ctx, song, identifier)
+func (s *streamerService) RequestSong(ctx context.Context, song radio.Song, requester radio.Requester) error {
+	return s.fn().RequestSong(ctx, song, requester)
 }
 
 // Start implements radio.StreamerService.
@@ -100,6 +105,11 @@ func (s *streamerService) Stop(ctx context.Context, force bool) error {
 	return s.fn().Stop(ctx, force)
 }
 
+// Skip implements radio.StreamerService.
+func (s *streamerService) Skip(ctx context.Context) error {
+	return s.fn().Skip(ctx)
+}
+
 func newQueueService(cfg Config, conn func() *grpc.ClientConn) radio.QueueService {
 	return &queueService{
 		Value(cfg, func(c Config) radio.QueueService {
@@ -122,11 +132,31 @@ func (q *queueService) Entries(ctx context.Context) ([]radio.QueueEntry, error)
 	return q.fn().Entries(ctx)
 }
 
+// Events implements radio.QueueService.
+func (q *queueService) Events(ctx context.Context) (eventstream.Stream[radio.QueueEvent], error) {
+	return q.fn().Events(ctx)
+}
+
 // Remove implements radio.QueueService.
 func (q *queueService) Remove(ctx context.Context, id radio.QueueID) (bool, error) {
 	return q.fn().Remove(ctx, id)
 }
 
+// Move implements radio.QueueService.
+func (q *queueService) Move(ctx context.Context, id radio.QueueID, position int) (bool, error) {
+	return q.fn().Move(ctx, id, position)
+}
+
+// InsertAt implements radio.QueueService.
+func (q *queueService) InsertAt(ctx context.Context, song radio.Song, position int) error {
+	return q.fn().InsertAt(ctx, song, position)
+}
+
+// Promote implements radio.QueueService.
+func (q *queueService) Promote(ctx context.Context, id radio.QueueID) (bool, error) {
+	return q.fn().Promote(ctx, id)
+}
+
 // ReserveNext implements radio.QueueService.
 func (q *queueService) ReserveNext(ctx context.Context) (*radio.QueueEntry, error) {
 	return q.fn().ReserveNext(ctx)
@@ -176,7 +206,54 @@ func (i *ircService) AnnounceRequest(ctx context.Context, song radio.Song) error
 	return i.fn().AnnounceRequest(ctx, song)
 }
 
+// AnnounceStaff implements radio.AnnounceService.
+func (i *ircService) AnnounceStaff(ctx context.Context, message string) error {
+	return i.fn().AnnounceStaff(ctx, message)
+}
+
 // AnnounceSong implements radio.AnnounceService.
 func (i *ircService) AnnounceSong(ctx context.Context, status radio.Status) error {
 	return i.fn().AnnounceSong(ctx, status)
 }
+
+func newProxyService(cfg Config) radio.ProxyService {
+	return &proxyService{
+		Value(cfg, func(c Config) radio.ProxyService {
+			return rpc.NewProxyService(rpc.PrepareConn(cfg.Conf().Proxy.RPCAddr))
+		}),
+	}
+}
+
+type proxyService struct {
+	fn func() radio.ProxyService
+}
+
+// ListSources implements radio.ProxyService.
+func (p *proxyService) ListSources(ctx context.Context) ([]radio.ProxySource, error) {
+	return p.fn().ListSources(ctx)
+}
+
+// ListUpstreams implements radio.ProxyService.
+func (p *proxyService) ListUpstreams(ctx context.Context) ([]radio.ProxyUpstream, error) {
+	return p.fn().ListUpstreams(ctx)
+}
+
+// KickSource implements radio.ProxyService.
+func (p *proxyService) KickSource(ctx context.Context, id radio.SourceID) error {
+	return p.fn().KickSource(ctx, id)
+}
+
+// SetLive implements radio.ProxyService.
+func (p *proxyService) SetLive(ctx context.Context, id radio.SourceID) error {
+	return p.fn().SetLive(ctx, id)
+}
+
+// SetPriorities implements radio.ProxyService.
+func (p *proxyService) SetPriorities(ctx context.Context, mount string, order []radio.SourceID) error {
+	return p.fn().SetPriorities(ctx, mount, order)
+}
+
+// Events implements radio.ProxyService.
+func (p *proxyService) Events(ctx context.Context) (eventstream.Stream[radio.ProxyEvent], error) {
+	return p.fn().Events(ctx)
+}
@@ -360,6 +360,13 @@ const (
 	DirtyMigration                     // indicates the migration failed to apply and is in a dirty state
 	MigrationNotApplied                // indicates not all migrations were applied
 	LoginError                         // Login error
+	BlockUnknown                       // Programming block does not exist
+	RequestsLimited                    // Requests are limited by the global rate
+	SourceUnknown                      // Proxy source does not exist
+	MountUnknown                       // Proxy mount does not exist
+	SourceFormat                       // Proxy source uses a different audio format than the mount
+	StreamKeyUnknown                   // Stream key does not exist
+	RecordingUnknown                   // Recording does not exist
 )
 
 func (k Kind) String() string {
@@ -436,6 +443,20 @@ func (k Kind) String() string {
 		return "migration needs to be applied"
 	case LoginError:
 		return "login error"
+	case BlockUnknown:
+		return "unknown programming block"
+	case RequestsLimited:
+		return "requests are rate limited"
+	case SourceUnknown:
+		return "unknown proxy source"
+	case MountUnknown:
+		return "unknown proxy mount"
+	case SourceFormat:
+		return "mismatching proxy source format"
+	case StreamKeyUnknown:
+		return "unknown stream key"
+	case RecordingUnknown:
+		return "unknown recording"
 	}
 
 	return "unknown error kind"
@@ -229,3 +229,13 @@ func (ann *announceService) AnnounceRequest(ctx context.Context, song radio.Song
 
 	return nil
 }
+
+func (ann *announceService) AnnounceStaff(ctx context.Context, message string) error {
+	channel := ann.Conf().IRC.StaffChannel
+	if channel == "" {
+		channel = ann.Conf().IRC.MainChannel
+	}
+
+	ann.bot.c.Cmd.Message(channel, Fmt("{red}%s", message))
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/policy"
 	"github.com/lrstanley/girc"
 	"github.com/rs/zerolog"
 )
@@ -24,6 +25,7 @@ var (
 	reThread          = "thread( (?P<thread>.+))?"
 	reTopic           = "topic( (?P<topic>.+))?"
 	reKill            = "kill( (?P<force>force))?"
+	reSkip            = "skip$"
 	reRandomRequest   = "ra(ndom)?( ((?P<isFave>f(ave)?)( (?P<Nick>.+))?|(?P<Query>.+)))?"
 	reLuckyRequest    = "l(ucky)? (?P<Query>.+)"
 	reSearch          = "s(earch)? ((?P<TrackID>[0-9]+)|(?P<Query>.+))"
@@ -97,6 +99,8 @@ func (rh RegexHandlers) Execute(c *girc.Client, e girc.Event) {
 				event.Echo("Your search returned no results")
 			case errors.Is(errors.UserCooldown, err):
 				fallthrough
+			case errors.Is(errors.RequestsLimited, err):
+				fallthrough
 			case errors.Is(errors.SongCooldown, err):
 				event.Echo(CooldownMessageFromError(err))
 			default:
@@ -120,6 +124,7 @@ var reHandlers = []RegexHandler{
 	{reThread, ThreadURL},
 	{reTopic, ChannelTopic},
 	{reKill, KillStreamer},
+	{reSkip, SkipTrack},
 	{reRandomRequest, RandomTrackRequest},
 	{reLuckyRequest, LuckyTrackRequest},
 	{reSearch, SearchTrack},
@@ -225,6 +230,23 @@ func (e Event) ArgumentTrack(key string) (*radio.Song, error) {
 	return track, nil
 }
 
+// Requester returns the requester that caused this event, the requester is
+// only staff if they have channel access and are identified with nickserv as
+// a user with the DJ permission
+func (e Event) Requester() radio.Requester {
+	var user *radio.User
+	if HasAccess(e.Client, e.Event) && IsAuthed(e) {
+		// not finding a user just means they aren't staff
+		user, _ = e.Storage.User(e.Ctx).ByNick(e.Source.Name)
+	}
+	return radio.NewRequester(e.Source.Host, user)
+}
+
+// RequestPolicy returns the request policy to use for request related checks
+func (e Event) RequestPolicy() policy.RequestPolicy {
+	return policy.NewRequestPolicy(e.Bot.Config)
+}
+
 // CurrentTrack returns the currently playing song on the main stream configured
 func (e Event) CurrentTrack() (*radio.Song, error) {
 	const op errors.Op = "irc/Event.CurrentTrack"
@@ -432,6 +432,26 @@ func KillStreamer(e Event) error {
 	return nil
 }
 
+func SkipTrack(e Event) error {
+	const op errors.Op = "irc/SkipTrack"
+
+	if !HasStreamAccess(e.Client, e.Event) {
+		return nil
+	}
+
+	err := e.Bot.Streamer.Skip(e.Ctx)
+	if err != nil {
+		if errors.Is(errors.StreamerNotRunning, err) {
+			e.Echo("The streamer isn't running")
+			return nil
+		}
+		return errors.E(op, err)
+	}
+
+	e.EchoPublic("Skipping the current song")
+	return nil
+}
+
 func RandomTrackRequest(e Event) error {
 	const op errors.Op = "irc/RandomTrackRequest"
 
@@ -477,6 +497,7 @@ func RandomTrackRequest(e Event) error {
 	}
 
 	rand := config.NewRand(false)
+	policy := e.RequestPolicy()
 
 	// select songs randomly of what we have
 	for len(songs) > 0 {
@@ -487,12 +508,12 @@ func RandomTrackRequest(e Event) error {
 		songs = songs[:len(songs)-1]
 
 		// can the song be requested
-		if !song.Requestable() {
+		if _, ok := policy.SongCooldown(song); !ok {
 			continue
 		}
 
 		// try requesting the song
-		err = e.Bot.Streamer.RequestSong(e.Ctx, song, e.Source.Host)
+		err = e.Bot.Streamer.RequestSong(e.Ctx, song, e.Requester())
 		if err == nil {
 			// finished and requested a song successfully
 			return nil
@@ -526,12 +547,13 @@ func LuckyTrackRequest(e Event) error {
 		return errors.E(op, err)
 	}
 
+	policy := e.RequestPolicy()
 	for _, song := range res.Songs {
-		if !song.Requestable() {
+		if _, ok := policy.SongCooldown(song); !ok {
 			continue
 		}
 
-		err = e.Bot.Streamer.RequestSong(e.Ctx, song, e.Source.Host)
+		err = e.Bot.Streamer.RequestSong(e.Ctx, song, e.Requester())
 		if err == nil {
 			// finished and requested a song successfully
 			return nil
@@ -583,10 +605,11 @@ func SearchTrack(e Event) error {
 		message = make([]string, 0, 10)
 		args    = make([]interface{}, 0, 15)
 	)
+	policy := e.RequestPolicy()
 	// loop over our songs and append to our args and message
 	for _, song := range songs {
 		// check if song is requestable to change the color
-		if song.Requestable() {
+		if _, ok := policy.SongCooldown(song); ok {
 			message = append(message, requestableColor+format)
 		} else {
 			message = append(message, unrequestableColor+format)
@@ -621,7 +644,7 @@ func RequestTrack(e Event) error {
 		return errors.E(op, err)
 	}
 
-	err = e.Bot.Streamer.RequestSong(e.Ctx, *song, e.Source.Host)
+	err = e.Bot.Streamer.RequestSong(e.Ctx, *song, e.Requester())
 	if err != nil {
 		return errors.E(op, err)
 	}
@@ -649,6 +672,11 @@ func CooldownMessageFromError(err error) string {
 		}
 	}
 
+	// global request limit messages
+	if errors.Is(errors.RequestsLimited, err) {
+		return "{brown}The queue is full right now, try requesting again in a bit."
+	}
+
 	// song cooldown messages
 	if !errors.Is(errors.SongCooldown, err) {
 		panic("invalid error passed to CooldownMessageFromError: " + err.Error())
@@ -724,8 +752,14 @@ func LastRequestInfo(e Event) error {
 		FormatDuration(time.Since(t).Truncate(time.Second), time.Second),
 	}
 
-	// calculate if enough time has passed since the last request
-	_, canRequest := radio.CalculateCooldown(time.Duration(e.Bot.Conf().UserRequestDelay), t)
+	// calculate if the user is allowed to request again
+	_, canRequest, err := e.RequestPolicy().UserCooldown(
+		e.Storage.Request(e.Ctx),
+		radio.Requester{Identifier: host},
+	)
+	if err != nil {
+		return errors.E(op, err)
+	}
 	if canRequest {
 		message += " {green}%s can request!"
 		args = append(args, name)
@@ -776,17 +810,10 @@ func TrackInfo(e Event) error {
 	}
 
 	// calculate the time remaining until this can be requested again
+	policy := e.RequestPolicy()
 	var cooldownIndicator = "!"
-	{
-		compareTime := song.LastPlayed
-		if song.LastRequested.After(song.LastPlayed) {
-			compareTime = song.LastRequested
-		}
-
-		leftover := song.RequestDelay() - time.Since(compareTime)
-		if leftover > 0 {
-			cooldownIndicator = FormatDuration(leftover, time.Second)
-		}
+	if leftover, ok := policy.SongCooldown(*song); !ok {
+		cooldownIndicator = FormatDuration(leftover, time.Second)
 	}
 
 	e.Echo(message,
@@ -796,7 +823,7 @@ func TrackInfo(e Event) error {
 		playedCount,
 		song.RequestCount,
 		song.Priority,
-		FormatDuration(song.RequestDelay(), time.Second), cooldownIndicator,
+		FormatDuration(policy.SongDelay(*song), time.Second), cooldownIndicator,
 		song.Acceptor,
 		song.Tags,
 	)
@@ -0,0 +1,82 @@
+//go:build !nostreamer
+// +build !nostreamer
+
+package jobs
+
+import (
+	"context"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/storage"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/rs/zerolog"
+)
+
+// loudnessBatchSize is the amount of tracks to retrieve from storage at once
+const loudnessBatchSize = 100
+
+// ExecuteLoudness runs a loudness analysis on all usable tracks that don't have
+// one stored yet and stores the results, this lets the streamer skip the
+// analysis pass when playing the track
+func ExecuteLoudness(ctx context.Context, cfg config.Config) error {
+	logger := zerolog.Ctx(ctx)
+
+	store, err := storage.Open(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	ts := store.Track(ctx)
+	root := cfg.Conf().MusicPath
+
+	// failed keeps track of tracks we couldn't analyze, they will still show up
+	// as unmeasured so we need to know when to stop asking for more
+	failed := make(map[radio.TrackID]bool)
+
+	for ctx.Err() == nil {
+		songs, err := ts.Unmeasured(int64(len(failed) + loudnessBatchSize))
+		if err != nil {
+			return err
+		}
+
+		var progress bool
+		for _, song := range songs {
+			if failed[song.TrackID] {
+				continue
+			}
+			progress = true
+
+			filename := util.AbsolutePath(root, song.FilePath)
+			loudness, err := audio.AnalyzeLoudness(ctx, filename)
+			if err != nil {
+				l := logger.Error().
+					Err(err).
+					Uint64("track_id", uint64(song.TrackID)).
+					Str("filename", filename)
+				if err, ok := err.(*audio.DecodeError); ok {
+					l = l.Str("info", err.ExtraInfo)
+				}
+				l.Msg("failed to analyze file")
+				failed[song.TrackID] = true
+				continue
+			}
+
+			err = ts.UpdateLoudness(song.TrackID, loudness)
+			if err != nil {
+				logger.Error().Err(err).Uint64("track_id", uint64(song.TrackID)).Msg("failed to store loudness")
+				failed[song.TrackID] = true
+				continue
+			}
+
+			logger.Info().Uint64("track_id", uint64(song.TrackID)).Msg("success")
+		}
+
+		if !progress {
+			break
+		}
+	}
+
+	return ctx.Err()
+}
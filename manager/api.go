@@ -40,6 +40,10 @@ func (m *Manager) CurrentStatus(ctx context.Context) (eventstream.Stream[radio.S
 	return m.statusStream.SubStream(ctx), nil
 }
 
+func (m *Manager) Events(ctx context.Context) (eventstream.Stream[radio.ManagerEvent], error) {
+	return m.eventStream.SubStream(ctx), nil
+}
+
 // Status returns the current status of the radio
 func (m *Manager) Status(ctx context.Context) (*radio.Status, error) {
 	m.mu.Lock()
@@ -63,6 +67,7 @@ func (m *Manager) UpdateUser(ctx context.Context, u *radio.User) error {
 		m.status.StreamerName = u.DJ.Name
 		m.status.User = *u
 	}
+	m.handoverUser(u)
 
 	m.mu.Unlock()
 	if u != nil {
@@ -91,20 +96,14 @@ func (m *Manager) UpdateSong(ctx context.Context, update *radio.SongUpdate) erro
 	}
 
 	// check if a robot is streaming
-	// TODO: don't hardcode this
-	//isRobot := m.status.User.Username == "AFK"
-	isRobot := true
+	isRobot := m.Conf().Manager.Handover.IsRobot(&m.status.User)
 
 	// check if we're on a fallback stream
 	if info.IsFallback {
 		m.logger.Info().Str("fallback", new.Metadata).Msg("fallback engaged")
-		// if we have a robot user we want to start the automated streamer, but only if
-		// there isn't already a timer running
-		if isRobot {
-			// TODO: don't hardcode this
-			timeout := time.Second * 15
-			m.tryStartStreamer(timeout)
-		}
+		// nobody is streaming so we want to start the automated streamer, but only
+		// if there isn't already a timer running
+		m.engageFallback()
 		m.status.SongInfo.IsFallback = info.IsFallback
 		m.mu.Unlock()
 		return nil
@@ -166,6 +165,9 @@ func (m *Manager) UpdateSong(ctx context.Context, update *radio.SongUpdate) erro
 	// update and retrieve listener count of start of song
 	var startListenerCount radio.Listeners
 	startListenerCount, m.songStartListenerCount = m.songStartListenerCount, currentListenerCount
+	// finish the curve of the previous song and start a new one
+	listenerCurve := m.songListenerCurve.Add(curveOffset(prevInfo.Start), currentListenerCount)
+	m.songListenerCurve = radio.ListenerCurve{}.Add(0, currentListenerCount)
 
 	m.mu.Unlock()
 
@@ -191,7 +193,7 @@ func (m *Manager) UpdateSong(ctx context.Context, update *radio.SongUpdate) erro
 	}
 
 	// insert a played entry
-	err = ss.AddPlay(prev.Song, prev.User, listenerCountDiff)
+	err = ss.AddPlay(prev.Song, prev.User, listenerCountDiff, listenerCurve)
 	if err != nil {
 		return errors.E(op, err)
 	}
@@ -250,6 +252,22 @@ func (m *Manager) UpdateListeners(ctx context.Context, listeners radio.Listeners
 
 	m.mu.Lock()
 	m.status.Listeners = listeners
+	if len(m.songListenerCurve) < maxListenerSamples {
+		m.songListenerCurve = m.songListenerCurve.Add(curveOffset(m.status.SongInfo.Start), listeners)
+	}
 	m.mu.Unlock()
 	return nil
 }
+
+// maxListenerSamples is the maximum amount of samples kept in the listener curve
+// of a single song, this only matters if a song goes on for a very long time
+const maxListenerSamples = 512
+
+// curveOffset returns the offset of a listener sample taken now for a song that
+// started at the time given
+func curveOffset(start time.Time) time.Duration {
+	if start.IsZero() {
+		return 0
+	}
+	return max(time.Since(start), 0)
+}
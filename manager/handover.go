@@ -0,0 +1,195 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+)
+
+// publishEvent sends a ManagerEvent of the kind given to the event stream
+func (m *Manager) publishEvent(kind radio.ManagerEventKind, u *radio.User, delay time.Duration) {
+	m.logger.Info().Str("kind", kind.String()).Dur("delay", delay).Msg("handover")
+	m.eventStream.Send(radio.ManagerEvent{
+		Kind:  kind,
+		User:  u,
+		Time:  time.Now(),
+		Delay: delay,
+	})
+}
+
+// engageFallback starts the countdown to starting the automated streamer because
+// nobody is streaming and the fallback is playing
+//
+// engageFallback needs to be called with m.mu held
+func (m *Manager) engageFallback() {
+	if m.autoStreamerTimer != nil {
+		// already counting down
+		return
+	}
+
+	timeout := time.Duration(m.Conf().Manager.Handover.FallbackTimeout)
+	m.publishEvent(radio.ManagerEventFallback, nil, timeout)
+	m.tryStartStreamer(timeout)
+}
+
+// handoverUser handles the transitions caused by the user given connecting, a nil
+// user means that nobody is connected anymore
+//
+// handoverUser needs to be called with m.mu held
+func (m *Manager) handoverUser(u *radio.User) {
+	if u == nil {
+		m.engageFallback()
+		return
+	}
+
+	// someone is streaming, so we don't want the automated streamer to start
+	m.stopStartStreamer()
+	if m.Conf().Manager.Handover.IsRobot(u) {
+		return
+	}
+
+	m.publishEvent(radio.ManagerEventTakeover, u, 0)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		m.stopStreamerFor(ctx, u)
+	}()
+}
+
+// stopStreamerFor stops the automated streamer so that the user given can take
+// over; the owner of the active schedule slot gets the stream immediately, anyone
+// else only if we're configured to stop the streamer for them
+func (m *Manager) stopStreamerFor(ctx context.Context, u *radio.User) {
+	scheduled := m.isScheduled(ctx, u)
+	if !scheduled && !m.Conf().Manager.Handover.StopOnTakeover {
+		return
+	}
+
+	err := m.client.streamer.Stop(ctx, scheduled)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("failed to stop streamer")
+		return
+	}
+	m.publishEvent(radio.ManagerEventStreamerStop, u, 0)
+}
+
+// onProxyEvent handles a source connecting to the primary mount while a robot is
+// live on it. New sources get the lowest priority on the proxy, so a DJ would
+// otherwise have to wait for the streamer to leave by itself before going live
+func (m *Manager) onProxyEvent(ctx context.Context, ev radio.ProxyEvent) {
+	if ev.Kind != radio.ProxyEventConnect || ev.MountName != m.Conf().Proxy.PrimaryMountName {
+		return
+	}
+
+	handover := m.Conf().Manager.Handover
+	if handover.IsRobot(&ev.Source.User) {
+		return
+	}
+
+	live := slices.IndexFunc(ev.Sources, func(s radio.ProxySource) bool {
+		return s.MountName == ev.MountName && s.Live
+	})
+	if live < 0 || !handover.IsRobot(&ev.Sources[live].User) {
+		// nobody or another DJ is live, the proxy handles those by itself
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	m.stopStreamerFor(ctx, &ev.Source.User)
+}
+
+// retryBackoff returns how long to wait before trying to start the streamer again
+// when the previous attempt was made after waiting prev
+func (m *Manager) retryBackoff(prev time.Duration) time.Duration {
+	cfg := m.Conf().Manager.Handover
+
+	next := prev * 2
+	if min := time.Duration(cfg.RetryBackoff); next < min {
+		next = min
+	}
+	if max := time.Duration(cfg.RetryBackoffMax); max > 0 && next > max {
+		next = max
+	}
+	return next
+}
+
+// isScheduled returns true if the user given owns the schedule slot that is active
+// right now
+func (m *Manager) isScheduled(ctx context.Context, u *radio.User) bool {
+	schedule, err := m.Storage.Schedule(ctx).Latest()
+	if err != nil {
+		m.logger.Error().Err(err).Msg("failed to load schedule")
+		return false
+	}
+
+	entry := radio.ActiveScheduleEntry(schedule, time.Now())
+	return entry != nil && entry.IsOwner(u)
+}
+
+// runScheduleReminders reminds staff of schedule slots that are about to start
+// while the owner of the slot hasn't connected yet
+func (m *Manager) runScheduleReminders(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	// reminded holds the start of the slot we last sent a reminder for, for
+	// each weekday
+	reminded := make(map[radio.ScheduleDay]time.Time)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.scheduleReminders(ctx, now, reminded)
+		}
+	}
+}
+
+func (m *Manager) scheduleReminders(ctx context.Context, now time.Time, reminded map[radio.ScheduleDay]time.Time) {
+	before := time.Duration(m.Conf().Manager.Handover.ScheduleReminder)
+	if before <= 0 {
+		return
+	}
+
+	schedule, err := m.Storage.Schedule(ctx).Latest()
+	if err != nil {
+		m.logger.Error().Err(err).Msg("failed to load schedule")
+		return
+	}
+
+	m.mu.Lock()
+	current := m.status.User
+	m.mu.Unlock()
+
+	for _, entry := range schedule {
+		if entry == nil || entry.Owner == nil || entry.IsOwner(&current) {
+			continue
+		}
+
+		start, _ := entry.Window(now)
+		if !start.After(now) || start.Sub(now) > before {
+			continue
+		}
+		if reminded[entry.Weekday].Equal(start) {
+			continue
+		}
+		reminded[entry.Weekday] = start
+
+		name := entry.Owner.DJ.Name
+		if name == "" {
+			name = entry.Owner.Username
+		}
+		message := fmt.Sprintf("%s is scheduled to stream in %s but hasn't connected yet",
+			name, start.Sub(now).Round(time.Minute))
+
+		err := m.IRC.AnnounceStaff(ctx, message)
+		if err != nil {
+			m.logger.Error().Err(err).Msg("failed to send schedule reminder")
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
+	"github.com/rs/xid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testRobot = radio.User{ID: 1, Username: "AFK"}
+	testDJ    = radio.User{ID: 2, Username: "dj"}
+	testOther = radio.User{ID: 3, Username: "other"}
+)
+
+// testStreamer is a StreamerService that records the calls to Stop
+type testStreamer struct {
+	mocks.StreamerServiceMock
+
+	mu    sync.Mutex
+	stops []bool
+}
+
+func newTestStreamer() *testStreamer {
+	ts := new(testStreamer)
+	ts.StopFunc = func(ctx context.Context, force bool) error {
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		ts.stops = append(ts.stops, force)
+		return nil
+	}
+	return ts
+}
+
+func (ts *testStreamer) Stops() []bool {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.stops
+}
+
+// newTestManager returns a Manager with the schedule owned by the owner given
+// for the whole week
+func newTestManager(cfg config.Config, owner *radio.User, streamer radio.StreamerService) *Manager {
+	var schedule []*radio.ScheduleEntry
+	for day := radio.Monday; day <= radio.Sunday; day++ {
+		schedule = append(schedule, &radio.ScheduleEntry{Weekday: day, Owner: owner})
+	}
+	storage := &mocks.StorageServiceMock{
+		ScheduleFunc: func(context.Context) radio.ScheduleStorage {
+			return &mocks.ScheduleStorageMock{
+				LatestFunc: func() ([]*radio.ScheduleEntry, error) {
+					return schedule, nil
+				},
+			}
+		},
+	}
+
+	logger := zerolog.Nop()
+	m := &Manager{
+		Config:  cfg,
+		logger:  &logger,
+		Storage: storage,
+		eventStream: eventstream.NewEventStream(radio.ManagerEvent{
+			Kind: radio.ManagerEventInit,
+		}),
+	}
+	m.client.streamer = streamer
+	return m
+}
+
+// connectEvent returns the event the proxy sends when the user given connects to
+// the primary mount while live is streaming on it
+func connectEvent(cfg config.Config, user, live radio.User) radio.ProxyEvent {
+	mount := cfg.Conf().Proxy.PrimaryMountName
+	source := radio.ProxySource{ID: radio.SourceID{ID: xid.New()}, MountName: mount, User: user, Priority: 1}
+	return radio.ProxyEvent{
+		Kind:      radio.ProxyEventConnect,
+		MountName: mount,
+		Source:    source,
+		Sources: []radio.ProxySource{
+			{ID: radio.SourceID{ID: xid.New()}, MountName: mount, User: live, Live: true},
+			source,
+		},
+	}
+}
+
+func TestConnectPreemptsStreamer(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.TestConfig()
+
+	t.Run("scheduled", func(t *testing.T) {
+		streamer := newTestStreamer()
+		m := newTestManager(cfg, &testDJ, streamer)
+
+		m.onProxyEvent(ctx, connectEvent(cfg, testDJ, testRobot))
+		// the owner of the slot gets the stream right away
+		assert.Equal(t, []bool{true}, streamer.Stops())
+	})
+
+	t.Run("not scheduled", func(t *testing.T) {
+		streamer := newTestStreamer()
+		m := newTestManager(cfg, &testOther, streamer)
+
+		m.onProxyEvent(ctx, connectEvent(cfg, testDJ, testRobot))
+		assert.Empty(t, streamer.Stops())
+	})
+
+	t.Run("dj live", func(t *testing.T) {
+		streamer := newTestStreamer()
+		m := newTestManager(cfg, &testDJ, streamer)
+
+		// the streamer isn't live, so there is nothing to preempt
+		m.onProxyEvent(ctx, connectEvent(cfg, testDJ, testOther))
+		assert.Empty(t, streamer.Stops())
+	})
+
+	t.Run("other mount", func(t *testing.T) {
+		streamer := newTestStreamer()
+		m := newTestManager(cfg, &testDJ, streamer)
+
+		ev := connectEvent(cfg, testDJ, testRobot)
+		ev.MountName = "/other.mp3"
+		m.onProxyEvent(ctx, ev)
+		assert.Empty(t, streamer.Stops())
+	})
+}
+
+// handoverConfig returns a config with short handover timeouts
+func handoverConfig(stopOnTakeover bool) config.Config {
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Manager.Handover.FallbackTimeout = config.Duration(time.Millisecond * 10)
+	c.Manager.Handover.RetryBackoff = config.Duration(time.Millisecond * 20)
+	c.Manager.Handover.StopOnTakeover = stopOnTakeover
+	cfg.StoreConf(c)
+	return cfg
+}
+
+// nextEvent returns the next event received on ch
+func nextEvent(t *testing.T, ch chan radio.ManagerEvent) radio.ManagerEvent {
+	t.Helper()
+	select {
+	case ev := <-ch:
+		return ev
+	case <-time.After(time.Second * 5):
+		t.Fatal("no event received")
+		return radio.ManagerEvent{}
+	}
+}
+
+func TestConnectStopOnTakeover(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("enabled", func(t *testing.T) {
+		cfg := handoverConfig(true)
+		streamer := newTestStreamer()
+		m := newTestManager(cfg, &testOther, streamer)
+
+		m.onProxyEvent(ctx, connectEvent(cfg, testDJ, testRobot))
+		// not scheduled, so the streamer gets to finish its song
+		assert.Equal(t, []bool{false}, streamer.Stops())
+	})
+
+	t.Run("robot connects", func(t *testing.T) {
+		cfg := handoverConfig(true)
+		streamer := newTestStreamer()
+		m := newTestManager(cfg, &testOther, streamer)
+
+		m.onProxyEvent(ctx, connectEvent(cfg, testRobot, testRobot))
+		assert.Empty(t, streamer.Stops())
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Manager.Handover.RetryBackoff = config.Duration(time.Second * 10)
+	c.Manager.Handover.RetryBackoffMax = config.Duration(time.Minute)
+	cfg.StoreConf(c)
+	m := newTestManager(cfg, nil, nil)
+
+	assert.Equal(t, time.Second*10, m.retryBackoff(0))
+	assert.Equal(t, time.Second*10, m.retryBackoff(time.Second*2))
+	assert.Equal(t, time.Second*40, m.retryBackoff(time.Second*20))
+	assert.Equal(t, time.Minute, m.retryBackoff(time.Minute))
+
+	// no maximum keeps doubling
+	c.Manager.Handover.RetryBackoffMax = 0
+	cfg.StoreConf(c)
+	assert.Equal(t, time.Minute*2, m.retryBackoff(time.Minute))
+}
+
+func TestEngageFallback(t *testing.T) {
+	cfg := handoverConfig(false)
+	streamer := newTestStreamer()
+	var mu sync.Mutex
+	var starts int
+	streamer.StartFunc = func(context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+		starts++
+		return nil
+	}
+	m := newTestManager(cfg, nil, streamer)
+	events := m.eventStream.Sub()
+	assert.Equal(t, radio.ManagerEventInit, nextEvent(t, events).Kind)
+
+	m.mu.Lock()
+	m.engageFallback()
+	// engaging again while counting down shouldn't start another countdown
+	m.engageFallback()
+	m.mu.Unlock()
+
+	ev := nextEvent(t, events)
+	assert.Equal(t, radio.ManagerEventFallback, ev.Kind)
+	assert.Equal(t, time.Millisecond*10, ev.Delay)
+	assert.Equal(t, radio.ManagerEventStreamerStart, nextEvent(t, events).Kind)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, starts)
+}
+
+func TestHandoverEvents(t *testing.T) {
+	cfg := handoverConfig(true)
+	streamer := newTestStreamer()
+	var failed bool
+	streamer.StartFunc = func(context.Context) error {
+		// fail the first attempt so that we retry
+		if !failed {
+			failed = true
+			return errors.New("streamer unavailable")
+		}
+		return nil
+	}
+	m := newTestManager(cfg, &testOther, streamer)
+	events := m.eventStream.Sub()
+	assert.Equal(t, radio.ManagerEventInit, nextEvent(t, events).Kind)
+
+	// a robot connecting cancels the countdown without any events
+	m.mu.Lock()
+	m.handoverUser(nil)
+	m.handoverUser(&testRobot)
+	m.mu.Unlock()
+	assert.Equal(t, radio.ManagerEventFallback, nextEvent(t, events).Kind)
+
+	m.mu.Lock()
+	m.handoverUser(nil)
+	m.mu.Unlock()
+	assert.Equal(t, radio.ManagerEventFallback, nextEvent(t, events).Kind)
+	ev := nextEvent(t, events)
+	assert.Equal(t, radio.ManagerEventStreamerRetry, ev.Kind)
+	assert.Equal(t, time.Millisecond*20, ev.Delay)
+	assert.Equal(t, radio.ManagerEventStreamerStart, nextEvent(t, events).Kind)
+
+	m.mu.Lock()
+	m.handoverUser(&testDJ)
+	m.mu.Unlock()
+	ev = nextEvent(t, events)
+	assert.Equal(t, radio.ManagerEventTakeover, ev.Kind)
+	assert.Equal(t, &testDJ, ev.User)
+	assert.Equal(t, radio.ManagerEventStreamerStop, nextEvent(t, events).Kind)
+	assert.Equal(t, []bool{false}, streamer.Stops())
+}
+
+func TestIsRobot(t *testing.T) {
+	handover := config.TestConfig().Conf().Manager.Handover
+
+	assert.False(t, handover.IsRobot(nil))
+	assert.True(t, handover.IsRobot(&testRobot))
+	assert.False(t, handover.IsRobot(&testDJ))
+
+	robot := radio.User{
+		Username:        "streamer",
+		UserPermissions: radio.UserPermissions{radio.PermRobot: struct{}{}},
+	}
+	assert.True(t, handover.IsRobot(&robot))
+}
@@ -9,6 +9,7 @@ import (
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/storage"
+	"github.com/R-a-dio/valkyrie/util"
 	"github.com/R-a-dio/valkyrie/util/eventstream"
 	"github.com/rs/zerolog"
 )
@@ -76,8 +77,15 @@ func NewManager(ctx context.Context, cfg config.Config) (*Manager, error) {
 	m.songStream = eventstream.NewEventStream(&radio.SongUpdate{Song: old.Song, Info: old.SongInfo})
 	m.listenerStream = eventstream.NewEventStream(radio.Listeners(old.Listeners))
 	m.statusStream = eventstream.NewEventStream(*old)
+	m.eventStream = eventstream.NewEventStream(radio.ManagerEvent{
+		Kind: radio.ManagerEventInit,
+		Time: time.Now(),
+	})
 
 	m.client.streamer = cfg.Streamer
+
+	util.StreamValue(ctx, cfg.Proxy.Events, m.onProxyEvent)
+	go m.runScheduleReminders(ctx)
 	return &m, nil
 }
 
@@ -98,6 +106,8 @@ type Manager struct {
 	autoStreamerTimer *time.Timer
 	// listener count at the start of a song
 	songStartListenerCount radio.Listeners
+	// listener count sampled during the current song
+	songListenerCurve radio.ListenerCurve
 
 	// streaming support
 	userStream     *eventstream.EventStream[*radio.User]
@@ -105,6 +115,7 @@ type Manager struct {
 	songStream     *eventstream.EventStream[*radio.SongUpdate]
 	listenerStream *eventstream.EventStream[radio.Listeners]
 	statusStream   *eventstream.EventStream[radio.Status]
+	eventStream    *eventstream.EventStream[radio.ManagerEvent]
 }
 
 // updateStreamStatus is a legacy layer to keep supporting streamstatus table usage
@@ -201,13 +212,13 @@ func (m *Manager) tryStartStreamer(timeout time.Duration) {
 		err := m.client.streamer.Start(context.Background())
 		if err != nil {
 			m.logger.Error().Err(err).Msg("failed to start streamer")
-			// if we failed to start, try again with atleast 10 seconds timeout
-			if timeout < time.Second*10 {
-				timeout = time.Second * 10
-			}
+			// if we failed to start, try again after backing off
+			timeout = m.retryBackoff(timeout)
+			m.publishEvent(radio.ManagerEventStreamerRetry, nil, timeout)
 			m.tryStartStreamer(timeout)
 			return
 		}
+		m.publishEvent(radio.ManagerEventStreamerStart, nil, 0)
 	})
 }
 
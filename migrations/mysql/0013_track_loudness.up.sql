@@ -0,0 +1,7 @@
+ALTER TABLE tracks ADD COLUMN IF NOT EXISTS (
+    loudness_i DOUBLE DEFAULT NULL,
+    loudness_lra DOUBLE DEFAULT NULL,
+    loudness_tp DOUBLE DEFAULT NULL,
+    loudness_thresh DOUBLE DEFAULT NULL,
+    loudness_offset DOUBLE DEFAULT NULL
+);
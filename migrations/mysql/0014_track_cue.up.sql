@@ -0,0 +1,4 @@
+ALTER TABLE tracks ADD COLUMN IF NOT EXISTS (
+    cue_in BIGINT DEFAULT NULL,
+    cue_out BIGINT DEFAULT NULL
+);
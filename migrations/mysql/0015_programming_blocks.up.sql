@@ -0,0 +1,12 @@
+CREATE TABLE `programming_blocks` (
+    `id` int unsigned NOT NULL AUTO_INCREMENT,
+    `name` varchar(200) NOT NULL,
+    `weekday` TINYINT unsigned NOT NULL,
+    `start` BIGINT NOT NULL,
+    `length` BIGINT NOT NULL,
+    `tags` TEXT NOT NULL DEFAULT "",
+    `artists` TEXT NOT NULL DEFAULT "",
+    `playlist` TEXT NOT NULL DEFAULT "",
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,4 @@
+ALTER TABLE `schedule` ADD COLUMN IF NOT EXISTS (
+    `start` BIGINT NOT NULL DEFAULT 0,
+    `length` BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,12 @@
+CREATE TABLE `webhook_deliveries` (
+    `id` int unsigned NOT NULL AUTO_INCREMENT,
+    `event` varchar(50) NOT NULL,
+    `url` TEXT NOT NULL,
+    `payload` TEXT NOT NULL,
+    `status_code` int NOT NULL DEFAULT 0,
+    `error` TEXT NOT NULL DEFAULT "",
+    `attempts` int NOT NULL DEFAULT 0,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `webhook_deliveries_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
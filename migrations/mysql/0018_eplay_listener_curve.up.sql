@@ -0,0 +1,5 @@
+ALTER TABLE `eplay` ADD COLUMN IF NOT EXISTS (
+    `lcurve` TEXT DEFAULT NULL,
+    `lstart` INT DEFAULT NULL,
+    `llost` INT DEFAULT NULL
+);
@@ -0,0 +1,13 @@
+CREATE TABLE `stream_keys` (
+    `id` int unsigned NOT NULL AUTO_INCREMENT,
+    `user_id` int(12) unsigned NOT NULL,
+    `name` varchar(200) NOT NULL DEFAULT "",
+    `hash` char(64) NOT NULL,
+    `mount` varchar(200) NOT NULL DEFAULT "",
+    `expires_at` DATETIME NULL DEFAULT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `stream_keys_hash` (`hash`),
+    KEY `user_id` (`user_id`),
+    CONSTRAINT `stream_keys_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -220,6 +220,9 @@ var _ radio.ManagerService = &ManagerServiceMock{}
 //			CurrentUserFunc: func(contextMoqParam context.Context) (eventstream.Stream[*radio.User], error) {
 //				panic("mock out the CurrentUser method")
 //			},
+//			EventsFunc: func(contextMoqParam context.Context) (eventstream.Stream[radio.ManagerEvent], error) {
+//				panic("mock out the Events method")
+//			},
 //			UpdateListenersFunc: func(contextMoqParam context.Context, n int64) error {
 //				panic("mock out the UpdateListeners method")
 //			},
@@ -254,6 +257,9 @@ type ManagerServiceMock struct {
 	// CurrentUserFunc mocks the CurrentUser method.
 	CurrentUserFunc func(contextMoqParam context.Context) (eventstream.Stream[*radio.User], error)
 
+	// EventsFunc mocks the Events method.
+	EventsFunc func(contextMoqParam context.Context) (eventstream.Stream[radio.ManagerEvent], error)
+
 	// UpdateListenersFunc mocks the UpdateListeners method.
 	UpdateListenersFunc func(contextMoqParam context.Context, n int64) error
 
@@ -293,6 +299,11 @@ type ManagerServiceMock struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
+		// Events holds details about calls to the Events method.
+		Events []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
 		// UpdateListeners holds details about calls to the UpdateListeners method.
 		UpdateListeners []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -327,6 +338,7 @@ type ManagerServiceMock struct {
 	lockCurrentStatus    sync.RWMutex
 	lockCurrentThread    sync.RWMutex
 	lockCurrentUser      sync.RWMutex
+	lockEvents           sync.RWMutex
 	lockUpdateListeners  sync.RWMutex
 	lockUpdateSong       sync.RWMutex
 	lockUpdateThread     sync.RWMutex
@@ -493,6 +505,38 @@ func (mock *ManagerServiceMock) CurrentUserCalls() []struct {
 	return calls
 }
 
+// Events calls EventsFunc.
+func (mock *ManagerServiceMock) Events(contextMoqParam context.Context) (eventstream.Stream[radio.ManagerEvent], error) {
+	if mock.EventsFunc == nil {
+		panic("ManagerServiceMock.EventsFunc: method is nil but ManagerService.Events was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockEvents.Lock()
+	mock.calls.Events = append(mock.calls.Events, callInfo)
+	mock.lockEvents.Unlock()
+	return mock.EventsFunc(contextMoqParam)
+}
+
+// EventsCalls gets all the calls that were made to Events.
+// Check the length with:
+//
+//	len(mockedManagerService.EventsCalls())
+func (mock *ManagerServiceMock) EventsCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockEvents.RLock()
+	calls = mock.calls.Events
+	mock.lockEvents.RUnlock()
+	return calls
+}
+
 // UpdateListeners calls UpdateListenersFunc.
 func (mock *ManagerServiceMock) UpdateListeners(contextMoqParam context.Context, n int64) error {
 	if mock.UpdateListenersFunc == nil {
@@ -650,9 +694,12 @@ var _ radio.StreamerService = &StreamerServiceMock{}
 //			QueueFunc: func(contextMoqParam context.Context) ([]radio.QueueEntry, error) {
 //				panic("mock out the Queue method")
 //			},
-//			RequestSongFunc: func(contextMoqParam context.Context, song radio.Song, s string) error {
+//			RequestSongFunc: func(contextMoqParam context.Context, song radio.Song, requester radio.Requester) error {
 //				panic("mock out the RequestSong method")
 //			},
+//			SkipFunc: func(contextMoqParam context.Context) error {
+//				panic("mock out the Skip method")
+//			},
 //			StartFunc: func(contextMoqParam context.Context) error {
 //				panic("mock out the Start method")
 //			},
@@ -670,7 +717,10 @@ type StreamerServiceMock struct {
 	QueueFunc func(contextMoqParam context.Context) ([]radio.QueueEntry, error)
 
 	// RequestSongFunc mocks the RequestSong method.
-	RequestSongFunc func(contextMoqParam context.Context, song radio.Song, s string) error
+	RequestSongFunc func(contextMoqParam context.Context, song radio.Song, requester radio.Requester) error
+
+	// SkipFunc mocks the Skip method.
+	SkipFunc func(contextMoqParam context.Context) error
 
 	// StartFunc mocks the Start method.
 	StartFunc func(contextMoqParam context.Context) error
@@ -691,8 +741,13 @@ type StreamerServiceMock struct {
 			ContextMoqParam context.Context
 			// Song is the song argument value.
 			Song radio.Song
-			// S is the s argument value.
-			S string
+			// Requester is the requester argument value.
+			Requester radio.Requester
+		}
+		// Skip holds details about calls to the Skip method.
+		Skip []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
 		}
 		// Start holds details about calls to the Start method.
 		Start []struct {
@@ -709,6 +764,7 @@ type StreamerServiceMock struct {
 	}
 	lockQueue       sync.RWMutex
 	lockRequestSong sync.RWMutex
+	lockSkip        sync.RWMutex
 	lockStart       sync.RWMutex
 	lockStop        sync.RWMutex
 }
@@ -746,23 +802,23 @@ func (mock *StreamerServiceMock) QueueCalls() []struct {
 }
 
 // RequestSong calls RequestSongFunc.
-func (mock *StreamerServiceMock) RequestSong(contextMoqParam context.Context, song radio.Song, s string) error {
+func (mock *StreamerServiceMock) RequestSong(contextMoqParam context.Context, song radio.Song, requester radio.Requester) error {
 	if mock.RequestSongFunc == nil {
 		panic("StreamerServiceMock.RequestSongFunc: method is nil but StreamerService.RequestSong was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 		Song            radio.Song
-		S               string
+		Requester       radio.Requester
 	}{
 		ContextMoqParam: contextMoqParam,
 		Song:            song,
-		S:               s,
+		Requester:       requester,
 	}
 	mock.lockRequestSong.Lock()
 	mock.calls.RequestSong = append(mock.calls.RequestSong, callInfo)
 	mock.lockRequestSong.Unlock()
-	return mock.RequestSongFunc(contextMoqParam, song, s)
+	return mock.RequestSongFunc(contextMoqParam, song, requester)
 }
 
 // RequestSongCalls gets all the calls that were made to RequestSong.
@@ -772,12 +828,12 @@ func (mock *StreamerServiceMock) RequestSong(contextMoqParam context.Context, so
 func (mock *StreamerServiceMock) RequestSongCalls() []struct {
 	ContextMoqParam context.Context
 	Song            radio.Song
-	S               string
+	Requester       radio.Requester
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 		Song            radio.Song
-		S               string
+		Requester       radio.Requester
 	}
 	mock.lockRequestSong.RLock()
 	calls = mock.calls.RequestSong
@@ -785,6 +841,38 @@ func (mock *StreamerServiceMock) RequestSongCalls() []struct {
 	return calls
 }
 
+// Skip calls SkipFunc.
+func (mock *StreamerServiceMock) Skip(contextMoqParam context.Context) error {
+	if mock.SkipFunc == nil {
+		panic("StreamerServiceMock.SkipFunc: method is nil but StreamerService.Skip was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockSkip.Lock()
+	mock.calls.Skip = append(mock.calls.Skip, callInfo)
+	mock.lockSkip.Unlock()
+	return mock.SkipFunc(contextMoqParam)
+}
+
+// SkipCalls gets all the calls that were made to Skip.
+// Check the length with:
+//
+//	len(mockedStreamerService.SkipCalls())
+func (mock *StreamerServiceMock) SkipCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockSkip.RLock()
+	calls = mock.calls.Skip
+	mock.lockSkip.RUnlock()
+	return calls
+}
+
 // Start calls StartFunc.
 func (mock *StreamerServiceMock) Start(contextMoqParam context.Context) error {
 	if mock.StartFunc == nil {
@@ -869,6 +957,18 @@ var _ radio.QueueService = &QueueServiceMock{}
 //			EntriesFunc: func(contextMoqParam context.Context) ([]radio.QueueEntry, error) {
 //				panic("mock out the Entries method")
 //			},
+//			EventsFunc: func(contextMoqParam context.Context) (eventstream.Stream[radio.QueueEvent], error) {
+//				panic("mock out the Events method")
+//			},
+//			InsertAtFunc: func(ctx context.Context, song radio.Song, position int) error {
+//				panic("mock out the InsertAt method")
+//			},
+//			MoveFunc: func(ctx context.Context, id radio.QueueID, position int) (bool, error) {
+//				panic("mock out the Move method")
+//			},
+//			PromoteFunc: func(contextMoqParam context.Context, queueID radio.QueueID) (bool, error) {
+//				panic("mock out the Promote method")
+//			},
 //			RemoveFunc: func(contextMoqParam context.Context, queueID radio.QueueID) (bool, error) {
 //				panic("mock out the Remove method")
 //			},
@@ -891,6 +991,18 @@ type QueueServiceMock struct {
 	// EntriesFunc mocks the Entries method.
 	EntriesFunc func(contextMoqParam context.Context) ([]radio.QueueEntry, error)
 
+	// EventsFunc mocks the Events method.
+	EventsFunc func(contextMoqParam context.Context) (eventstream.Stream[radio.QueueEvent], error)
+
+	// InsertAtFunc mocks the InsertAt method.
+	InsertAtFunc func(ctx context.Context, song radio.Song, position int) error
+
+	// MoveFunc mocks the Move method.
+	MoveFunc func(ctx context.Context, id radio.QueueID, position int) (bool, error)
+
+	// PromoteFunc mocks the Promote method.
+	PromoteFunc func(contextMoqParam context.Context, queueID radio.QueueID) (bool, error)
+
 	// RemoveFunc mocks the Remove method.
 	RemoveFunc func(contextMoqParam context.Context, queueID radio.QueueID) (bool, error)
 
@@ -916,6 +1028,36 @@ type QueueServiceMock struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
+		// Events holds details about calls to the Events method.
+		Events []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// InsertAt holds details about calls to the InsertAt method.
+		InsertAt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Song is the song argument value.
+			Song radio.Song
+			// Position is the position argument value.
+			Position int
+		}
+		// Move holds details about calls to the Move method.
+		Move []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID radio.QueueID
+			// Position is the position argument value.
+			Position int
+		}
+		// Promote holds details about calls to the Promote method.
+		Promote []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// QueueID is the queueID argument value.
+			QueueID radio.QueueID
+		}
 		// Remove holds details about calls to the Remove method.
 		Remove []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -936,6 +1078,10 @@ type QueueServiceMock struct {
 	}
 	lockAddRequest    sync.RWMutex
 	lockEntries       sync.RWMutex
+	lockEvents        sync.RWMutex
+	lockInsertAt      sync.RWMutex
+	lockMove          sync.RWMutex
+	lockPromote       sync.RWMutex
 	lockRemove        sync.RWMutex
 	lockReserveNext   sync.RWMutex
 	lockResetReserved sync.RWMutex
@@ -1013,6 +1159,154 @@ func (mock *QueueServiceMock) EntriesCalls() []struct {
 	return calls
 }
 
+// Events calls EventsFunc.
+func (mock *QueueServiceMock) Events(contextMoqParam context.Context) (eventstream.Stream[radio.QueueEvent], error) {
+	if mock.EventsFunc == nil {
+		panic("QueueServiceMock.EventsFunc: method is nil but QueueService.Events was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockEvents.Lock()
+	mock.calls.Events = append(mock.calls.Events, callInfo)
+	mock.lockEvents.Unlock()
+	return mock.EventsFunc(contextMoqParam)
+}
+
+// EventsCalls gets all the calls that were made to Events.
+// Check the length with:
+//
+//	len(mockedQueueService.EventsCalls())
+func (mock *QueueServiceMock) EventsCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockEvents.RLock()
+	calls = mock.calls.Events
+	mock.lockEvents.RUnlock()
+	return calls
+}
+
+// InsertAt calls InsertAtFunc.
+func (mock *QueueServiceMock) InsertAt(ctx context.Context, song radio.Song, position int) error {
+	if mock.InsertAtFunc == nil {
+		panic("QueueServiceMock.InsertAtFunc: method is nil but QueueService.InsertAt was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Song     radio.Song
+		Position int
+	}{
+		Ctx:      ctx,
+		Song:     song,
+		Position: position,
+	}
+	mock.lockInsertAt.Lock()
+	mock.calls.InsertAt = append(mock.calls.InsertAt, callInfo)
+	mock.lockInsertAt.Unlock()
+	return mock.InsertAtFunc(ctx, song, position)
+}
+
+// InsertAtCalls gets all the calls that were made to InsertAt.
+// Check the length with:
+//
+//	len(mockedQueueService.InsertAtCalls())
+func (mock *QueueServiceMock) InsertAtCalls() []struct {
+	Ctx      context.Context
+	Song     radio.Song
+	Position int
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Song     radio.Song
+		Position int
+	}
+	mock.lockInsertAt.RLock()
+	calls = mock.calls.InsertAt
+	mock.lockInsertAt.RUnlock()
+	return calls
+}
+
+// Move calls MoveFunc.
+func (mock *QueueServiceMock) Move(ctx context.Context, id radio.QueueID, position int) (bool, error) {
+	if mock.MoveFunc == nil {
+		panic("QueueServiceMock.MoveFunc: method is nil but QueueService.Move was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ID       radio.QueueID
+		Position int
+	}{
+		Ctx:      ctx,
+		ID:       id,
+		Position: position,
+	}
+	mock.lockMove.Lock()
+	mock.calls.Move = append(mock.calls.Move, callInfo)
+	mock.lockMove.Unlock()
+	return mock.MoveFunc(ctx, id, position)
+}
+
+// MoveCalls gets all the calls that were made to Move.
+// Check the length with:
+//
+//	len(mockedQueueService.MoveCalls())
+func (mock *QueueServiceMock) MoveCalls() []struct {
+	Ctx      context.Context
+	ID       radio.QueueID
+	Position int
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ID       radio.QueueID
+		Position int
+	}
+	mock.lockMove.RLock()
+	calls = mock.calls.Move
+	mock.lockMove.RUnlock()
+	return calls
+}
+
+// Promote calls PromoteFunc.
+func (mock *QueueServiceMock) Promote(contextMoqParam context.Context, queueID radio.QueueID) (bool, error) {
+	if mock.PromoteFunc == nil {
+		panic("QueueServiceMock.PromoteFunc: method is nil but QueueService.Promote was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		QueueID         radio.QueueID
+	}{
+		ContextMoqParam: contextMoqParam,
+		QueueID:         queueID,
+	}
+	mock.lockPromote.Lock()
+	mock.calls.Promote = append(mock.calls.Promote, callInfo)
+	mock.lockPromote.Unlock()
+	return mock.PromoteFunc(contextMoqParam, queueID)
+}
+
+// PromoteCalls gets all the calls that were made to Promote.
+// Check the length with:
+//
+//	len(mockedQueueService.PromoteCalls())
+func (mock *QueueServiceMock) PromoteCalls() []struct {
+	ContextMoqParam context.Context
+	QueueID         radio.QueueID
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		QueueID         radio.QueueID
+	}
+	mock.lockPromote.RLock()
+	calls = mock.calls.Promote
+	mock.lockPromote.RUnlock()
+	return calls
+}
+
 // Remove calls RemoveFunc.
 func (mock *QueueServiceMock) Remove(contextMoqParam context.Context, queueID radio.QueueID) (bool, error) {
 	if mock.RemoveFunc == nil {
@@ -1129,6 +1423,9 @@ var _ radio.AnnounceService = &AnnounceServiceMock{}
 //			AnnounceSongFunc: func(contextMoqParam context.Context, status radio.Status) error {
 //				panic("mock out the AnnounceSong method")
 //			},
+//			AnnounceStaffFunc: func(contextMoqParam context.Context, s string) error {
+//				panic("mock out the AnnounceStaff method")
+//			},
 //		}
 //
 //		// use mockedAnnounceService in code that requires radio.AnnounceService
@@ -1142,6 +1439,9 @@ type AnnounceServiceMock struct {
 	// AnnounceSongFunc mocks the AnnounceSong method.
 	AnnounceSongFunc func(contextMoqParam context.Context, status radio.Status) error
 
+	// AnnounceStaffFunc mocks the AnnounceStaff method.
+	AnnounceStaffFunc func(contextMoqParam context.Context, s string) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AnnounceRequest holds details about calls to the AnnounceRequest method.
@@ -1158,9 +1458,17 @@ type AnnounceServiceMock struct {
 			// Status is the status argument value.
 			Status radio.Status
 		}
+		// AnnounceStaff holds details about calls to the AnnounceStaff method.
+		AnnounceStaff []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// S is the s argument value.
+			S string
+		}
 	}
 	lockAnnounceRequest sync.RWMutex
 	lockAnnounceSong    sync.RWMutex
+	lockAnnounceStaff   sync.RWMutex
 }
 
 // AnnounceRequest calls AnnounceRequestFunc.
@@ -1235,6 +1543,42 @@ func (mock *AnnounceServiceMock) AnnounceSongCalls() []struct {
 	return calls
 }
 
+// AnnounceStaff calls AnnounceStaffFunc.
+func (mock *AnnounceServiceMock) AnnounceStaff(contextMoqParam context.Context, s string) error {
+	if mock.AnnounceStaffFunc == nil {
+		panic("AnnounceServiceMock.AnnounceStaffFunc: method is nil but AnnounceService.AnnounceStaff was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		S               string
+	}{
+		ContextMoqParam: contextMoqParam,
+		S:               s,
+	}
+	mock.lockAnnounceStaff.Lock()
+	mock.calls.AnnounceStaff = append(mock.calls.AnnounceStaff, callInfo)
+	mock.lockAnnounceStaff.Unlock()
+	return mock.AnnounceStaffFunc(contextMoqParam, s)
+}
+
+// AnnounceStaffCalls gets all the calls that were made to AnnounceStaff.
+// Check the length with:
+//
+//	len(mockedAnnounceService.AnnounceStaffCalls())
+func (mock *AnnounceServiceMock) AnnounceStaffCalls() []struct {
+	ContextMoqParam context.Context
+	S               string
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		S               string
+	}
+	mock.lockAnnounceStaff.RLock()
+	calls = mock.calls.AnnounceStaff
+	mock.lockAnnounceStaff.RUnlock()
+	return calls
+}
+
 // Ensure, that StorageTxMock does implement radio.StorageTx.
 // If this is not the case, regenerate this file with moq.
 var _ radio.StorageTx = &StorageTxMock{}
@@ -1347,6 +1691,12 @@ var _ radio.StorageService = &StorageServiceMock{}
 //			NewsTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.NewsStorage, radio.StorageTx, error) {
 //				panic("mock out the NewsTx method")
 //			},
+//			ProgrammingBlockFunc: func(contextMoqParam context.Context) radio.ProgrammingBlockStorage {
+//				panic("mock out the ProgrammingBlock method")
+//			},
+//			ProgrammingBlockTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ProgrammingBlockStorage, radio.StorageTx, error) {
+//				panic("mock out the ProgrammingBlockTx method")
+//			},
 //			QueueFunc: func(contextMoqParam context.Context) radio.QueueStorage {
 //				panic("mock out the Queue method")
 //			},
@@ -1404,6 +1754,12 @@ var _ radio.StorageService = &StorageServiceMock{}
 //			UserTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error) {
 //				panic("mock out the UserTx method")
 //			},
+//			WebhookFunc: func(contextMoqParam context.Context) radio.WebhookStorage {
+//				panic("mock out the Webhook method")
+//			},
+//			WebhookTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.WebhookStorage, radio.StorageTx, error) {
+//				panic("mock out the WebhookTx method")
+//			},
 //		}
 //
 //		// use mockedStorageService in code that requires radio.StorageService
@@ -1417,6 +1773,12 @@ type StorageServiceMock struct {
 	// NewsTxFunc mocks the NewsTx method.
 	NewsTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.NewsStorage, radio.StorageTx, error)
 
+	// ProgrammingBlockFunc mocks the ProgrammingBlock method.
+	ProgrammingBlockFunc func(contextMoqParam context.Context) radio.ProgrammingBlockStorage
+
+	// ProgrammingBlockTxFunc mocks the ProgrammingBlockTx method.
+	ProgrammingBlockTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ProgrammingBlockStorage, radio.StorageTx, error)
+
 	// QueueFunc mocks the Queue method.
 	QueueFunc func(contextMoqParam context.Context) radio.QueueStorage
 
@@ -1474,6 +1836,12 @@ type StorageServiceMock struct {
 	// UserTxFunc mocks the UserTx method.
 	UserTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.UserStorage, radio.StorageTx, error)
 
+	// WebhookFunc mocks the Webhook method.
+	WebhookFunc func(contextMoqParam context.Context) radio.WebhookStorage
+
+	// WebhookTxFunc mocks the WebhookTx method.
+	WebhookTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.WebhookStorage, radio.StorageTx, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// News holds details about calls to the News method.
@@ -1488,6 +1856,18 @@ type StorageServiceMock struct {
 			// StorageTx is the storageTx argument value.
 			StorageTx radio.StorageTx
 		}
+		// ProgrammingBlock holds details about calls to the ProgrammingBlock method.
+		ProgrammingBlock []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// ProgrammingBlockTx holds details about calls to the ProgrammingBlockTx method.
+		ProgrammingBlockTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
 		// Queue holds details about calls to the Queue method.
 		Queue []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -1601,28 +1981,44 @@ type StorageServiceMock struct {
 			// StorageTx is the storageTx argument value.
 			StorageTx radio.StorageTx
 		}
+		// Webhook holds details about calls to the Webhook method.
+		Webhook []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// WebhookTx holds details about calls to the WebhookTx method.
+		WebhookTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
 	}
-	lockNews          sync.RWMutex
-	lockNewsTx        sync.RWMutex
-	lockQueue         sync.RWMutex
-	lockQueueTx       sync.RWMutex
-	lockRelay         sync.RWMutex
-	lockRelayTx       sync.RWMutex
-	lockRequest       sync.RWMutex
-	lockRequestTx     sync.RWMutex
-	lockSchedule      sync.RWMutex
-	lockScheduleTx    sync.RWMutex
-	lockSessions      sync.RWMutex
-	lockSessionsTx    sync.RWMutex
-	lockSong          sync.RWMutex
-	lockSongTx        sync.RWMutex
-	lockStatus        sync.RWMutex
-	lockSubmissions   sync.RWMutex
-	lockSubmissionsTx sync.RWMutex
-	lockTrack         sync.RWMutex
-	lockTrackTx       sync.RWMutex
-	lockUser          sync.RWMutex
-	lockUserTx        sync.RWMutex
+	lockNews               sync.RWMutex
+	lockNewsTx             sync.RWMutex
+	lockProgrammingBlock   sync.RWMutex
+	lockProgrammingBlockTx sync.RWMutex
+	lockQueue              sync.RWMutex
+	lockQueueTx            sync.RWMutex
+	lockRelay              sync.RWMutex
+	lockRelayTx            sync.RWMutex
+	lockRequest            sync.RWMutex
+	lockRequestTx          sync.RWMutex
+	lockSchedule           sync.RWMutex
+	lockScheduleTx         sync.RWMutex
+	lockSessions           sync.RWMutex
+	lockSessionsTx         sync.RWMutex
+	lockSong               sync.RWMutex
+	lockSongTx             sync.RWMutex
+	lockStatus             sync.RWMutex
+	lockSubmissions        sync.RWMutex
+	lockSubmissionsTx      sync.RWMutex
+	lockTrack              sync.RWMutex
+	lockTrackTx            sync.RWMutex
+	lockUser               sync.RWMutex
+	lockUserTx             sync.RWMutex
+	lockWebhook            sync.RWMutex
+	lockWebhookTx          sync.RWMutex
 }
 
 // News calls NewsFunc.
@@ -1693,6 +2089,74 @@ func (mock *StorageServiceMock) NewsTxCalls() []struct {
 	return calls
 }
 
+// ProgrammingBlock calls ProgrammingBlockFunc.
+func (mock *StorageServiceMock) ProgrammingBlock(contextMoqParam context.Context) radio.ProgrammingBlockStorage {
+	if mock.ProgrammingBlockFunc == nil {
+		panic("StorageServiceMock.ProgrammingBlockFunc: method is nil but StorageService.ProgrammingBlock was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockProgrammingBlock.Lock()
+	mock.calls.ProgrammingBlock = append(mock.calls.ProgrammingBlock, callInfo)
+	mock.lockProgrammingBlock.Unlock()
+	return mock.ProgrammingBlockFunc(contextMoqParam)
+}
+
+// ProgrammingBlockCalls gets all the calls that were made to ProgrammingBlock.
+// Check the length with:
+//
+//	len(mockedStorageService.ProgrammingBlockCalls())
+func (mock *StorageServiceMock) ProgrammingBlockCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockProgrammingBlock.RLock()
+	calls = mock.calls.ProgrammingBlock
+	mock.lockProgrammingBlock.RUnlock()
+	return calls
+}
+
+// ProgrammingBlockTx calls ProgrammingBlockTxFunc.
+func (mock *StorageServiceMock) ProgrammingBlockTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ProgrammingBlockStorage, radio.StorageTx, error) {
+	if mock.ProgrammingBlockTxFunc == nil {
+		panic("StorageServiceMock.ProgrammingBlockTxFunc: method is nil but StorageService.ProgrammingBlockTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockProgrammingBlockTx.Lock()
+	mock.calls.ProgrammingBlockTx = append(mock.calls.ProgrammingBlockTx, callInfo)
+	mock.lockProgrammingBlockTx.Unlock()
+	return mock.ProgrammingBlockTxFunc(contextMoqParam, storageTx)
+}
+
+// ProgrammingBlockTxCalls gets all the calls that were made to ProgrammingBlockTx.
+// Check the length with:
+//
+//	len(mockedStorageService.ProgrammingBlockTxCalls())
+func (mock *StorageServiceMock) ProgrammingBlockTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockProgrammingBlockTx.RLock()
+	calls = mock.calls.ProgrammingBlockTx
+	mock.lockProgrammingBlockTx.RUnlock()
+	return calls
+}
+
 // Queue calls QueueFunc.
 func (mock *StorageServiceMock) Queue(contextMoqParam context.Context) radio.QueueStorage {
 	if mock.QueueFunc == nil {
@@ -2337,13 +2801,81 @@ func (mock *StorageServiceMock) UserTxCalls() []struct {
 	return calls
 }
 
-// Ensure, that SessionStorageServiceMock does implement radio.SessionStorageService.
-// If this is not the case, regenerate this file with moq.
-var _ radio.SessionStorageService = &SessionStorageServiceMock{}
+// Webhook calls WebhookFunc.
+func (mock *StorageServiceMock) Webhook(contextMoqParam context.Context) radio.WebhookStorage {
+	if mock.WebhookFunc == nil {
+		panic("StorageServiceMock.WebhookFunc: method is nil but StorageService.Webhook was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockWebhook.Lock()
+	mock.calls.Webhook = append(mock.calls.Webhook, callInfo)
+	mock.lockWebhook.Unlock()
+	return mock.WebhookFunc(contextMoqParam)
+}
 
-// SessionStorageServiceMock is a mock implementation of radio.SessionStorageService.
+// WebhookCalls gets all the calls that were made to Webhook.
+// Check the length with:
 //
-//	func TestSomethingThatUsesSessionStorageService(t *testing.T) {
+//	len(mockedStorageService.WebhookCalls())
+func (mock *StorageServiceMock) WebhookCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockWebhook.RLock()
+	calls = mock.calls.Webhook
+	mock.lockWebhook.RUnlock()
+	return calls
+}
+
+// WebhookTx calls WebhookTxFunc.
+func (mock *StorageServiceMock) WebhookTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.WebhookStorage, radio.StorageTx, error) {
+	if mock.WebhookTxFunc == nil {
+		panic("StorageServiceMock.WebhookTxFunc: method is nil but StorageService.WebhookTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockWebhookTx.Lock()
+	mock.calls.WebhookTx = append(mock.calls.WebhookTx, callInfo)
+	mock.lockWebhookTx.Unlock()
+	return mock.WebhookTxFunc(contextMoqParam, storageTx)
+}
+
+// WebhookTxCalls gets all the calls that were made to WebhookTx.
+// Check the length with:
+//
+//	len(mockedStorageService.WebhookTxCalls())
+func (mock *StorageServiceMock) WebhookTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockWebhookTx.RLock()
+	calls = mock.calls.WebhookTx
+	mock.lockWebhookTx.RUnlock()
+	return calls
+}
+
+// Ensure, that SessionStorageServiceMock does implement radio.SessionStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.SessionStorageService = &SessionStorageServiceMock{}
+
+// SessionStorageServiceMock is a mock implementation of radio.SessionStorageService.
+//
+//	func TestSomethingThatUsesSessionStorageService(t *testing.T) {
 //
 //		// make and configure a mocked radio.SessionStorageService
 //		mockedSessionStorageService := &SessionStorageServiceMock{
@@ -2968,7 +3500,7 @@ var _ radio.SongStorage = &SongStorageMock{}
 //			AddFavoriteFunc: func(song radio.Song, nick string) (bool, error) {
 //				panic("mock out the AddFavorite method")
 //			},
-//			AddPlayFunc: func(song radio.Song, streamer radio.User, ldiff *int64) error {
+//			AddPlayFunc: func(song radio.Song, streamer radio.User, ldiff *int64, curve radio.ListenerCurve) error {
 //				panic("mock out the AddPlay method")
 //			},
 //			CreateFunc: func(song radio.Song) (*radio.Song, error) {
@@ -2995,12 +3527,24 @@ var _ radio.SongStorage = &SongStorageMock{}
 //			LastPlayedCountFunc: func() (int64, error) {
 //				panic("mock out the LastPlayedCount method")
 //			},
+//			ListenerCurvesFunc: func(song radio.Song, limit int64) ([]radio.PlayCurve, error) {
+//				panic("mock out the ListenerCurves method")
+//			},
 //			PlayedCountFunc: func(song radio.Song) (int64, error) {
 //				panic("mock out the PlayedCount method")
 //			},
 //			RemoveFavoriteFunc: func(song radio.Song, nick string) (bool, error) {
 //				panic("mock out the RemoveFavorite method")
 //			},
+//			RetentionFunc: func(song radio.Song) (radio.ListenerRetention, error) {
+//				panic("mock out the Retention method")
+//			},
+//			RetentionByDJFunc: func(since time.Time) ([]radio.DJRetention, error) {
+//				panic("mock out the RetentionByDJ method")
+//			},
+//			RetentionBySongFunc: func(since time.Time, limit int64) ([]radio.SongRetention, error) {
+//				panic("mock out the RetentionBySong method")
+//			},
 //			UpdateHashLinkFunc: func(entry radio.SongHash, hashLink radio.SongHash) error {
 //				panic("mock out the UpdateHashLink method")
 //			},
@@ -3018,7 +3562,7 @@ type SongStorageMock struct {
 	AddFavoriteFunc func(song radio.Song, nick string) (bool, error)
 
 	// AddPlayFunc mocks the AddPlay method.
-	AddPlayFunc func(song radio.Song, streamer radio.User, ldiff *int64) error
+	AddPlayFunc func(song radio.Song, streamer radio.User, ldiff *int64, curve radio.ListenerCurve) error
 
 	// CreateFunc mocks the Create method.
 	CreateFunc func(song radio.Song) (*radio.Song, error)
@@ -3044,12 +3588,24 @@ type SongStorageMock struct {
 	// LastPlayedCountFunc mocks the LastPlayedCount method.
 	LastPlayedCountFunc func() (int64, error)
 
+	// ListenerCurvesFunc mocks the ListenerCurves method.
+	ListenerCurvesFunc func(song radio.Song, limit int64) ([]radio.PlayCurve, error)
+
 	// PlayedCountFunc mocks the PlayedCount method.
 	PlayedCountFunc func(song radio.Song) (int64, error)
 
 	// RemoveFavoriteFunc mocks the RemoveFavorite method.
 	RemoveFavoriteFunc func(song radio.Song, nick string) (bool, error)
 
+	// RetentionFunc mocks the Retention method.
+	RetentionFunc func(song radio.Song) (radio.ListenerRetention, error)
+
+	// RetentionByDJFunc mocks the RetentionByDJ method.
+	RetentionByDJFunc func(since time.Time) ([]radio.DJRetention, error)
+
+	// RetentionBySongFunc mocks the RetentionBySong method.
+	RetentionBySongFunc func(since time.Time, limit int64) ([]radio.SongRetention, error)
+
 	// UpdateHashLinkFunc mocks the UpdateHashLink method.
 	UpdateHashLinkFunc func(entry radio.SongHash, hashLink radio.SongHash) error
 
@@ -3073,6 +3629,8 @@ type SongStorageMock struct {
 			Streamer radio.User
 			// Ldiff is the ldiff argument value.
 			Ldiff *int64
+			// Curve is the curve argument value.
+			Curve radio.ListenerCurve
 		}
 		// Create holds details about calls to the Create method.
 		Create []struct {
@@ -3118,6 +3676,13 @@ type SongStorageMock struct {
 		// LastPlayedCount holds details about calls to the LastPlayedCount method.
 		LastPlayedCount []struct {
 		}
+		// ListenerCurves holds details about calls to the ListenerCurves method.
+		ListenerCurves []struct {
+			// Song is the song argument value.
+			Song radio.Song
+			// Limit is the limit argument value.
+			Limit int64
+		}
 		// PlayedCount holds details about calls to the PlayedCount method.
 		PlayedCount []struct {
 			// Song is the song argument value.
@@ -3130,6 +3695,23 @@ type SongStorageMock struct {
 			// Nick is the nick argument value.
 			Nick string
 		}
+		// Retention holds details about calls to the Retention method.
+		Retention []struct {
+			// Song is the song argument value.
+			Song radio.Song
+		}
+		// RetentionByDJ holds details about calls to the RetentionByDJ method.
+		RetentionByDJ []struct {
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// RetentionBySong holds details about calls to the RetentionBySong method.
+		RetentionBySong []struct {
+			// Since is the since argument value.
+			Since time.Time
+			// Limit is the limit argument value.
+			Limit int64
+		}
 		// UpdateHashLink holds details about calls to the UpdateHashLink method.
 		UpdateHashLink []struct {
 			// Entry is the entry argument value.
@@ -3155,8 +3737,12 @@ type SongStorageMock struct {
 	lockFromMetadata    sync.RWMutex
 	lockLastPlayed      sync.RWMutex
 	lockLastPlayedCount sync.RWMutex
+	lockListenerCurves  sync.RWMutex
 	lockPlayedCount     sync.RWMutex
 	lockRemoveFavorite  sync.RWMutex
+	lockRetention       sync.RWMutex
+	lockRetentionByDJ   sync.RWMutex
+	lockRetentionBySong sync.RWMutex
 	lockUpdateHashLink  sync.RWMutex
 	lockUpdateLength    sync.RWMutex
 }
@@ -3198,7 +3784,7 @@ func (mock *SongStorageMock) AddFavoriteCalls() []struct {
 }
 
 // AddPlay calls AddPlayFunc.
-func (mock *SongStorageMock) AddPlay(song radio.Song, streamer radio.User, ldiff *int64) error {
+func (mock *SongStorageMock) AddPlay(song radio.Song, streamer radio.User, ldiff *int64, curve radio.ListenerCurve) error {
 	if mock.AddPlayFunc == nil {
 		panic("SongStorageMock.AddPlayFunc: method is nil but SongStorage.AddPlay was just called")
 	}
@@ -3206,15 +3792,17 @@ func (mock *SongStorageMock) AddPlay(song radio.Song, streamer radio.User, ldiff
 		Song     radio.Song
 		Streamer radio.User
 		Ldiff    *int64
+		Curve    radio.ListenerCurve
 	}{
 		Song:     song,
 		Streamer: streamer,
 		Ldiff:    ldiff,
+		Curve:    curve,
 	}
 	mock.lockAddPlay.Lock()
 	mock.calls.AddPlay = append(mock.calls.AddPlay, callInfo)
 	mock.lockAddPlay.Unlock()
-	return mock.AddPlayFunc(song, streamer, ldiff)
+	return mock.AddPlayFunc(song, streamer, ldiff, curve)
 }
 
 // AddPlayCalls gets all the calls that were made to AddPlay.
@@ -3225,11 +3813,13 @@ func (mock *SongStorageMock) AddPlayCalls() []struct {
 	Song     radio.Song
 	Streamer radio.User
 	Ldiff    *int64
+	Curve    radio.ListenerCurve
 } {
 	var calls []struct {
 		Song     radio.Song
 		Streamer radio.User
 		Ldiff    *int64
+		Curve    radio.ListenerCurve
 	}
 	mock.lockAddPlay.RLock()
 	calls = mock.calls.AddPlay
@@ -3500,6 +4090,42 @@ func (mock *SongStorageMock) LastPlayedCountCalls() []struct {
 	return calls
 }
 
+// ListenerCurves calls ListenerCurvesFunc.
+func (mock *SongStorageMock) ListenerCurves(song radio.Song, limit int64) ([]radio.PlayCurve, error) {
+	if mock.ListenerCurvesFunc == nil {
+		panic("SongStorageMock.ListenerCurvesFunc: method is nil but SongStorage.ListenerCurves was just called")
+	}
+	callInfo := struct {
+		Song  radio.Song
+		Limit int64
+	}{
+		Song:  song,
+		Limit: limit,
+	}
+	mock.lockListenerCurves.Lock()
+	mock.calls.ListenerCurves = append(mock.calls.ListenerCurves, callInfo)
+	mock.lockListenerCurves.Unlock()
+	return mock.ListenerCurvesFunc(song, limit)
+}
+
+// ListenerCurvesCalls gets all the calls that were made to ListenerCurves.
+// Check the length with:
+//
+//	len(mockedSongStorage.ListenerCurvesCalls())
+func (mock *SongStorageMock) ListenerCurvesCalls() []struct {
+	Song  radio.Song
+	Limit int64
+} {
+	var calls []struct {
+		Song  radio.Song
+		Limit int64
+	}
+	mock.lockListenerCurves.RLock()
+	calls = mock.calls.ListenerCurves
+	mock.lockListenerCurves.RUnlock()
+	return calls
+}
+
 // PlayedCount calls PlayedCountFunc.
 func (mock *SongStorageMock) PlayedCount(song radio.Song) (int64, error) {
 	if mock.PlayedCountFunc == nil {
@@ -3568,6 +4194,106 @@ func (mock *SongStorageMock) RemoveFavoriteCalls() []struct {
 	return calls
 }
 
+// Retention calls RetentionFunc.
+func (mock *SongStorageMock) Retention(song radio.Song) (radio.ListenerRetention, error) {
+	if mock.RetentionFunc == nil {
+		panic("SongStorageMock.RetentionFunc: method is nil but SongStorage.Retention was just called")
+	}
+	callInfo := struct {
+		Song radio.Song
+	}{
+		Song: song,
+	}
+	mock.lockRetention.Lock()
+	mock.calls.Retention = append(mock.calls.Retention, callInfo)
+	mock.lockRetention.Unlock()
+	return mock.RetentionFunc(song)
+}
+
+// RetentionCalls gets all the calls that were made to Retention.
+// Check the length with:
+//
+//	len(mockedSongStorage.RetentionCalls())
+func (mock *SongStorageMock) RetentionCalls() []struct {
+	Song radio.Song
+} {
+	var calls []struct {
+		Song radio.Song
+	}
+	mock.lockRetention.RLock()
+	calls = mock.calls.Retention
+	mock.lockRetention.RUnlock()
+	return calls
+}
+
+// RetentionByDJ calls RetentionByDJFunc.
+func (mock *SongStorageMock) RetentionByDJ(since time.Time) ([]radio.DJRetention, error) {
+	if mock.RetentionByDJFunc == nil {
+		panic("SongStorageMock.RetentionByDJFunc: method is nil but SongStorage.RetentionByDJ was just called")
+	}
+	callInfo := struct {
+		Since time.Time
+	}{
+		Since: since,
+	}
+	mock.lockRetentionByDJ.Lock()
+	mock.calls.RetentionByDJ = append(mock.calls.RetentionByDJ, callInfo)
+	mock.lockRetentionByDJ.Unlock()
+	return mock.RetentionByDJFunc(since)
+}
+
+// RetentionByDJCalls gets all the calls that were made to RetentionByDJ.
+// Check the length with:
+//
+//	len(mockedSongStorage.RetentionByDJCalls())
+func (mock *SongStorageMock) RetentionByDJCalls() []struct {
+	Since time.Time
+} {
+	var calls []struct {
+		Since time.Time
+	}
+	mock.lockRetentionByDJ.RLock()
+	calls = mock.calls.RetentionByDJ
+	mock.lockRetentionByDJ.RUnlock()
+	return calls
+}
+
+// RetentionBySong calls RetentionBySongFunc.
+func (mock *SongStorageMock) RetentionBySong(since time.Time, limit int64) ([]radio.SongRetention, error) {
+	if mock.RetentionBySongFunc == nil {
+		panic("SongStorageMock.RetentionBySongFunc: method is nil but SongStorage.RetentionBySong was just called")
+	}
+	callInfo := struct {
+		Since time.Time
+		Limit int64
+	}{
+		Since: since,
+		Limit: limit,
+	}
+	mock.lockRetentionBySong.Lock()
+	mock.calls.RetentionBySong = append(mock.calls.RetentionBySong, callInfo)
+	mock.lockRetentionBySong.Unlock()
+	return mock.RetentionBySongFunc(since, limit)
+}
+
+// RetentionBySongCalls gets all the calls that were made to RetentionBySong.
+// Check the length with:
+//
+//	len(mockedSongStorage.RetentionBySongCalls())
+func (mock *SongStorageMock) RetentionBySongCalls() []struct {
+	Since time.Time
+	Limit int64
+} {
+	var calls []struct {
+		Since time.Time
+		Limit int64
+	}
+	mock.lockRetentionBySong.RLock()
+	calls = mock.calls.RetentionBySong
+	mock.lockRetentionBySong.RUnlock()
+	return calls
+}
+
 // UpdateHashLink calls UpdateHashLinkFunc.
 func (mock *SongStorageMock) UpdateHashLink(entry radio.SongHash, hashLink radio.SongHash) error {
 	if mock.UpdateHashLinkFunc == nil {
@@ -3781,21 +4507,33 @@ var _ radio.TrackStorage = &TrackStorageMock{}
 //			GetFunc: func(trackID radio.TrackID) (*radio.Song, error) {
 //				panic("mock out the Get method")
 //			},
+//			GetWithFavoritesFunc: func(trackIDs []radio.TrackID) ([]radio.FavoritedSong, error) {
+//				panic("mock out the GetWithFavorites method")
+//			},
 //			InsertFunc: func(song radio.Song) (radio.TrackID, error) {
 //				panic("mock out the Insert method")
 //			},
 //			QueueCandidatesFunc: func() ([]radio.TrackID, error) {
 //				panic("mock out the QueueCandidates method")
 //			},
+//			UnmeasuredFunc: func(limit int64) ([]radio.Song, error) {
+//				panic("mock out the Unmeasured method")
+//			},
 //			UnusableFunc: func() ([]radio.Song, error) {
 //				panic("mock out the Unusable method")
 //			},
+//			UpdateCueFunc: func(id radio.TrackID, in time.Duration, out time.Duration) error {
+//				panic("mock out the UpdateCue method")
+//			},
 //			UpdateLastPlayedFunc: func(trackID radio.TrackID) error {
 //				panic("mock out the UpdateLastPlayed method")
 //			},
 //			UpdateLastRequestedFunc: func(trackID radio.TrackID) error {
 //				panic("mock out the UpdateLastRequested method")
 //			},
+//			UpdateLoudnessFunc: func(trackID radio.TrackID, loudness radio.Loudness) error {
+//				panic("mock out the UpdateLoudness method")
+//			},
 //			UpdateMetadataFunc: func(song radio.Song) error {
 //				panic("mock out the UpdateMetadata method")
 //			},
@@ -3827,21 +4565,33 @@ type TrackStorageMock struct {
 	// GetFunc mocks the Get method.
 	GetFunc func(trackID radio.TrackID) (*radio.Song, error)
 
+	// GetWithFavoritesFunc mocks the GetWithFavorites method.
+	GetWithFavoritesFunc func(trackIDs []radio.TrackID) ([]radio.FavoritedSong, error)
+
 	// InsertFunc mocks the Insert method.
 	InsertFunc func(song radio.Song) (radio.TrackID, error)
 
 	// QueueCandidatesFunc mocks the QueueCandidates method.
 	QueueCandidatesFunc func() ([]radio.TrackID, error)
 
+	// UnmeasuredFunc mocks the Unmeasured method.
+	UnmeasuredFunc func(limit int64) ([]radio.Song, error)
+
 	// UnusableFunc mocks the Unusable method.
 	UnusableFunc func() ([]radio.Song, error)
 
+	// UpdateCueFunc mocks the UpdateCue method.
+	UpdateCueFunc func(id radio.TrackID, in time.Duration, out time.Duration) error
+
 	// UpdateLastPlayedFunc mocks the UpdateLastPlayed method.
 	UpdateLastPlayedFunc func(trackID radio.TrackID) error
 
 	// UpdateLastRequestedFunc mocks the UpdateLastRequested method.
 	UpdateLastRequestedFunc func(trackID radio.TrackID) error
 
+	// UpdateLoudnessFunc mocks the UpdateLoudness method.
+	UpdateLoudnessFunc func(trackID radio.TrackID, loudness radio.Loudness) error
+
 	// UpdateMetadataFunc mocks the UpdateMetadata method.
 	UpdateMetadataFunc func(song radio.Song) error
 
@@ -3876,6 +4626,11 @@ type TrackStorageMock struct {
 			// TrackID is the trackID argument value.
 			TrackID radio.TrackID
 		}
+		// GetWithFavorites holds details about calls to the GetWithFavorites method.
+		GetWithFavorites []struct {
+			// TrackIDs is the trackIDs argument value.
+			TrackIDs []radio.TrackID
+		}
 		// Insert holds details about calls to the Insert method.
 		Insert []struct {
 			// Song is the song argument value.
@@ -3884,9 +4639,23 @@ type TrackStorageMock struct {
 		// QueueCandidates holds details about calls to the QueueCandidates method.
 		QueueCandidates []struct {
 		}
+		// Unmeasured holds details about calls to the Unmeasured method.
+		Unmeasured []struct {
+			// Limit is the limit argument value.
+			Limit int64
+		}
 		// Unusable holds details about calls to the Unusable method.
 		Unusable []struct {
 		}
+		// UpdateCue holds details about calls to the UpdateCue method.
+		UpdateCue []struct {
+			// ID is the id argument value.
+			ID radio.TrackID
+			// In is the in argument value.
+			In time.Duration
+			// Out is the out argument value.
+			Out time.Duration
+		}
 		// UpdateLastPlayed holds details about calls to the UpdateLastPlayed method.
 		UpdateLastPlayed []struct {
 			// TrackID is the trackID argument value.
@@ -3897,6 +4666,13 @@ type TrackStorageMock struct {
 			// TrackID is the trackID argument value.
 			TrackID radio.TrackID
 		}
+		// UpdateLoudness holds details about calls to the UpdateLoudness method.
+		UpdateLoudness []struct {
+			// TrackID is the trackID argument value.
+			TrackID radio.TrackID
+			// Loudness is the loudness argument value.
+			Loudness radio.Loudness
+		}
 		// UpdateMetadata holds details about calls to the UpdateMetadata method.
 		UpdateMetadata []struct {
 			// Song is the song argument value.
@@ -3920,11 +4696,15 @@ type TrackStorageMock struct {
 	lockDecrementRequestCount sync.RWMutex
 	lockDelete                sync.RWMutex
 	lockGet                   sync.RWMutex
+	lockGetWithFavorites      sync.RWMutex
 	lockInsert                sync.RWMutex
 	lockQueueCandidates       sync.RWMutex
+	lockUnmeasured            sync.RWMutex
 	lockUnusable              sync.RWMutex
+	lockUpdateCue             sync.RWMutex
 	lockUpdateLastPlayed      sync.RWMutex
 	lockUpdateLastRequested   sync.RWMutex
+	lockUpdateLoudness        sync.RWMutex
 	lockUpdateMetadata        sync.RWMutex
 	lockUpdateRequestInfo     sync.RWMutex
 	lockUpdateUsable          sync.RWMutex
@@ -4085,6 +4865,38 @@ func (mock *TrackStorageMock) GetCalls() []struct {
 	return calls
 }
 
+// GetWithFavorites calls GetWithFavoritesFunc.
+func (mock *TrackStorageMock) GetWithFavorites(trackIDs []radio.TrackID) ([]radio.FavoritedSong, error) {
+	if mock.GetWithFavoritesFunc == nil {
+		panic("TrackStorageMock.GetWithFavoritesFunc: method is nil but TrackStorage.GetWithFavorites was just called")
+	}
+	callInfo := struct {
+		TrackIDs []radio.TrackID
+	}{
+		TrackIDs: trackIDs,
+	}
+	mock.lockGetWithFavorites.Lock()
+	mock.calls.GetWithFavorites = append(mock.calls.GetWithFavorites, callInfo)
+	mock.lockGetWithFavorites.Unlock()
+	return mock.GetWithFavoritesFunc(trackIDs)
+}
+
+// GetWithFavoritesCalls gets all the calls that were made to GetWithFavorites.
+// Check the length with:
+//
+//	len(mockedTrackStorage.GetWithFavoritesCalls())
+func (mock *TrackStorageMock) GetWithFavoritesCalls() []struct {
+	TrackIDs []radio.TrackID
+} {
+	var calls []struct {
+		TrackIDs []radio.TrackID
+	}
+	mock.lockGetWithFavorites.RLock()
+	calls = mock.calls.GetWithFavorites
+	mock.lockGetWithFavorites.RUnlock()
+	return calls
+}
+
 // Insert calls InsertFunc.
 func (mock *TrackStorageMock) Insert(song radio.Song) (radio.TrackID, error) {
 	if mock.InsertFunc == nil {
@@ -4144,6 +4956,38 @@ func (mock *TrackStorageMock) QueueCandidatesCalls() []struct {
 	return calls
 }
 
+// Unmeasured calls UnmeasuredFunc.
+func (mock *TrackStorageMock) Unmeasured(limit int64) ([]radio.Song, error) {
+	if mock.UnmeasuredFunc == nil {
+		panic("TrackStorageMock.UnmeasuredFunc: method is nil but TrackStorage.Unmeasured was just called")
+	}
+	callInfo := struct {
+		Limit int64
+	}{
+		Limit: limit,
+	}
+	mock.lockUnmeasured.Lock()
+	mock.calls.Unmeasured = append(mock.calls.Unmeasured, callInfo)
+	mock.lockUnmeasured.Unlock()
+	return mock.UnmeasuredFunc(limit)
+}
+
+// UnmeasuredCalls gets all the calls that were made to Unmeasured.
+// Check the length with:
+//
+//	len(mockedTrackStorage.UnmeasuredCalls())
+func (mock *TrackStorageMock) UnmeasuredCalls() []struct {
+	Limit int64
+} {
+	var calls []struct {
+		Limit int64
+	}
+	mock.lockUnmeasured.RLock()
+	calls = mock.calls.Unmeasured
+	mock.lockUnmeasured.RUnlock()
+	return calls
+}
+
 // Unusable calls UnusableFunc.
 func (mock *TrackStorageMock) Unusable() ([]radio.Song, error) {
 	if mock.UnusableFunc == nil {
@@ -4171,6 +5015,46 @@ func (mock *TrackStorageMock) UnusableCalls() []struct {
 	return calls
 }
 
+// UpdateCue calls UpdateCueFunc.
+func (mock *TrackStorageMock) UpdateCue(id radio.TrackID, in time.Duration, out time.Duration) error {
+	if mock.UpdateCueFunc == nil {
+		panic("TrackStorageMock.UpdateCueFunc: method is nil but TrackStorage.UpdateCue was just called")
+	}
+	callInfo := struct {
+		ID  radio.TrackID
+		In  time.Duration
+		Out time.Duration
+	}{
+		ID:  id,
+		In:  in,
+		Out: out,
+	}
+	mock.lockUpdateCue.Lock()
+	mock.calls.UpdateCue = append(mock.calls.UpdateCue, callInfo)
+	mock.lockUpdateCue.Unlock()
+	return mock.UpdateCueFunc(id, in, out)
+}
+
+// UpdateCueCalls gets all the calls that were made to UpdateCue.
+// Check the length with:
+//
+//	len(mockedTrackStorage.UpdateCueCalls())
+func (mock *TrackStorageMock) UpdateCueCalls() []struct {
+	ID  radio.TrackID
+	In  time.Duration
+	Out time.Duration
+} {
+	var calls []struct {
+		ID  radio.TrackID
+		In  time.Duration
+		Out time.Duration
+	}
+	mock.lockUpdateCue.RLock()
+	calls = mock.calls.UpdateCue
+	mock.lockUpdateCue.RUnlock()
+	return calls
+}
+
 // UpdateLastPlayed calls UpdateLastPlayedFunc.
 func (mock *TrackStorageMock) UpdateLastPlayed(trackID radio.TrackID) error {
 	if mock.UpdateLastPlayedFunc == nil {
@@ -4235,6 +5119,42 @@ func (mock *TrackStorageMock) UpdateLastRequestedCalls() []struct {
 	return calls
 }
 
+// UpdateLoudness calls UpdateLoudnessFunc.
+func (mock *TrackStorageMock) UpdateLoudness(trackID radio.TrackID, loudness radio.Loudness) error {
+	if mock.UpdateLoudnessFunc == nil {
+		panic("TrackStorageMock.UpdateLoudnessFunc: method is nil but TrackStorage.UpdateLoudness was just called")
+	}
+	callInfo := struct {
+		TrackID  radio.TrackID
+		Loudness radio.Loudness
+	}{
+		TrackID:  trackID,
+		Loudness: loudness,
+	}
+	mock.lockUpdateLoudness.Lock()
+	mock.calls.UpdateLoudness = append(mock.calls.UpdateLoudness, callInfo)
+	mock.lockUpdateLoudness.Unlock()
+	return mock.UpdateLoudnessFunc(trackID, loudness)
+}
+
+// UpdateLoudnessCalls gets all the calls that were made to UpdateLoudness.
+// Check the length with:
+//
+//	len(mockedTrackStorage.UpdateLoudnessCalls())
+func (mock *TrackStorageMock) UpdateLoudnessCalls() []struct {
+	TrackID  radio.TrackID
+	Loudness radio.Loudness
+} {
+	var calls []struct {
+		TrackID  radio.TrackID
+		Loudness radio.Loudness
+	}
+	mock.lockUpdateLoudness.RLock()
+	calls = mock.calls.UpdateLoudness
+	mock.lockUpdateLoudness.RUnlock()
+	return calls
+}
+
 // UpdateMetadata calls UpdateMetadataFunc.
 func (mock *TrackStorageMock) UpdateMetadata(song radio.Song) error {
 	if mock.UpdateMetadataFunc == nil {
@@ -4461,9 +5381,15 @@ var _ radio.RequestStorage = &RequestStorageMock{}
 //
 //		// make and configure a mocked radio.RequestStorage
 //		mockedRequestStorage := &RequestStorageMock{
+//			AllRequestTimesFunc: func(since time.Time) ([]time.Time, error) {
+//				panic("mock out the AllRequestTimes method")
+//			},
 //			LastRequestFunc: func(identifier string) (time.Time, error) {
 //				panic("mock out the LastRequest method")
 //			},
+//			RequestTimesFunc: func(identifier string, since time.Time) ([]time.Time, error) {
+//				panic("mock out the RequestTimes method")
+//			},
 //			UpdateLastRequestFunc: func(identifier string) error {
 //				panic("mock out the UpdateLastRequest method")
 //			},
@@ -4474,29 +5400,81 @@ var _ radio.RequestStorage = &RequestStorageMock{}
 //
 //	}
 type RequestStorageMock struct {
+	// AllRequestTimesFunc mocks the AllRequestTimes method.
+	AllRequestTimesFunc func(since time.Time) ([]time.Time, error)
+
 	// LastRequestFunc mocks the LastRequest method.
 	LastRequestFunc func(identifier string) (time.Time, error)
 
+	// RequestTimesFunc mocks the RequestTimes method.
+	RequestTimesFunc func(identifier string, since time.Time) ([]time.Time, error)
+
 	// UpdateLastRequestFunc mocks the UpdateLastRequest method.
 	UpdateLastRequestFunc func(identifier string) error
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AllRequestTimes holds details about calls to the AllRequestTimes method.
+		AllRequestTimes []struct {
+			// Since is the since argument value.
+			Since time.Time
+		}
 		// LastRequest holds details about calls to the LastRequest method.
 		LastRequest []struct {
 			// Identifier is the identifier argument value.
 			Identifier string
 		}
+		// RequestTimes holds details about calls to the RequestTimes method.
+		RequestTimes []struct {
+			// Identifier is the identifier argument value.
+			Identifier string
+			// Since is the since argument value.
+			Since time.Time
+		}
 		// UpdateLastRequest holds details about calls to the UpdateLastRequest method.
 		UpdateLastRequest []struct {
 			// Identifier is the identifier argument value.
 			Identifier string
 		}
 	}
+	lockAllRequestTimes   sync.RWMutex
 	lockLastRequest       sync.RWMutex
+	lockRequestTimes      sync.RWMutex
 	lockUpdateLastRequest sync.RWMutex
 }
 
+// AllRequestTimes calls AllRequestTimesFunc.
+func (mock *RequestStorageMock) AllRequestTimes(since time.Time) ([]time.Time, error) {
+	if mock.AllRequestTimesFunc == nil {
+		panic("RequestStorageMock.AllRequestTimesFunc: method is nil but RequestStorage.AllRequestTimes was just called")
+	}
+	callInfo := struct {
+		Since time.Time
+	}{
+		Since: since,
+	}
+	mock.lockAllRequestTimes.Lock()
+	mock.calls.AllRequestTimes = append(mock.calls.AllRequestTimes, callInfo)
+	mock.lockAllRequestTimes.Unlock()
+	return mock.AllRequestTimesFunc(since)
+}
+
+// AllRequestTimesCalls gets all the calls that were made to AllRequestTimes.
+// Check the length with:
+//
+//	len(mockedRequestStorage.AllRequestTimesCalls())
+func (mock *RequestStorageMock) AllRequestTimesCalls() []struct {
+	Since time.Time
+} {
+	var calls []struct {
+		Since time.Time
+	}
+	mock.lockAllRequestTimes.RLock()
+	calls = mock.calls.AllRequestTimes
+	mock.lockAllRequestTimes.RUnlock()
+	return calls
+}
+
 // LastRequest calls LastRequestFunc.
 func (mock *RequestStorageMock) LastRequest(identifier string) (time.Time, error) {
 	if mock.LastRequestFunc == nil {
@@ -4529,6 +5507,42 @@ func (mock *RequestStorageMock) LastRequestCalls() []struct {
 	return calls
 }
 
+// RequestTimes calls RequestTimesFunc.
+func (mock *RequestStorageMock) RequestTimes(identifier string, since time.Time) ([]time.Time, error) {
+	if mock.RequestTimesFunc == nil {
+		panic("RequestStorageMock.RequestTimesFunc: method is nil but RequestStorage.RequestTimes was just called")
+	}
+	callInfo := struct {
+		Identifier string
+		Since      time.Time
+	}{
+		Identifier: identifier,
+		Since:      since,
+	}
+	mock.lockRequestTimes.Lock()
+	mock.calls.RequestTimes = append(mock.calls.RequestTimes, callInfo)
+	mock.lockRequestTimes.Unlock()
+	return mock.RequestTimesFunc(identifier, since)
+}
+
+// RequestTimesCalls gets all the calls that were made to RequestTimes.
+// Check the length with:
+//
+//	len(mockedRequestStorage.RequestTimesCalls())
+func (mock *RequestStorageMock) RequestTimesCalls() []struct {
+	Identifier string
+	Since      time.Time
+} {
+	var calls []struct {
+		Identifier string
+		Since      time.Time
+	}
+	mock.lockRequestTimes.RLock()
+	calls = mock.calls.RequestTimes
+	mock.lockRequestTimes.RUnlock()
+	return calls
+}
+
 // UpdateLastRequest calls UpdateLastRequestFunc.
 func (mock *RequestStorageMock) UpdateLastRequest(identifier string) error {
 	if mock.UpdateLastRequestFunc == nil {
@@ -4699,6 +5713,9 @@ var _ radio.UserStorage = &UserStorageMock{}
 //			CreateDJFunc: func(user radio.User, dJ radio.DJ) (radio.DJID, error) {
 //				panic("mock out the CreateDJ method")
 //			},
+//			CreateStreamKeyFunc: func(streamKey radio.StreamKey) (radio.StreamKeyID, error) {
+//				panic("mock out the CreateStreamKey method")
+//			},
 //			GetFunc: func(name string) (*radio.User, error) {
 //				panic("mock out the Get method")
 //			},
@@ -4708,6 +5725,9 @@ var _ radio.UserStorage = &UserStorageMock{}
 //			GetByIDFunc: func(userID radio.UserID) (*radio.User, error) {
 //				panic("mock out the GetByID method")
 //			},
+//			GetByStreamKeyFunc: func(hash string) (*radio.User, *radio.StreamKey, error) {
+//				panic("mock out the GetByStreamKey method")
+//			},
 //			LookupNameFunc: func(name string) (*radio.User, error) {
 //				panic("mock out the LookupName method")
 //			},
@@ -4717,6 +5737,12 @@ var _ radio.UserStorage = &UserStorageMock{}
 //			RecordListenersFunc: func(n int64, user radio.User) error {
 //				panic("mock out the RecordListeners method")
 //			},
+//			RevokeStreamKeyFunc: func(userID radio.UserID, streamKeyID radio.StreamKeyID) error {
+//				panic("mock out the RevokeStreamKey method")
+//			},
+//			StreamKeysFunc: func(userID radio.UserID) ([]radio.StreamKey, error) {
+//				panic("mock out the StreamKeys method")
+//			},
 //			UpdateFunc: func(user radio.User) (radio.User, error) {
 //				panic("mock out the Update method")
 //			},
@@ -4739,6 +5765,9 @@ type UserStorageMock struct {
 	// CreateDJFunc mocks the CreateDJ method.
 	CreateDJFunc func(user radio.User, dJ radio.DJ) (radio.DJID, error)
 
+	// CreateStreamKeyFunc mocks the CreateStreamKey method.
+	CreateStreamKeyFunc func(streamKey radio.StreamKey) (radio.StreamKeyID, error)
+
 	// GetFunc mocks the Get method.
 	GetFunc func(name string) (*radio.User, error)
 
@@ -4748,6 +5777,9 @@ type UserStorageMock struct {
 	// GetByIDFunc mocks the GetByID method.
 	GetByIDFunc func(userID radio.UserID) (*radio.User, error)
 
+	// GetByStreamKeyFunc mocks the GetByStreamKey method.
+	GetByStreamKeyFunc func(hash string) (*radio.User, *radio.StreamKey, error)
+
 	// LookupNameFunc mocks the LookupName method.
 	LookupNameFunc func(name string) (*radio.User, error)
 
@@ -4757,6 +5789,12 @@ type UserStorageMock struct {
 	// RecordListenersFunc mocks the RecordListeners method.
 	RecordListenersFunc func(n int64, user radio.User) error
 
+	// RevokeStreamKeyFunc mocks the RevokeStreamKey method.
+	RevokeStreamKeyFunc func(userID radio.UserID, streamKeyID radio.StreamKeyID) error
+
+	// StreamKeysFunc mocks the StreamKeys method.
+	StreamKeysFunc func(userID radio.UserID) ([]radio.StreamKey, error)
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(user radio.User) (radio.User, error)
 
@@ -4782,6 +5820,11 @@ type UserStorageMock struct {
 			// DJ is the dJ argument value.
 			DJ radio.DJ
 		}
+		// CreateStreamKey holds details about calls to the CreateStreamKey method.
+		CreateStreamKey []struct {
+			// StreamKey is the streamKey argument value.
+			StreamKey radio.StreamKey
+		}
 		// Get holds details about calls to the Get method.
 		Get []struct {
 			// Name is the name argument value.
@@ -4797,6 +5840,11 @@ type UserStorageMock struct {
 			// UserID is the userID argument value.
 			UserID radio.UserID
 		}
+		// GetByStreamKey holds details about calls to the GetByStreamKey method.
+		GetByStreamKey []struct {
+			// Hash is the hash argument value.
+			Hash string
+		}
 		// LookupName holds details about calls to the LookupName method.
 		LookupName []struct {
 			// Name is the name argument value.
@@ -4812,6 +5860,18 @@ type UserStorageMock struct {
 			// User is the user argument value.
 			User radio.User
 		}
+		// RevokeStreamKey holds details about calls to the RevokeStreamKey method.
+		RevokeStreamKey []struct {
+			// UserID is the userID argument value.
+			UserID radio.UserID
+			// StreamKeyID is the streamKeyID argument value.
+			StreamKeyID radio.StreamKeyID
+		}
+		// StreamKeys holds details about calls to the StreamKeys method.
+		StreamKeys []struct {
+			// UserID is the userID argument value.
+			UserID radio.UserID
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// User is the user argument value.
@@ -4822,12 +5882,16 @@ type UserStorageMock struct {
 	lockByNick          sync.RWMutex
 	lockCreate          sync.RWMutex
 	lockCreateDJ        sync.RWMutex
+	lockCreateStreamKey sync.RWMutex
 	lockGet             sync.RWMutex
 	lockGetByDJID       sync.RWMutex
 	lockGetByID         sync.RWMutex
+	lockGetByStreamKey  sync.RWMutex
 	lockLookupName      sync.RWMutex
 	lockPermissions     sync.RWMutex
 	lockRecordListeners sync.RWMutex
+	lockRevokeStreamKey sync.RWMutex
+	lockStreamKeys      sync.RWMutex
 	lockUpdate          sync.RWMutex
 }
 
@@ -4958,13 +6022,45 @@ func (mock *UserStorageMock) CreateDJCalls() []struct {
 	return calls
 }
 
-// Get calls GetFunc.
-func (mock *UserStorageMock) Get(name string) (*radio.User, error) {
-	if mock.GetFunc == nil {
-		panic("UserStorageMock.GetFunc: method is nil but UserStorage.Get was just called")
+// CreateStreamKey calls CreateStreamKeyFunc.
+func (mock *UserStorageMock) CreateStreamKey(streamKey radio.StreamKey) (radio.StreamKeyID, error) {
+	if mock.CreateStreamKeyFunc == nil {
+		panic("UserStorageMock.CreateStreamKeyFunc: method is nil but UserStorage.CreateStreamKey was just called")
 	}
 	callInfo := struct {
-		Name string
+		StreamKey radio.StreamKey
+	}{
+		StreamKey: streamKey,
+	}
+	mock.lockCreateStreamKey.Lock()
+	mock.calls.CreateStreamKey = append(mock.calls.CreateStreamKey, callInfo)
+	mock.lockCreateStreamKey.Unlock()
+	return mock.CreateStreamKeyFunc(streamKey)
+}
+
+// CreateStreamKeyCalls gets all the calls that were made to CreateStreamKey.
+// Check the length with:
+//
+//	len(mockedUserStorage.CreateStreamKeyCalls())
+func (mock *UserStorageMock) CreateStreamKeyCalls() []struct {
+	StreamKey radio.StreamKey
+} {
+	var calls []struct {
+		StreamKey radio.StreamKey
+	}
+	mock.lockCreateStreamKey.RLock()
+	calls = mock.calls.CreateStreamKey
+	mock.lockCreateStreamKey.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *UserStorageMock) Get(name string) (*radio.User, error) {
+	if mock.GetFunc == nil {
+		panic("UserStorageMock.GetFunc: method is nil but UserStorage.Get was just called")
+	}
+	callInfo := struct {
+		Name string
 	}{
 		Name: name,
 	}
@@ -5054,6 +6150,38 @@ func (mock *UserStorageMock) GetByIDCalls() []struct {
 	return calls
 }
 
+// GetByStreamKey calls GetByStreamKeyFunc.
+func (mock *UserStorageMock) GetByStreamKey(hash string) (*radio.User, *radio.StreamKey, error) {
+	if mock.GetByStreamKeyFunc == nil {
+		panic("UserStorageMock.GetByStreamKeyFunc: method is nil but UserStorage.GetByStreamKey was just called")
+	}
+	callInfo := struct {
+		Hash string
+	}{
+		Hash: hash,
+	}
+	mock.lockGetByStreamKey.Lock()
+	mock.calls.GetByStreamKey = append(mock.calls.GetByStreamKey, callInfo)
+	mock.lockGetByStreamKey.Unlock()
+	return mock.GetByStreamKeyFunc(hash)
+}
+
+// GetByStreamKeyCalls gets all the calls that were made to GetByStreamKey.
+// Check the length with:
+//
+//	len(mockedUserStorage.GetByStreamKeyCalls())
+func (mock *UserStorageMock) GetByStreamKeyCalls() []struct {
+	Hash string
+} {
+	var calls []struct {
+		Hash string
+	}
+	mock.lockGetByStreamKey.RLock()
+	calls = mock.calls.GetByStreamKey
+	mock.lockGetByStreamKey.RUnlock()
+	return calls
+}
+
 // LookupName calls LookupNameFunc.
 func (mock *UserStorageMock) LookupName(name string) (*radio.User, error) {
 	if mock.LookupNameFunc == nil {
@@ -5149,6 +6277,74 @@ func (mock *UserStorageMock) RecordListenersCalls() []struct {
 	return calls
 }
 
+// RevokeStreamKey calls RevokeStreamKeyFunc.
+func (mock *UserStorageMock) RevokeStreamKey(userID radio.UserID, streamKeyID radio.StreamKeyID) error {
+	if mock.RevokeStreamKeyFunc == nil {
+		panic("UserStorageMock.RevokeStreamKeyFunc: method is nil but UserStorage.RevokeStreamKey was just called")
+	}
+	callInfo := struct {
+		UserID      radio.UserID
+		StreamKeyID radio.StreamKeyID
+	}{
+		UserID:      userID,
+		StreamKeyID: streamKeyID,
+	}
+	mock.lockRevokeStreamKey.Lock()
+	mock.calls.RevokeStreamKey = append(mock.calls.RevokeStreamKey, callInfo)
+	mock.lockRevokeStreamKey.Unlock()
+	return mock.RevokeStreamKeyFunc(userID, streamKeyID)
+}
+
+// RevokeStreamKeyCalls gets all the calls that were made to RevokeStreamKey.
+// Check the length with:
+//
+//	len(mockedUserStorage.RevokeStreamKeyCalls())
+func (mock *UserStorageMock) RevokeStreamKeyCalls() []struct {
+	UserID      radio.UserID
+	StreamKeyID radio.StreamKeyID
+} {
+	var calls []struct {
+		UserID      radio.UserID
+		StreamKeyID radio.StreamKeyID
+	}
+	mock.lockRevokeStreamKey.RLock()
+	calls = mock.calls.RevokeStreamKey
+	mock.lockRevokeStreamKey.RUnlock()
+	return calls
+}
+
+// StreamKeys calls StreamKeysFunc.
+func (mock *UserStorageMock) StreamKeys(userID radio.UserID) ([]radio.StreamKey, error) {
+	if mock.StreamKeysFunc == nil {
+		panic("UserStorageMock.StreamKeysFunc: method is nil but UserStorage.StreamKeys was just called")
+	}
+	callInfo := struct {
+		UserID radio.UserID
+	}{
+		UserID: userID,
+	}
+	mock.lockStreamKeys.Lock()
+	mock.calls.StreamKeys = append(mock.calls.StreamKeys, callInfo)
+	mock.lockStreamKeys.Unlock()
+	return mock.StreamKeysFunc(userID)
+}
+
+// StreamKeysCalls gets all the calls that were made to StreamKeys.
+// Check the length with:
+//
+//	len(mockedUserStorage.StreamKeysCalls())
+func (mock *UserStorageMock) StreamKeysCalls() []struct {
+	UserID radio.UserID
+} {
+	var calls []struct {
+		UserID radio.UserID
+	}
+	mock.lockStreamKeys.RLock()
+	calls = mock.calls.StreamKeys
+	mock.lockStreamKeys.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *UserStorageMock) Update(user radio.User) (radio.User, error) {
 	if mock.UpdateFunc == nil {
@@ -6784,3 +7980,586 @@ func (mock *ScheduleStorageMock) UpdateCalls() []struct {
 	mock.lockUpdate.RUnlock()
 	return calls
 }
+
+// Ensure, that ProgrammingBlockStorageServiceMock does implement radio.ProgrammingBlockStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.ProgrammingBlockStorageService = &ProgrammingBlockStorageServiceMock{}
+
+// ProgrammingBlockStorageServiceMock is a mock implementation of radio.ProgrammingBlockStorageService.
+//
+//	func TestSomethingThatUsesProgrammingBlockStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.ProgrammingBlockStorageService
+//		mockedProgrammingBlockStorageService := &ProgrammingBlockStorageServiceMock{
+//			ProgrammingBlockFunc: func(contextMoqParam context.Context) radio.ProgrammingBlockStorage {
+//				panic("mock out the ProgrammingBlock method")
+//			},
+//			ProgrammingBlockTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ProgrammingBlockStorage, radio.StorageTx, error) {
+//				panic("mock out the ProgrammingBlockTx method")
+//			},
+//		}
+//
+//		// use mockedProgrammingBlockStorageService in code that requires radio.ProgrammingBlockStorageService
+//		// and then make assertions.
+//
+//	}
+type ProgrammingBlockStorageServiceMock struct {
+	// ProgrammingBlockFunc mocks the ProgrammingBlock method.
+	ProgrammingBlockFunc func(contextMoqParam context.Context) radio.ProgrammingBlockStorage
+
+	// ProgrammingBlockTxFunc mocks the ProgrammingBlockTx method.
+	ProgrammingBlockTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ProgrammingBlockStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ProgrammingBlock holds details about calls to the ProgrammingBlock method.
+		ProgrammingBlock []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// ProgrammingBlockTx holds details about calls to the ProgrammingBlockTx method.
+		ProgrammingBlockTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockProgrammingBlock   sync.RWMutex
+	lockProgrammingBlockTx sync.RWMutex
+}
+
+// ProgrammingBlock calls ProgrammingBlockFunc.
+func (mock *ProgrammingBlockStorageServiceMock) ProgrammingBlock(contextMoqParam context.Context) radio.ProgrammingBlockStorage {
+	if mock.ProgrammingBlockFunc == nil {
+		panic("ProgrammingBlockStorageServiceMock.ProgrammingBlockFunc: method is nil but ProgrammingBlockStorageService.ProgrammingBlock was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockProgrammingBlock.Lock()
+	mock.calls.ProgrammingBlock = append(mock.calls.ProgrammingBlock, callInfo)
+	mock.lockProgrammingBlock.Unlock()
+	return mock.ProgrammingBlockFunc(contextMoqParam)
+}
+
+// ProgrammingBlockCalls gets all the calls that were made to ProgrammingBlock.
+// Check the length with:
+//
+//	len(mockedProgrammingBlockStorageService.ProgrammingBlockCalls())
+func (mock *ProgrammingBlockStorageServiceMock) ProgrammingBlockCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockProgrammingBlock.RLock()
+	calls = mock.calls.ProgrammingBlock
+	mock.lockProgrammingBlock.RUnlock()
+	return calls
+}
+
+// ProgrammingBlockTx calls ProgrammingBlockTxFunc.
+func (mock *ProgrammingBlockStorageServiceMock) ProgrammingBlockTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.ProgrammingBlockStorage, radio.StorageTx, error) {
+	if mock.ProgrammingBlockTxFunc == nil {
+		panic("ProgrammingBlockStorageServiceMock.ProgrammingBlockTxFunc: method is nil but ProgrammingBlockStorageService.ProgrammingBlockTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockProgrammingBlockTx.Lock()
+	mock.calls.ProgrammingBlockTx = append(mock.calls.ProgrammingBlockTx, callInfo)
+	mock.lockProgrammingBlockTx.Unlock()
+	return mock.ProgrammingBlockTxFunc(contextMoqParam, storageTx)
+}
+
+// ProgrammingBlockTxCalls gets all the calls that were made to ProgrammingBlockTx.
+// Check the length with:
+//
+//	len(mockedProgrammingBlockStorageService.ProgrammingBlockTxCalls())
+func (mock *ProgrammingBlockStorageServiceMock) ProgrammingBlockTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockProgrammingBlockTx.RLock()
+	calls = mock.calls.ProgrammingBlockTx
+	mock.lockProgrammingBlockTx.RUnlock()
+	return calls
+}
+
+// Ensure, that ProgrammingBlockStorageMock does implement radio.ProgrammingBlockStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.ProgrammingBlockStorage = &ProgrammingBlockStorageMock{}
+
+// ProgrammingBlockStorageMock is a mock implementation of radio.ProgrammingBlockStorage.
+//
+//	func TestSomethingThatUsesProgrammingBlockStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.ProgrammingBlockStorage
+//		mockedProgrammingBlockStorage := &ProgrammingBlockStorageMock{
+//			AllFunc: func() ([]radio.ProgrammingBlock, error) {
+//				panic("mock out the All method")
+//			},
+//			CandidatesFunc: func(programmingBlock radio.ProgrammingBlock) ([]radio.TrackID, error) {
+//				panic("mock out the Candidates method")
+//			},
+//			CreateFunc: func(programmingBlock radio.ProgrammingBlock) (radio.ProgrammingBlockID, error) {
+//				panic("mock out the Create method")
+//			},
+//			DeleteFunc: func(programmingBlockID radio.ProgrammingBlockID) error {
+//				panic("mock out the Delete method")
+//			},
+//			UpdateFunc: func(programmingBlock radio.ProgrammingBlock) error {
+//				panic("mock out the Update method")
+//			},
+//		}
+//
+//		// use mockedProgrammingBlockStorage in code that requires radio.ProgrammingBlockStorage
+//		// and then make assertions.
+//
+//	}
+type ProgrammingBlockStorageMock struct {
+	// AllFunc mocks the All method.
+	AllFunc func() ([]radio.ProgrammingBlock, error)
+
+	// CandidatesFunc mocks the Candidates method.
+	CandidatesFunc func(programmingBlock radio.ProgrammingBlock) ([]radio.TrackID, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(programmingBlock radio.ProgrammingBlock) (radio.ProgrammingBlockID, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(programmingBlockID radio.ProgrammingBlockID) error
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(programmingBlock radio.ProgrammingBlock) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// All holds details about calls to the All method.
+		All []struct {
+		}
+		// Candidates holds details about calls to the Candidates method.
+		Candidates []struct {
+			// ProgrammingBlock is the programmingBlock argument value.
+			ProgrammingBlock radio.ProgrammingBlock
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// ProgrammingBlock is the programmingBlock argument value.
+			ProgrammingBlock radio.ProgrammingBlock
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// ProgrammingBlockID is the programmingBlockID argument value.
+			ProgrammingBlockID radio.ProgrammingBlockID
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// ProgrammingBlock is the programmingBlock argument value.
+			ProgrammingBlock radio.ProgrammingBlock
+		}
+	}
+	lockAll        sync.RWMutex
+	lockCandidates sync.RWMutex
+	lockCreate     sync.RWMutex
+	lockDelete     sync.RWMutex
+	lockUpdate     sync.RWMutex
+}
+
+// All calls AllFunc.
+func (mock *ProgrammingBlockStorageMock) All() ([]radio.ProgrammingBlock, error) {
+	if mock.AllFunc == nil {
+		panic("ProgrammingBlockStorageMock.AllFunc: method is nil but ProgrammingBlockStorage.All was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockAll.Lock()
+	mock.calls.All = append(mock.calls.All, callInfo)
+	mock.lockAll.Unlock()
+	return mock.AllFunc()
+}
+
+// AllCalls gets all the calls that were made to All.
+// Check the length with:
+//
+//	len(mockedProgrammingBlockStorage.AllCalls())
+func (mock *ProgrammingBlockStorageMock) AllCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockAll.RLock()
+	calls = mock.calls.All
+	mock.lockAll.RUnlock()
+	return calls
+}
+
+// Candidates calls CandidatesFunc.
+func (mock *ProgrammingBlockStorageMock) Candidates(programmingBlock radio.ProgrammingBlock) ([]radio.TrackID, error) {
+	if mock.CandidatesFunc == nil {
+		panic("ProgrammingBlockStorageMock.CandidatesFunc: method is nil but ProgrammingBlockStorage.Candidates was just called")
+	}
+	callInfo := struct {
+		ProgrammingBlock radio.ProgrammingBlock
+	}{
+		ProgrammingBlock: programmingBlock,
+	}
+	mock.lockCandidates.Lock()
+	mock.calls.Candidates = append(mock.calls.Candidates, callInfo)
+	mock.lockCandidates.Unlock()
+	return mock.CandidatesFunc(programmingBlock)
+}
+
+// CandidatesCalls gets all the calls that were made to Candidates.
+// Check the length with:
+//
+//	len(mockedProgrammingBlockStorage.CandidatesCalls())
+func (mock *ProgrammingBlockStorageMock) CandidatesCalls() []struct {
+	ProgrammingBlock radio.ProgrammingBlock
+} {
+	var calls []struct {
+		ProgrammingBlock radio.ProgrammingBlock
+	}
+	mock.lockCandidates.RLock()
+	calls = mock.calls.Candidates
+	mock.lockCandidates.RUnlock()
+	return calls
+}
+
+// Create calls CreateFunc.
+func (mock *ProgrammingBlockStorageMock) Create(programmingBlock radio.ProgrammingBlock) (radio.ProgrammingBlockID, error) {
+	if mock.CreateFunc == nil {
+		panic("ProgrammingBlockStorageMock.CreateFunc: method is nil but ProgrammingBlockStorage.Create was just called")
+	}
+	callInfo := struct {
+		ProgrammingBlock radio.ProgrammingBlock
+	}{
+		ProgrammingBlock: programmingBlock,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(programmingBlock)
+}
+
+// CreateCalls gets all the calls that were made to Create.
+// Check the length with:
+//
+//	len(mockedProgrammingBlockStorage.CreateCalls())
+func (mock *ProgrammingBlockStorageMock) CreateCalls() []struct {
+	ProgrammingBlock radio.ProgrammingBlock
+} {
+	var calls []struct {
+		ProgrammingBlock radio.ProgrammingBlock
+	}
+	mock.lockCreate.RLock()
+	calls = mock.calls.Create
+	mock.lockCreate.RUnlock()
+	return calls
+}
+
+// Delete calls DeleteFunc.
+func (mock *ProgrammingBlockStorageMock) Delete(programmingBlockID radio.ProgrammingBlockID) error {
+	if mock.DeleteFunc == nil {
+		panic("ProgrammingBlockStorageMock.DeleteFunc: method is nil but ProgrammingBlockStorage.Delete was just called")
+	}
+	callInfo := struct {
+		ProgrammingBlockID radio.ProgrammingBlockID
+	}{
+		ProgrammingBlockID: programmingBlockID,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(programmingBlockID)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedProgrammingBlockStorage.DeleteCalls())
+func (mock *ProgrammingBlockStorageMock) DeleteCalls() []struct {
+	ProgrammingBlockID radio.ProgrammingBlockID
+} {
+	var calls []struct {
+		ProgrammingBlockID radio.ProgrammingBlockID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *ProgrammingBlockStorageMock) Update(programmingBlock radio.ProgrammingBlock) error {
+	if mock.UpdateFunc == nil {
+		panic("ProgrammingBlockStorageMock.UpdateFunc: method is nil but ProgrammingBlockStorage.Update was just called")
+	}
+	callInfo := struct {
+		ProgrammingBlock radio.ProgrammingBlock
+	}{
+		ProgrammingBlock: programmingBlock,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(programmingBlock)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedProgrammingBlockStorage.UpdateCalls())
+func (mock *ProgrammingBlockStorageMock) UpdateCalls() []struct {
+	ProgrammingBlock radio.ProgrammingBlock
+} {
+	var calls []struct {
+		ProgrammingBlock radio.ProgrammingBlock
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
+
+// Ensure, that WebhookStorageServiceMock does implement radio.WebhookStorageService.
+// If this is not the case, regenerate this file with moq.
+var _ radio.WebhookStorageService = &WebhookStorageServiceMock{}
+
+// WebhookStorageServiceMock is a mock implementation of radio.WebhookStorageService.
+//
+//	func TestSomethingThatUsesWebhookStorageService(t *testing.T) {
+//
+//		// make and configure a mocked radio.WebhookStorageService
+//		mockedWebhookStorageService := &WebhookStorageServiceMock{
+//			WebhookFunc: func(contextMoqParam context.Context) radio.WebhookStorage {
+//				panic("mock out the Webhook method")
+//			},
+//			WebhookTxFunc: func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.WebhookStorage, radio.StorageTx, error) {
+//				panic("mock out the WebhookTx method")
+//			},
+//		}
+//
+//		// use mockedWebhookStorageService in code that requires radio.WebhookStorageService
+//		// and then make assertions.
+//
+//	}
+type WebhookStorageServiceMock struct {
+	// WebhookFunc mocks the Webhook method.
+	WebhookFunc func(contextMoqParam context.Context) radio.WebhookStorage
+
+	// WebhookTxFunc mocks the WebhookTx method.
+	WebhookTxFunc func(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.WebhookStorage, radio.StorageTx, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Webhook holds details about calls to the Webhook method.
+		Webhook []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// WebhookTx holds details about calls to the WebhookTx method.
+		WebhookTx []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StorageTx is the storageTx argument value.
+			StorageTx radio.StorageTx
+		}
+	}
+	lockWebhook   sync.RWMutex
+	lockWebhookTx sync.RWMutex
+}
+
+// Webhook calls WebhookFunc.
+func (mock *WebhookStorageServiceMock) Webhook(contextMoqParam context.Context) radio.WebhookStorage {
+	if mock.WebhookFunc == nil {
+		panic("WebhookStorageServiceMock.WebhookFunc: method is nil but WebhookStorageService.Webhook was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockWebhook.Lock()
+	mock.calls.Webhook = append(mock.calls.Webhook, callInfo)
+	mock.lockWebhook.Unlock()
+	return mock.WebhookFunc(contextMoqParam)
+}
+
+// WebhookCalls gets all the calls that were made to Webhook.
+// Check the length with:
+//
+//	len(mockedWebhookStorageService.WebhookCalls())
+func (mock *WebhookStorageServiceMock) WebhookCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockWebhook.RLock()
+	calls = mock.calls.Webhook
+	mock.lockWebhook.RUnlock()
+	return calls
+}
+
+// WebhookTx calls WebhookTxFunc.
+func (mock *WebhookStorageServiceMock) WebhookTx(contextMoqParam context.Context, storageTx radio.StorageTx) (radio.WebhookStorage, radio.StorageTx, error) {
+	if mock.WebhookTxFunc == nil {
+		panic("WebhookStorageServiceMock.WebhookTxFunc: method is nil but WebhookStorageService.WebhookTx was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}{
+		ContextMoqParam: contextMoqParam,
+		StorageTx:       storageTx,
+	}
+	mock.lockWebhookTx.Lock()
+	mock.calls.WebhookTx = append(mock.calls.WebhookTx, callInfo)
+	mock.lockWebhookTx.Unlock()
+	return mock.WebhookTxFunc(contextMoqParam, storageTx)
+}
+
+// WebhookTxCalls gets all the calls that were made to WebhookTx.
+// Check the length with:
+//
+//	len(mockedWebhookStorageService.WebhookTxCalls())
+func (mock *WebhookStorageServiceMock) WebhookTxCalls() []struct {
+	ContextMoqParam context.Context
+	StorageTx       radio.StorageTx
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		StorageTx       radio.StorageTx
+	}
+	mock.lockWebhookTx.RLock()
+	calls = mock.calls.WebhookTx
+	mock.lockWebhookTx.RUnlock()
+	return calls
+}
+
+// Ensure, that WebhookStorageMock does implement radio.WebhookStorage.
+// If this is not the case, regenerate this file with moq.
+var _ radio.WebhookStorage = &WebhookStorageMock{}
+
+// WebhookStorageMock is a mock implementation of radio.WebhookStorage.
+//
+//	func TestSomethingThatUsesWebhookStorage(t *testing.T) {
+//
+//		// make and configure a mocked radio.WebhookStorage
+//		mockedWebhookStorage := &WebhookStorageMock{
+//			AddDeliveryFunc: func(webhookDelivery radio.WebhookDelivery) (radio.WebhookDeliveryID, error) {
+//				panic("mock out the AddDelivery method")
+//			},
+//			DeliveriesFunc: func(limit int64, offset int64) (radio.WebhookDeliveryList, error) {
+//				panic("mock out the Deliveries method")
+//			},
+//		}
+//
+//		// use mockedWebhookStorage in code that requires radio.WebhookStorage
+//		// and then make assertions.
+//
+//	}
+type WebhookStorageMock struct {
+	// AddDeliveryFunc mocks the AddDelivery method.
+	AddDeliveryFunc func(webhookDelivery radio.WebhookDelivery) (radio.WebhookDeliveryID, error)
+
+	// DeliveriesFunc mocks the Deliveries method.
+	DeliveriesFunc func(limit int64, offset int64) (radio.WebhookDeliveryList, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddDelivery holds details about calls to the AddDelivery method.
+		AddDelivery []struct {
+			// WebhookDelivery is the webhookDelivery argument value.
+			WebhookDelivery radio.WebhookDelivery
+		}
+		// Deliveries holds details about calls to the Deliveries method.
+		Deliveries []struct {
+			// Limit is the limit argument value.
+			Limit int64
+			// Offset is the offset argument value.
+			Offset int64
+		}
+	}
+	lockAddDelivery sync.RWMutex
+	lockDeliveries  sync.RWMutex
+}
+
+// AddDelivery calls AddDeliveryFunc.
+func (mock *WebhookStorageMock) AddDelivery(webhookDelivery radio.WebhookDelivery) (radio.WebhookDeliveryID, error) {
+	if mock.AddDeliveryFunc == nil {
+		panic("WebhookStorageMock.AddDeliveryFunc: method is nil but WebhookStorage.AddDelivery was just called")
+	}
+	callInfo := struct {
+		WebhookDelivery radio.WebhookDelivery
+	}{
+		WebhookDelivery: webhookDelivery,
+	}
+	mock.lockAddDelivery.Lock()
+	mock.calls.AddDelivery = append(mock.calls.AddDelivery, callInfo)
+	mock.lockAddDelivery.Unlock()
+	return mock.AddDeliveryFunc(webhookDelivery)
+}
+
+// AddDeliveryCalls gets all the calls that were made to AddDelivery.
+// Check the length with:
+//
+//	len(mockedWebhookStorage.AddDeliveryCalls())
+func (mock *WebhookStorageMock) AddDeliveryCalls() []struct {
+	WebhookDelivery radio.WebhookDelivery
+} {
+	var calls []struct {
+		WebhookDelivery radio.WebhookDelivery
+	}
+	mock.lockAddDelivery.RLock()
+	calls = mock.calls.AddDelivery
+	mock.lockAddDelivery.RUnlock()
+	return calls
+}
+
+// Deliveries calls DeliveriesFunc.
+func (mock *WebhookStorageMock) Deliveries(limit int64, offset int64) (radio.WebhookDeliveryList, error) {
+	if mock.DeliveriesFunc == nil {
+		panic("WebhookStorageMock.DeliveriesFunc: method is nil but WebhookStorage.Deliveries was just called")
+	}
+	callInfo := struct {
+		Limit  int64
+		Offset int64
+	}{
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockDeliveries.Lock()
+	mock.calls.Deliveries = append(mock.calls.Deliveries, callInfo)
+	mock.lockDeliveries.Unlock()
+	return mock.DeliveriesFunc(limit, offset)
+}
+
+// DeliveriesCalls gets all the calls that were made to Deliveries.
+// Check the length with:
+//
+//	len(mockedWebhookStorage.DeliveriesCalls())
+func (mock *WebhookStorageMock) DeliveriesCalls() []struct {
+	Limit  int64
+	Offset int64
+} {
+	var calls []struct {
+		Limit  int64
+		Offset int64
+	}
+	mock.lockDeliveries.RLock()
+	calls = mock.calls.Deliveries
+	mock.lockDeliveries.RUnlock()
+	return calls
+}
@@ -0,0 +1,128 @@
+// Package policy implements the rules for who can request songs and how often
+package policy
+
+import (
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+)
+
+// RequestPolicy decides if a song can be requested, it uses the configuration
+// as it is at the time of each call
+type RequestPolicy struct {
+	config.Config
+}
+
+// NewRequestPolicy returns a RequestPolicy using the configuration given
+func NewRequestPolicy(cfg config.Config) RequestPolicy {
+	return RequestPolicy{cfg}
+}
+
+// SongDelay returns the delay between two requests of the song given
+func (p RequestPolicy) SongDelay(song radio.Song) time.Duration {
+	return song.RequestDelay(p.Conf().Requests.Song.Curve())
+}
+
+// SongCooldown returns the time until the song given can be requested again
+// and true if it can be requested right now
+func (p RequestPolicy) SongCooldown(song radio.Song) (time.Duration, bool) {
+	return p.Conf().Requests.Song.Curve().Cooldown(&song)
+}
+
+// UserCooldown returns the time until the requester given can request again
+// and true if they can request right now
+func (p RequestPolicy) UserCooldown(rs radio.RequestStorage, requester radio.Requester) (time.Duration, bool, error) {
+	const op errors.Op = "policy/RequestPolicy.UserCooldown"
+
+	cfg := p.Conf()
+	if requester.Staff && cfg.Requests.StaffExempt {
+		return 0, true, nil
+	}
+
+	window := time.Duration(cfg.UserRequestDelay)
+	now := time.Now()
+
+	times, err := rs.RequestTimes(requester.Identifier, now.Add(-window))
+	if err != nil {
+		return 0, false, errors.E(op, err)
+	}
+
+	d, ok := RateLimit(times, cfg.Requests.UserLimit, window, now)
+	return d, ok, nil
+}
+
+// GlobalCooldown returns the time until anyone can request again and true if
+// requests can be made right now, queued should be the amount of requests
+// currently in the queue
+func (p RequestPolicy) GlobalCooldown(rs radio.RequestStorage, requester radio.Requester, queued int) (time.Duration, bool, error) {
+	const op errors.Op = "policy/RequestPolicy.GlobalCooldown"
+
+	cfg := p.Conf().Requests
+	if cfg.GlobalLimit <= 0 || queued < cfg.QueueFull {
+		return 0, true, nil
+	}
+	if requester.Staff && cfg.StaffExempt {
+		return 0, true, nil
+	}
+
+	window := time.Duration(cfg.GlobalWindow)
+	now := time.Now()
+
+	times, err := rs.AllRequestTimes(now.Add(-window))
+	if err != nil {
+		return 0, false, errors.E(op, err)
+	}
+
+	d, ok := RateLimit(times, cfg.GlobalLimit, window, now)
+	return d, ok, nil
+}
+
+// Check checks if the requester given can request the song given, it returns
+// an error of kind UserCooldown, RequestsLimited or SongCooldown with the
+// delay attached if they can't. queued should be the amount of requests
+// currently in the queue
+func (p RequestPolicy) Check(rs radio.RequestStorage, song radio.Song, requester radio.Requester, queued int) error {
+	const op errors.Op = "policy/RequestPolicy.Check"
+
+	d, ok, err := p.UserCooldown(rs, requester)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !ok {
+		return errors.E(op, errors.UserCooldown, errors.Delay(d), song)
+	}
+
+	d, ok, err = p.GlobalCooldown(rs, requester, queued)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !ok {
+		return errors.E(op, errors.RequestsLimited, errors.Delay(d), song)
+	}
+
+	d, ok = p.SongCooldown(song)
+	if !ok {
+		return errors.E(op, errors.SongCooldown, errors.Delay(d), song)
+	}
+	return nil
+}
+
+// RateLimit returns the time until another event is allowed and true if one
+// is allowed right now, when at most limit events can happen within window.
+// times should be ordered from newest to oldest, a limit of zero or less
+// means there is no limit
+func RateLimit(times []time.Time, limit int, window time.Duration, now time.Time) (time.Duration, bool) {
+	if limit <= 0 || len(times) < limit {
+		return 0, true
+	}
+
+	// the oldest event that still counts towards the limit has to leave the
+	// window before another is allowed
+	d := times[limit-1].Add(window).Sub(now)
+	if d <= 0 {
+		return 0, true
+	}
+	return d, false
+}
@@ -0,0 +1,328 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	now := time.Now()
+	window := time.Hour
+
+	t.Run("no limit", func(t *testing.T) {
+		times := []time.Time{now, now, now}
+		_, ok := RateLimit(times, 0, window, now)
+		assert.True(t, ok)
+	})
+
+	t.Run("under the limit", func(t *testing.T) {
+		times := []time.Time{now.Add(-time.Minute)}
+		_, ok := RateLimit(times, 2, window, now)
+		assert.True(t, ok)
+	})
+
+	t.Run("at the limit", func(t *testing.T) {
+		times := []time.Time{now.Add(-time.Minute), now.Add(-time.Minute * 20)}
+		d, ok := RateLimit(times, 2, window, now)
+		assert.False(t, ok)
+		assert.Equal(t, time.Minute*40, d)
+	})
+
+	t.Run("oldest left the window", func(t *testing.T) {
+		times := []time.Time{now.Add(-time.Minute), now.Add(-time.Hour * 2)}
+		_, ok := RateLimit(times, 2, window, now)
+		assert.True(t, ok)
+	})
+}
+
+// policyTest is a test case for the cooldowns of RequestPolicy
+type policyTest struct {
+	name string
+	// configuration, UserRequestDelay is an hour and GlobalWindow ten minutes
+	userLimit   int
+	globalLimit int
+	queueFull   int
+	staffExempt bool
+
+	staff  bool
+	queued int
+	song   radio.Song
+	// request times returned by storage, as time before now
+	userTimes []time.Duration
+	allTimes  []time.Duration
+	// errors returned by storage
+	userErr error
+	allErr  error
+
+	// kind is the kind of error expected, Other for no error
+	kind errors.Kind
+	// delay is the delay expected with the error
+	delay time.Duration
+	// userCalls and allCalls are the amount of calls to storage expected
+	userCalls int
+	allCalls  int
+}
+
+func (pt policyTest) policy() RequestPolicy {
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.UserRequestDelay = config.Duration(time.Hour)
+	c.Requests.UserLimit = pt.userLimit
+	c.Requests.GlobalLimit = pt.globalLimit
+	c.Requests.GlobalWindow = config.Duration(time.Minute * 10)
+	c.Requests.QueueFull = pt.queueFull
+	c.Requests.StaffExempt = pt.staffExempt
+	cfg.StoreConf(c)
+	return NewRequestPolicy(cfg)
+}
+
+func (pt policyTest) storage(now time.Time) *mocks.RequestStorageMock {
+	var times = func(ds []time.Duration) []time.Time {
+		var res []time.Time
+		for _, d := range ds {
+			res = append(res, now.Add(-d))
+		}
+		return res
+	}
+
+	return &mocks.RequestStorageMock{
+		RequestTimesFunc: func(identifier string, since time.Time) ([]time.Time, error) {
+			return times(pt.userTimes), pt.userErr
+		},
+		AllRequestTimesFunc: func(since time.Time) ([]time.Time, error) {
+			return times(pt.allTimes), pt.allErr
+		},
+	}
+}
+
+// freshSong returns a song that has never been requested or played
+func freshSong() radio.Song {
+	return radio.Song{DatabaseTrack: &radio.DatabaseTrack{TrackID: 1}}
+}
+
+// requestedSong returns a song that was requested just now
+func requestedSong() radio.Song {
+	song := freshSong()
+	song.LastRequested = time.Now()
+	return song
+}
+
+var policyTests = []policyTest{
+	{
+		name:      "allowed",
+		userLimit: 1, globalLimit: 2, queueFull: 10,
+		song:      freshSong(),
+		userCalls: 1,
+	},
+	{
+		name:      "user cooldown",
+		userLimit: 1, staffExempt: true,
+		song:      freshSong(),
+		userTimes: []time.Duration{time.Minute * 10},
+		kind:      errors.UserCooldown,
+		delay:     time.Minute * 50,
+		userCalls: 1,
+	},
+	{
+		name:      "user below limit",
+		userLimit: 2,
+		song:      freshSong(),
+		userTimes: []time.Duration{time.Minute * 10},
+		userCalls: 1,
+	},
+	{
+		name:      "user cooldown staff exempt",
+		userLimit: 1, staffExempt: true,
+		staff:     true,
+		song:      freshSong(),
+		userTimes: []time.Duration{time.Minute * 10},
+	},
+	{
+		name:      "user cooldown staff not exempt",
+		userLimit: 1,
+		staff:     true,
+		song:      freshSong(),
+		userTimes: []time.Duration{time.Minute * 10},
+		kind:      errors.UserCooldown,
+		delay:     time.Minute * 50,
+		userCalls: 1,
+	},
+	{
+		name:      "global queue not full",
+		userLimit: 1, globalLimit: 2, queueFull: 10,
+		queued:    9,
+		song:      freshSong(),
+		allTimes:  []time.Duration{time.Minute, time.Minute * 2},
+		userCalls: 1,
+	},
+	{
+		name:      "global limited",
+		userLimit: 1, globalLimit: 2, queueFull: 10,
+		queued:    10,
+		song:      freshSong(),
+		allTimes:  []time.Duration{time.Minute, time.Minute * 2},
+		kind:      errors.RequestsLimited,
+		delay:     time.Minute * 8,
+		userCalls: 1, allCalls: 1,
+	},
+	{
+		name:      "global below limit",
+		userLimit: 1, globalLimit: 2, queueFull: 10,
+		queued:    10,
+		song:      freshSong(),
+		allTimes:  []time.Duration{time.Minute},
+		userCalls: 1, allCalls: 1,
+	},
+	{
+		name:      "global no limit",
+		userLimit: 1, queueFull: 10,
+		queued:    20,
+		song:      freshSong(),
+		allTimes:  []time.Duration{time.Minute, time.Minute * 2},
+		userCalls: 1,
+	},
+	{
+		name:      "global limited staff exempt",
+		userLimit: 1, globalLimit: 2, queueFull: 10, staffExempt: true,
+		staff:    true,
+		queued:   10,
+		song:     freshSong(),
+		allTimes: []time.Duration{time.Minute, time.Minute * 2},
+	},
+	{
+		name:      "global limited staff not exempt",
+		userLimit: 1, globalLimit: 2, queueFull: 10,
+		staff:     true,
+		queued:    10,
+		song:      freshSong(),
+		allTimes:  []time.Duration{time.Minute, time.Minute * 2},
+		kind:      errors.RequestsLimited,
+		delay:     time.Minute * 8,
+		userCalls: 1, allCalls: 1,
+	},
+	{
+		name:      "song cooldown",
+		userLimit: 1, staffExempt: true,
+		song:      requestedSong(),
+		kind:      errors.SongCooldown,
+		delay:     radio.DefaultRequestCurve.Delay(0),
+		userCalls: 1,
+	},
+	{
+		name:      "song cooldown staff",
+		userLimit: 1, staffExempt: true,
+		staff: true,
+		song:  requestedSong(),
+		kind:  errors.SongCooldown,
+		delay: radio.DefaultRequestCurve.Delay(0),
+	},
+	{
+		name:      "user storage error",
+		userLimit: 1, globalLimit: 2, queueFull: 10,
+		song:      freshSong(),
+		userErr:   errors.E(errors.Testing),
+		kind:      errors.Testing,
+		userCalls: 1,
+	},
+	{
+		name:      "global storage error",
+		userLimit: 1, globalLimit: 2, queueFull: 10,
+		queued:    10,
+		song:      freshSong(),
+		allErr:    errors.E(errors.Testing),
+		kind:      errors.Testing,
+		userCalls: 1, allCalls: 1,
+	},
+}
+
+func TestRequestPolicyCheck(t *testing.T) {
+	for _, pt := range policyTests {
+		t.Run(pt.name, func(t *testing.T) {
+			rs := pt.storage(time.Now())
+			requester := radio.Requester{Identifier: "127.0.0.1", Staff: pt.staff}
+
+			err := pt.policy().Check(rs, pt.song, requester, pt.queued)
+			if pt.kind == errors.Other {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.True(t, errors.Is(pt.kind, err), "expected %s, got %s", pt.kind, err)
+			}
+
+			if pt.delay > 0 {
+				delay, ok := errors.SelectDelay(err)
+				require.True(t, ok, "error should have a delay")
+				assert.InDelta(t, pt.delay, time.Duration(delay), float64(time.Second))
+			}
+
+			assert.Len(t, rs.RequestTimesCalls(), pt.userCalls)
+			assert.Len(t, rs.AllRequestTimesCalls(), pt.allCalls)
+			for _, call := range rs.RequestTimesCalls() {
+				assert.Equal(t, requester.Identifier, call.Identifier)
+			}
+		})
+	}
+}
+
+func TestUserCooldown(t *testing.T) {
+	now := time.Now()
+
+	for _, pt := range policyTests {
+		if pt.kind != errors.UserCooldown && pt.userErr == nil {
+			continue
+		}
+
+		t.Run(pt.name, func(t *testing.T) {
+			rs := pt.storage(now)
+			requester := radio.Requester{Identifier: "127.0.0.1", Staff: pt.staff}
+
+			d, ok, err := pt.policy().UserCooldown(rs, requester)
+			if pt.userErr != nil {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, ok)
+			assert.InDelta(t, pt.delay, d, float64(time.Second))
+
+			// the same requester as staff is only let through if staff is
+			// exempt
+			requester.Staff = true
+			_, ok, err = pt.policy().UserCooldown(rs, requester)
+			require.NoError(t, err)
+			assert.Equal(t, pt.staffExempt, ok)
+		})
+	}
+}
+
+func TestGlobalCooldown(t *testing.T) {
+	now := time.Now()
+	pt := policyTest{
+		userLimit: 1, globalLimit: 2, queueFull: 10,
+		allTimes: []time.Duration{time.Minute, time.Minute * 2},
+	}
+	requester := radio.Requester{Identifier: "127.0.0.1"}
+
+	// the global limit only applies once the queue is full
+	for queued := range 20 {
+		rs := pt.storage(now)
+		d, ok, err := pt.policy().GlobalCooldown(rs, requester, queued)
+		require.NoError(t, err)
+
+		if queued < pt.queueFull {
+			assert.True(t, ok, "queued %d", queued)
+			assert.Zero(t, d)
+			assert.Empty(t, rs.AllRequestTimesCalls(), "storage shouldn't be used")
+		} else {
+			assert.False(t, ok, "queued %d", queued)
+			assert.InDelta(t, time.Minute*8, d, float64(time.Second))
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package proxy
+
+import (
+	"bytes"
+)
+
+// adtsHeaderSize is the size of an ADTS header without the CRC
+const adtsHeaderSize = 7
+
+// adtsSampleRates are the sample rates of AAC, the position is the value used
+// in the ADTS header
+var adtsSampleRates = []int{
+	96000, 88200, 64000, 48000, 44100, 32000, 24000,
+	22050, 16000, 12000, 11025, 8000, 7350,
+}
+
+// aacFramer splits a stream of ADTS AAC data into whole frames, anything in
+// between frames is dropped
+type aacFramer struct {
+	noStreamInfo
+
+	// pending is the data that doesn't make up a whole frame yet
+	pending []byte
+	out     bytes.Buffer
+
+	// format is the format of the first frame found
+	format *audioFormat
+}
+
+func newAACFramer() *aacFramer {
+	return &aacFramer{}
+}
+
+// Push adds p to the stream and returns all whole frames found so far, the
+// returned slice is only valid until the next call to Push
+func (af *aacFramer) Push(p []byte) []byte {
+	af.pending = append(af.pending, p...)
+	af.out.Reset()
+
+	var pos int
+	for pos < len(af.pending) {
+		i := bytes.IndexByte(af.pending[pos:], 0xFF)
+		if i < 0 {
+			pos = len(af.pending)
+			break
+		}
+		pos += i
+
+		b := af.pending[pos:]
+		if len(b) < adtsHeaderSize {
+			break
+		}
+
+		format, size, ok := parseADTSHeader(b)
+		if !ok {
+			pos++
+			continue
+		}
+		// a frame should be followed by another frame, so we wait for the start
+		// of the next one to make sure we didn't sync onto random data that
+		// looks like a header
+		if len(b) < size+2 {
+			break
+		}
+		if b[size] != 0xFF || b[size+1]&0xF6 != 0xF0 {
+			pos++
+			continue
+		}
+
+		if af.format == nil {
+			af.format = &format
+		}
+		af.out.Write(b[:size])
+		pos += size
+	}
+
+	// what's left is at most a single partial frame
+	af.pending = append(af.pending[:0], af.pending[pos:]...)
+	return af.out.Bytes()
+}
+
+// Format returns the format of the first frame, or false if no frame has been
+// found yet
+func (af *aacFramer) Format() (audioFormat, bool) {
+	if af.format == nil {
+		return audioFormat{}, false
+	}
+	return *af.format, true
+}
+
+// parseADTSHeader parses the ADTS header at the start of b and returns the
+// format and size of the frame
+func parseADTSHeader(b []byte) (audioFormat, int, bool) {
+	// 12 bit sync word and the layer, which is always zero
+	if b[0] != 0xFF || b[1]&0xF6 != 0xF0 {
+		return audioFormat{}, 0, false
+	}
+
+	sampleRateIndex := int(b[2]>>2) & 0x0F
+	if sampleRateIndex >= len(adtsSampleRates) {
+		return audioFormat{}, 0, false
+	}
+	channels := int(b[2]&0x01)<<2 | int(b[3]>>6)
+	if channels == 7 {
+		// channel configuration 7 is 7.1 surround, the rest maps directly
+		channels = 8
+	}
+
+	size := int(b[3]&0x03)<<11 | int(b[4])<<3 | int(b[5]>>5)
+	if size < adtsHeaderSize {
+		return audioFormat{}, 0, false
+	}
+
+	return audioFormat{
+		Codec:      "aac",
+		SampleRate: adtsSampleRates[sampleRateIndex],
+		Channels:   channels,
+	}, size, true
+}
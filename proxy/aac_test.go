@@ -0,0 +1,57 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// adtsTestFrame returns an ADTS frame of the size given
+func adtsTestFrame(sampleRateIndex, channels, size int) []byte {
+	frame := make([]byte, size)
+	frame[0] = 0xFF
+	// MPEG-4, layer 0, no CRC
+	frame[1] = 0xF1
+	// AAC LC
+	frame[2] = 1<<6 | byte(sampleRateIndex)<<2 | byte(channels>>2)
+	frame[3] = byte(channels&0x03)<<6 | byte(size>>11)
+	frame[4] = byte(size >> 3)
+	frame[5] = byte(size&0x07)<<5 | 0x1F
+	frame[6] = 0xFC
+	return frame
+}
+
+func TestAACFramer(t *testing.T) {
+	var frames []byte
+	for i := range 10 {
+		frames = append(frames, adtsTestFrame(4, 2, 300+i)...)
+	}
+	// junk in front, including something that looks like the start of a frame
+	stream := append([]byte("junk\xFF\xF1junk"), frames...)
+	// the last frame is only returned once the next one starts
+	stream = append(stream, adtsTestFrame(4, 2, 300)[:2]...)
+
+	for _, size := range []int{1, 7, 100, 4096} {
+		af := newAACFramer()
+		var out []byte
+		for i := 0; i < len(stream); i += size {
+			got := af.Push(stream[i:min(i+size, len(stream))])
+			// every push should only return whole frames
+			for rest := got; len(rest) > 0; {
+				_, n, ok := parseADTSHeader(rest)
+				if !assert.True(t, ok, "chunk size %d", size) {
+					break
+				}
+				rest = rest[n:]
+			}
+			out = append(out, got...)
+		}
+		assert.True(t, bytes.Equal(frames, out), "chunk size %d", size)
+
+		format, ok := af.Format()
+		require.True(t, ok)
+		assert.Equal(t, audioFormat{Codec: "aac", SampleRate: 44100, Channels: 2}, format)
+	}
+}
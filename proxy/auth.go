@@ -0,0 +1,73 @@
+package proxy
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/rs/zerolog/hlog"
+)
+
+// SourceAuth authenticates source clients with either a stream key or their
+// account password, passwords are only accepted if enabled in the configuration
+func SourceAuth(cfg config.Config, uss radio.UserStorageService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		passwordAuth := middleware.BasicAuth(uss)(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			username, passwd, ok := r.BasicAuth()
+			if ok && username == "source" {
+				// same as BasicAuth, the password can contain the username
+				// separated by a '|'
+				if _, key, found := strings.Cut(passwd, "|"); found {
+					passwd = key
+				}
+			}
+
+			if !ok || !radio.IsStreamKey(passwd) {
+				if !cfg.Conf().Proxy.PasswordAuth {
+					hlog.FromRequest(r).Error().Msg("password authentication is disabled")
+					middleware.BasicAuthFailure(w, r)
+					return
+				}
+				passwordAuth.ServeHTTP(w, r)
+				return
+			}
+
+			user, key, err := uss.User(r.Context()).GetByStreamKey(radio.HashStreamKey(passwd))
+			if err != nil {
+				hlog.FromRequest(r).Error().Err(err).Msg("unknown stream key")
+				middleware.BasicAuthFailure(w, r)
+				return
+			}
+
+			logger := hlog.FromRequest(r).With().
+				Str("username", user.Username).
+				Str("stream_key", key.ID.String()).
+				Logger()
+
+			if key.Expired(time.Now()) {
+				logger.Error().Msg("expired stream key")
+				middleware.BasicAuthFailure(w, r)
+				return
+			}
+
+			if !key.AllowsMount(GetMountpoint(r)) {
+				logger.Error().Str("mount", GetMountpoint(r)).Msg("stream key not allowed on mount")
+				middleware.BasicAuthFailure(w, r)
+				return
+			}
+
+			if !user.UserPermissions.Has(radio.PermActive) {
+				logger.Error().Msg("inactive user")
+				middleware.BasicAuthFailure(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, middleware.RequestWithUser(r, user))
+		})
+	}
+}
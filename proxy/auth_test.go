@@ -0,0 +1,92 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceAuth(t *testing.T) {
+	key, hash, err := radio.GenerateStreamKey()
+	require.NoError(t, err)
+	expired := time.Now().Add(-time.Hour)
+
+	user := newTestUser("test", "hackme")
+	keys := map[string]radio.StreamKey{
+		hash: {ID: 1, Hash: hash},
+	}
+
+	storage := &mocks.StorageServiceMock{
+		UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+			return &mocks.UserStorageMock{
+				GetFunc: func(name string) (*radio.User, error) {
+					return user, nil
+				},
+				GetByStreamKeyFunc: func(hash string) (*radio.User, *radio.StreamKey, error) {
+					sk, ok := keys[hash]
+					if !ok {
+						return nil, nil, errors.E(errors.StreamKeyUnknown)
+					}
+					return user, &sk, nil
+				},
+			}
+		},
+	}
+
+	cases := []struct {
+		name         string
+		username     string
+		password     string
+		key          radio.StreamKey
+		passwordAuth bool
+		ok           bool
+	}{
+		{"key", "source", key, radio.StreamKey{}, true, true},
+		{"key with username", "source", "test|" + key, radio.StreamKey{}, false, true},
+		{"unknown key", "source", radio.StreamKeyPrefix + "nope", radio.StreamKey{}, true, false},
+		{"expired key", "source", key, radio.StreamKey{ExpiresAt: &expired}, true, false},
+		{"mount allowed", "source", key, radio.StreamKey{Mount: "/main.mp3"}, true, true},
+		{"mount not allowed", "source", key, radio.StreamKey{Mount: "/other.mp3"}, true, false},
+		{"password", "test", "hackme", radio.StreamKey{}, true, true},
+		{"password disabled", "test", "hackme", radio.StreamKey{}, false, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cfg := config.TestConfig()
+			conf := cfg.Conf()
+			conf.Proxy.PasswordAuth = c.passwordAuth
+			cfg.StoreConf(conf)
+
+			sk := c.key
+			sk.ID, sk.Hash = 1, hash
+			keys[hash] = sk
+
+			var called bool
+			handler := SourceAuth(cfg, storage)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				assert.Equal(t, user, middleware.UserFromContext(r.Context()))
+			}))
+
+			req := httptest.NewRequest(http.MethodPut, "/main.mp3", nil)
+			req.SetBasicAuth(c.username, c.password)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, c.ok, called)
+			if !c.ok {
+				assert.Equal(t, http.StatusUnauthorized, w.Code)
+			}
+		})
+	}
+}
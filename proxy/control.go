@@ -0,0 +1,222 @@
+package proxy
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
+)
+
+var _ radio.ProxyService = &ProxyManager{}
+
+// ListSources implements radio.ProxyService
+func (pm *ProxyManager) ListSources(ctx context.Context) ([]radio.ProxySource, error) {
+	return pm.sources(), nil
+}
+
+// sources returns the sources of all mounts sorted by mount and priority
+func (pm *ProxyManager) sources() []radio.ProxySource {
+	pm.mountsMu.Lock()
+	mounts := make([]*Mount, 0, len(pm.mounts))
+	for _, mount := range pm.mounts {
+		mounts = append(mounts, mount)
+	}
+	pm.mountsMu.Unlock()
+
+	var sources []radio.ProxySource
+	for _, mount := range mounts {
+		sources = append(sources, mount.ListSources()...)
+	}
+
+	slices.SortStableFunc(sources, func(a, b radio.ProxySource) int {
+		return cmp.Or(
+			cmp.Compare(a.MountName, b.MountName),
+			cmp.Compare(a.Priority, b.Priority),
+		)
+	})
+	return sources
+}
+
+// ListUpstreams implements radio.ProxyService
+func (pm *ProxyManager) ListUpstreams(ctx context.Context) ([]radio.ProxyUpstream, error) {
+	pm.mountsMu.Lock()
+	mounts := make([]*Mount, 0, len(pm.mounts))
+	for _, mount := range pm.mounts {
+		mounts = append(mounts, mount)
+	}
+	pm.mountsMu.Unlock()
+
+	var upstreams []radio.ProxyUpstream
+	for _, mount := range mounts {
+		upstreams = append(upstreams, mount.ListUpstreams()...)
+	}
+
+	slices.SortStableFunc(upstreams, func(a, b radio.ProxyUpstream) int {
+		return cmp.Compare(a.MountName, b.MountName)
+	})
+	return upstreams, nil
+}
+
+// KickSource implements radio.ProxyService
+func (pm *ProxyManager) KickSource(ctx context.Context, id radio.SourceID) error {
+	const op errors.Op = "proxy/ProxyManager.KickSource"
+
+	mount := pm.mountOfSource(id)
+	if mount == nil {
+		return errors.E(op, errors.SourceUnknown)
+	}
+
+	err := mount.KickSource(ctx, id)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// SetLive implements radio.ProxyService
+func (pm *ProxyManager) SetLive(ctx context.Context, id radio.SourceID) error {
+	const op errors.Op = "proxy/ProxyManager.SetLive"
+
+	mount := pm.mountOfSource(id)
+	if mount == nil {
+		return errors.E(op, errors.SourceUnknown)
+	}
+
+	err := mount.SetPriorities(ctx, []SourceID{id})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// SetPriorities implements radio.ProxyService
+func (pm *ProxyManager) SetPriorities(ctx context.Context, mountName string, order []radio.SourceID) error {
+	const op errors.Op = "proxy/ProxyManager.SetPriorities"
+
+	pm.mountsMu.Lock()
+	mount, ok := pm.mounts[mountName]
+	pm.mountsMu.Unlock()
+	if !ok {
+		return errors.E(op, errors.MountUnknown)
+	}
+
+	err := mount.SetPriorities(ctx, order)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// Events implements radio.ProxyService
+func (pm *ProxyManager) Events(ctx context.Context) (eventstream.Stream[radio.ProxyEvent], error) {
+	return pm.events.stream.SubStream(ctx), nil
+}
+
+// mountOfSource returns the mount the source given is connected to, or nil if
+// no such source exists
+func (pm *ProxyManager) mountOfSource(id SourceID) *Mount {
+	pm.mountsMu.Lock()
+	defer pm.mountsMu.Unlock()
+
+	for _, mount := range pm.mounts {
+		if mount.source(id) != nil {
+			return mount
+		}
+	}
+	return nil
+}
+
+// ListSources returns the sources of this mount
+func (m *Mount) ListSources() []radio.ProxySource {
+	m.SourcesMu.RLock()
+	defer m.SourcesMu.RUnlock()
+
+	sources := make([]radio.ProxySource, 0, len(m.Sources))
+	for _, msc := range m.Sources {
+		source := msc.Source.ProxySource()
+		source.MountName = m.Name
+		source.Priority = msc.Priority
+		source.Live = msc.MW.GetLive()
+		sources = append(sources, source)
+	}
+	return sources
+}
+
+// source returns the source with the id given, or nil if it doesn't exist
+func (m *Mount) source(id SourceID) *MountSourceClient {
+	m.SourcesMu.RLock()
+	defer m.SourcesMu.RUnlock()
+
+	for _, msc := range m.Sources {
+		if msc.Source.ID == id {
+			return msc
+		}
+	}
+	return nil
+}
+
+// KickSource disconnects the source given, removing it from the mount is done
+// by RunMountSourceClient once it notices the connection is gone
+func (m *Mount) KickSource(ctx context.Context, id SourceID) error {
+	const op errors.Op = "proxy/Mount.KickSource"
+
+	msc := m.source(id)
+	if msc == nil {
+		return errors.E(op, errors.SourceUnknown)
+	}
+
+	msc.logger.Info().Str("req_id", id.String()).Msg("kicking source client")
+	err := msc.Source.conn.Close()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// SetPriorities gives the sources in order the highest priorities in the order
+// given, and then switches the live source if the highest priority source isn't
+// live already
+func (m *Mount) SetPriorities(ctx context.Context, order []SourceID) error {
+	const op errors.Op = "proxy/Mount.SetPriorities"
+
+	m.SourcesMu.Lock()
+	defer m.SourcesMu.Unlock()
+
+	position := make(map[SourceID]int, len(order))
+	for i, id := range order {
+		position[id] = i
+	}
+
+	for _, id := range order {
+		if !slices.ContainsFunc(m.Sources, func(msc *MountSourceClient) bool {
+			return msc.Source.ID == id
+		}) {
+			return errors.E(op, errors.SourceUnknown, errors.Info(id.String()))
+		}
+	}
+
+	// sort by the new order first, and the old priority for the rest
+	slices.SortStableFunc(m.Sources, func(a, b *MountSourceClient) int {
+		pa, oka := position[a.Source.ID]
+		pb, okb := position[b.Source.ID]
+		switch {
+		case oka && okb:
+			return cmp.Compare(pa, pb)
+		case oka:
+			return -1
+		case okb:
+			return 1
+		}
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+
+	for i, msc := range m.Sources {
+		msc.Priority = uint(i)
+	}
+
+	m.liveSourceSwap(ctx)
+	return nil
+}
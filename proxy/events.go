@@ -2,12 +2,13 @@ package proxy
 
 import (
 	"context"
-	"fmt"
+	"slices"
 	"sync"
 	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
 	"github.com/rs/zerolog"
 )
 
@@ -19,6 +20,9 @@ func NewEventHandler(ctx context.Context, cfg config.Config) *EventHandler {
 		}),
 		logger:  *zerolog.Ctx(ctx),
 		records: make(map[string]eventRecords),
+		stream: eventstream.NewEventStream(radio.ProxyEvent{
+			Kind: radio.ProxyEventInit,
+		}),
 	}
 }
 
@@ -36,6 +40,29 @@ type EventHandler struct {
 	mu sync.Mutex
 	// map of MountName->eventRecords
 	records map[string]eventRecords
+
+	// stream is where the events are published for the admin panel
+	stream *eventstream.EventStream[radio.ProxyEvent]
+	// sources returns all sources connected, this is set by the ProxyManager
+	// using this handler and can be nil
+	sources func() []radio.ProxySource
+}
+
+// publish sends the event to subscribers of Events with the sources connected
+// right now
+func (eh *EventHandler) publish(ev radio.ProxyEvent) {
+	if eh.sources != nil {
+		ev.Sources = eh.sources()
+	}
+	// the source in the list knows its priority and if it's live, so use that
+	// one if it's still connected
+	i := slices.IndexFunc(ev.Sources, func(s radio.ProxySource) bool {
+		return s.ID == ev.Source.ID
+	})
+	if i >= 0 {
+		ev.Source = ev.Sources[i]
+	}
+	eh.stream.Send(ev)
 }
 
 // "live" got swapped (any mount)
@@ -72,15 +99,27 @@ func (eh *EventHandler) eventNewLiveSource(ctx context.Context, mountName string
 		// update the record
 		record.newLiveSource = instant
 		eh.records[mountName] = record
+
+		ev := radio.ProxyEvent{
+			Kind:      radio.ProxyEventLive,
+			MountName: mountName,
+		}
+		if new != nil {
+			ev.Source = new.ProxySource()
+		}
+		eh.publish(ev)
 	}()
 }
 
 // eventMetadataUpdate is any metadata update send by any source, to any mount.
 // We use this information mostly for display purposes to the admin panel
 func (eh *EventHandler) eventMetadataUpdate(ctx context.Context, new *Metadata) {
-	instant := time.Now()
 	go func() {
-		_ = instant
+		eh.publish(radio.ProxyEvent{
+			Kind:      radio.ProxyEventMetadata,
+			MountName: new.MountName,
+			Metadata:  new.Value,
+		})
 	}()
 }
 
@@ -124,12 +163,35 @@ func (eh *EventHandler) eventLiveMetadataUpdate(ctx context.Context, mountName s
 // source connected (any mount)
 func (eh *EventHandler) eventSourceConnect(ctx context.Context, source *SourceClient) {
 	go func() {
-		fmt.Println("connect:", source)
+		eh.logger.Info().
+			Str("mount", source.MountName).
+			Str("username", source.User.Username).
+			Str("req_id", source.ID.String()).
+			Msg("source connected")
+
+		eh.publish(radio.ProxyEvent{
+			Kind:      radio.ProxyEventConnect,
+			MountName: source.MountName,
+			Source:    source.ProxySource(),
+		})
 	}()
 }
 
+// source disconnected (any mount)
 func (eh *EventHandler) eventSourceDisconnect(ctx context.Context, source *SourceClient) {
 	go func() {
-		fmt.Println("disconnect:", source)
+		eh.logger.Info().
+			Str("mount", source.MountName).
+			Str("username", source.User.Username).
+			Str("req_id", source.ID.String()).
+			Uint64("bytes_received", source.received.Load()).
+			Dur("duration", time.Since(source.Start)).
+			Msg("source disconnected")
+
+		eh.publish(radio.ProxyEvent{
+			Kind:      radio.ProxyEventDisconnect,
+			MountName: source.MountName,
+			Source:    source.ProxySource(),
+		})
 	}()
 }
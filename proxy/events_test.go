@@ -0,0 +1,57 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventHandlerPublish(t *testing.T) {
+	ctx := context.Background()
+	eh := NewEventHandler(ctx, config.TestConfig())
+
+	connected := radio.ProxySource{
+		ID:        SourceID{ID: xid.New()},
+		MountName: "/main.mp3",
+		Priority:  1,
+		Live:      true,
+	}
+	eh.sources = func() []radio.ProxySource {
+		return []radio.ProxySource{connected}
+	}
+
+	stream := eh.stream.SubStream(ctx)
+	defer stream.Close()
+
+	ev, err := stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, radio.ProxyEventInit, ev.Kind)
+
+	// a source that is still connected should be replaced by the one from the
+	// list since that one knows the priority and live state
+	eh.publish(radio.ProxyEvent{
+		Kind:   radio.ProxyEventConnect,
+		Source: radio.ProxySource{ID: connected.ID},
+	})
+	ev, err = stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, radio.ProxyEventConnect, ev.Kind)
+	assert.Equal(t, connected, ev.Source)
+	assert.Equal(t, []radio.ProxySource{connected}, ev.Sources)
+
+	// a source that left should stay as given
+	gone := radio.ProxySource{ID: SourceID{ID: xid.New()}, MountName: "/main.mp3"}
+	eh.publish(radio.ProxyEvent{
+		Kind:   radio.ProxyEventDisconnect,
+		Source: gone,
+	})
+	ev, err = stream.Next()
+	require.NoError(t, err)
+	assert.Equal(t, radio.ProxyEventDisconnect, ev.Kind)
+	assert.Equal(t, gone, ev.Source)
+}
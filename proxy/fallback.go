@@ -0,0 +1,277 @@
+package proxy
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/rs/zerolog"
+	"github.com/tcolgate/mp3"
+)
+
+// fallbackLead is how far ahead of real-time the fallback is allowed to send
+// audio, this gives the receiving end a bit of a buffer
+const fallbackLead = time.Millisecond * 500
+
+// mp3Bitrates are the bitrates in kbit/s of MPEG-1 Layer III, the position is
+// the value used in the frame header
+var mp3Bitrates = []int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320}
+
+// mp3SampleRates are the sample rates of MPEG-1, the position is the value used
+// in the frame header
+var mp3SampleRates = []int{44100, 48000, 32000}
+
+// mp3FrameSamples is the amount of samples in a single MPEG-1 Layer III frame
+const mp3FrameSamples = 1152
+
+// silentFrame returns a single MPEG-1 Layer III frame of silence with the
+// bitrate, sample rate and channel count given and the duration of the frame
+func silentFrame(bitrate, sampleRate, channels int) ([]byte, time.Duration, error) {
+	bitrateIndex := slices.Index(mp3Bitrates, bitrate)
+	if bitrateIndex <= 0 {
+		return nil, 0, fmt.Errorf("unsupported mp3 bitrate: %d", bitrate)
+	}
+	sampleRateIndex := slices.Index(mp3SampleRates, sampleRate)
+	if sampleRateIndex < 0 {
+		return nil, 0, fmt.Errorf("unsupported mp3 sample rate: %d", sampleRate)
+	}
+
+	frame := make([]byte, 144*bitrate*1000/sampleRate)
+	// sync word, MPEG-1, Layer III, no CRC
+	frame[0] = 0xFF
+	frame[1] = 0xFB
+	frame[2] = byte(bitrateIndex<<4 | sampleRateIndex<<2)
+	switch channels {
+	case 1:
+		// single channel, original
+		frame[3] = 0xC4
+	case 2:
+		// joint stereo, original
+		frame[3] = 0x44
+	default:
+		return nil, 0, fmt.Errorf("unsupported mp3 channel count: %d", channels)
+	}
+	// the side information and audio data are left as zero, which means the
+	// frame has no audio data and decodes as silence
+
+	duration := time.Second * mp3FrameSamples / time.Duration(sampleRate)
+	return frame, duration, nil
+}
+
+// fallback is the fallback audio running on a mount
+type fallback struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startFallback starts sending the fallback audio of this mount if it has one
+// configured, it returns false if there is no fallback.
+//
+// startFallback should only be called with m.SourcesMu held in a write lock
+func (m *Mount) startFallback(ctx context.Context) bool {
+	if m.fallback != nil {
+		// already running
+		return true
+	}
+
+	var found bool
+	var file string
+	var bitrate, sampleRate int
+	for _, fb := range m.cfg.Conf().Proxy.Fallbacks {
+		if fb.Mount == m.Name {
+			found = true
+			file = fb.File
+			bitrate = cmp.Or(fb.Bitrate, 128)
+			sampleRate = cmp.Or(fb.SampleRate, 44100)
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+
+	if !isMP3(m.ContentType) {
+		m.logger.Warn().Str("content-type", m.ContentType).Msg("fallback only supports mp3 mounts")
+		return false
+	}
+
+	silence, duration, err := m.fallbackSilence(bitrate, sampleRate)
+	if err != nil {
+		m.logger.Error().Err(err).Msg("invalid fallback configuration")
+		return false
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	m.fallback = &fallback{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	frames := &fallbackFrames{
+		logger:          m.logger,
+		file:            file,
+		checkFormat:     m.checkFormat,
+		silence:         silence,
+		silenceDuration: duration,
+	}
+
+	m.logger.Info().Str("file", file).Msg("starting fallback")
+	go func(done chan struct{}) {
+		defer close(done)
+		defer frames.Close()
+		m.runFallback(ctx, frames)
+	}(m.fallback.done)
+	return true
+}
+
+// fallbackSilence returns the silence to use as fallback, this uses the format
+// of the mount if it's known so that listeners don't notice a format change and
+// the bitrate and sample rate given otherwise
+func (m *Mount) fallbackSilence(bitrate, sampleRate int) ([]byte, time.Duration, error) {
+	m.formatMu.Lock()
+	format := m.format
+	m.formatMu.Unlock()
+
+	if format != nil && format.Codec == "mp3" {
+		silence, duration, err := silentFrame(format.Bitrate/1000, format.SampleRate, format.Channels)
+		if err == nil {
+			return silence, duration, nil
+		}
+		m.logger.Warn().Err(err).Stringer("format", *format).Msg("can't encode silence in mount format")
+	}
+	return silentFrame(bitrate, sampleRate, 2)
+}
+
+// stopFallback stops the fallback audio if it's running, it returns after the
+// last frame of the fallback has been written so that a source can take over
+// without interleaving.
+//
+// stopFallback should only be called with m.SourcesMu held in a write lock
+func (m *Mount) stopFallback() {
+	if m.fallback == nil {
+		return
+	}
+
+	m.fallback.cancel()
+	<-m.fallback.done
+	m.fallback = nil
+	m.logger.Info().Msg("stopped fallback")
+}
+
+// runFallback writes the frames to the mount in real-time until ctx is canceled
+func (m *Mount) runFallback(ctx context.Context, frames *fallbackFrames) {
+	start := time.Now()
+	// sent is the duration of audio sent so far
+	var sent time.Duration
+
+	for {
+		wait := time.Until(start.Add(sent)) - fallbackLead
+		if wait > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+		} else if ctx.Err() != nil {
+			return
+		}
+
+		frame, duration := frames.Next()
+		_, err := m.Write(frame)
+		if err != nil {
+			m.logger.Error().Err(err).Msg("failed to write fallback")
+			return
+		}
+		sent += duration
+	}
+}
+
+// fallbackFrames returns the frames of the fallback file in a loop, or silence
+// if there is no file or it can't be read
+type fallbackFrames struct {
+	logger zerolog.Logger
+	// file is the file to loop, empty means only silence is used
+	file string
+	// checkFormat is called with the format of the file before it's used
+	checkFormat func(audioFormat) error
+	checked     bool
+
+	silence         []byte
+	silenceDuration time.Duration
+
+	f     *os.File
+	dec   *mp3.Decoder
+	frame mp3.Frame
+	buf   bytes.Buffer
+}
+
+// Next returns the next frame and its duration, the frame is only valid until
+// the next call to Next
+func (ff *fallbackFrames) Next() ([]byte, time.Duration) {
+	if ff.file == "" {
+		return ff.silence, ff.silenceDuration
+	}
+
+	frame, duration, err := ff.nextFile()
+	if err != nil {
+		// don't try the file again, otherwise we'd be logging this every frame
+		ff.logger.Error().Err(err).Str("file", ff.file).Msg("failed to read fallback file, using silence")
+		ff.file = ""
+		ff.Close()
+		return ff.silence, ff.silenceDuration
+	}
+	return frame, duration
+}
+
+func (ff *fallbackFrames) nextFile() ([]byte, time.Duration, error) {
+	// we only retry once after reaching the end, so that an empty or broken
+	// file doesn't keep us looping forever
+	for range 2 {
+		if ff.f == nil {
+			f, err := os.Open(ff.file)
+			if err != nil {
+				return nil, 0, err
+			}
+			ff.f = f
+			ff.dec = mp3.NewDecoder(f)
+		}
+
+		var skipped int
+		err := ff.dec.Decode(&ff.frame, &skipped)
+		if err == nil {
+			ff.buf.Reset()
+			_, _ = ff.buf.ReadFrom(ff.frame.Reader())
+			if !ff.checked && ff.checkFormat != nil {
+				format := newMP3Format(ff.frame.Header())
+				format.CBR = hasInfoTag(ff.buf.Bytes())
+				if err := ff.checkFormat(format); err != nil {
+					return nil, 0, err
+				}
+				ff.checked = true
+			}
+			return ff.buf.Bytes(), ff.frame.Duration(), nil
+		}
+		if !errors.IsE(err, io.EOF, io.ErrUnexpectedEOF) {
+			return nil, 0, err
+		}
+
+		// end of the file, start from the beginning
+		ff.Close()
+	}
+	return nil, 0, errors.New("no mp3 frames found")
+}
+
+// Close closes the fallback file if it's open
+func (ff *fallbackFrames) Close() {
+	if ff.f != nil {
+		ff.f.Close()
+		ff.f = nil
+		ff.dec = nil
+	}
+}
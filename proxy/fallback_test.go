@@ -0,0 +1,176 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/rs/xid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tcolgate/mp3"
+)
+
+func TestSilentFrame(t *testing.T) {
+	for _, sampleRate := range mp3SampleRates {
+		for _, bitrate := range mp3Bitrates[1:] {
+			frame, duration, err := silentFrame(bitrate, sampleRate, 2)
+			require.NoError(t, err)
+
+			var decoded mp3.Frame
+			var skipped int
+			err = mp3.NewDecoder(bytes.NewReader(frame)).Decode(&decoded, &skipped)
+			require.NoError(t, err, "bitrate %d sample rate %d", bitrate, sampleRate)
+			assert.Zero(t, skipped)
+			assert.EqualValues(t, bitrate*1000, decoded.Header().BitRate())
+			assert.EqualValues(t, sampleRate, decoded.Header().SampleRate())
+			assert.Equal(t, len(frame), decoded.Size())
+			assert.Equal(t, decoded.Duration(), duration)
+		}
+	}
+
+	_, _, err := silentFrame(100, 44100, 2)
+	assert.Error(t, err)
+	_, _, err = silentFrame(128, 22050, 2)
+	assert.Error(t, err)
+}
+
+func TestMountFallback(t *testing.T) {
+	ctx := context.Background()
+	mountName := "/test.mp3"
+	contentType := "audio/mpeg"
+
+	cfg, err := config.Load(strings.NewReader(`
+[proxy]
+masterserver = ""
+
+[[proxy.fallbacks]]
+mount = "/test.mp3"
+bitrate = 128
+samplerate = 44100
+`))
+	require.NoError(t, err)
+
+	eh := NewEventHandler(ctx, cfg)
+	mount := NewMount(ctx, cfg, nil, eh, mountName, contentType, nil)
+	mount.Broadcaster = NewBroadcaster(cfg, mountName, contentType)
+
+	lc := NewListenerClient(radio.Listener{}, 1024*1024)
+	mount.Broadcaster.AddListener(lc)
+
+	// a mount without sources should start the fallback instead of closing
+	mount.SourcesMu.Lock()
+	mount.liveSourceSwap(ctx)
+	running := mount.fallback != nil
+	mount.SourcesMu.Unlock()
+	require.True(t, running, "fallback should be running")
+
+	silence, _, err := silentFrame(128, 44100, 2)
+	require.NoError(t, err)
+	select {
+	case chunk := <-lc.data:
+		assert.Equal(t, silence, chunk)
+	case <-time.After(time.Second * 5):
+		t.Fatal("no fallback audio received")
+	}
+
+	// a source connecting should take over from the fallback
+	conn1, conn2 := net.Pipe()
+	defer conn1.Close()
+	req := httptest.NewRequest("PUT", mountName, conn2)
+	source := NewSourceClient(SourceID{ID: xid.New()}, "test", contentType,
+		mountName, conn2, *newTestUser("test", "test"), IdentFromRequest(req), &Metadata{})
+	mount.AddSource(ctx, source)
+
+	mount.SourcesMu.RLock()
+	running = mount.fallback != nil
+	mount.SourcesMu.RUnlock()
+	assert.False(t, running, "fallback should be stopped")
+	assert.True(t, getSource(mount, 0).MW.GetLive(), "source should be live")
+}
+
+func TestSilentFrameMono(t *testing.T) {
+	frame, _, err := silentFrame(128, 44100, 1)
+	require.NoError(t, err)
+
+	var decoded mp3.Frame
+	var skipped int
+	err = mp3.NewDecoder(bytes.NewReader(frame)).Decode(&decoded, &skipped)
+	require.NoError(t, err)
+	assert.Equal(t, mp3.SingleChannel, decoded.Header().ChannelMode())
+
+	_, _, err = silentFrame(128, 44100, 6)
+	assert.Error(t, err)
+}
+
+func TestFallbackSilence(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.TestConfig()
+	mount := NewMount(ctx, cfg, nil, NewEventHandler(ctx, cfg), "/test.mp3", "audio/mpeg", nil)
+
+	// without a mount format the configured values are used
+	silence, _, err := mount.fallbackSilence(128, 44100)
+	require.NoError(t, err)
+	expected, _, err := silentFrame(128, 44100, 2)
+	require.NoError(t, err)
+	assert.Equal(t, expected, silence)
+
+	require.NoError(t, mount.checkFormat(audioFormat{Codec: "mp3", SampleRate: 48000, Channels: 1, Bitrate: 192000}))
+	silence, _, err = mount.fallbackSilence(128, 44100)
+	require.NoError(t, err)
+	expected, _, err = silentFrame(192, 48000, 1)
+	require.NoError(t, err)
+	assert.Equal(t, expected, silence)
+}
+
+func TestFallbackFramesFormat(t *testing.T) {
+	silence, silenceDuration, err := silentFrame(128, 44100, 2)
+	require.NoError(t, err)
+	// the file uses another sample rate than the silence
+	frame, _, err := silentFrame(128, 48000, 2)
+	require.NoError(t, err)
+	file := filepath.Join(t.TempDir(), "fallback.mp3")
+	require.NoError(t, os.WriteFile(file, bytes.Repeat(frame, 10), 0644))
+
+	newFrames := func(check func(audioFormat) error) *fallbackFrames {
+		return &fallbackFrames{
+			logger:          zerolog.Nop(),
+			file:            file,
+			checkFormat:     check,
+			silence:         silence,
+			silenceDuration: silenceDuration,
+		}
+	}
+
+	var checked []audioFormat
+	ff := newFrames(func(format audioFormat) error {
+		checked = append(checked, format)
+		return nil
+	})
+	defer ff.Close()
+	for range 20 {
+		got, _ := ff.Next()
+		assert.Equal(t, frame, got)
+	}
+	// the format is only checked once, even after looping
+	assert.Equal(t, []audioFormat{{Codec: "mp3", SampleRate: 48000, Channels: 2, Bitrate: 128000}}, checked)
+
+	// a rejected file isn't used
+	ff = newFrames(func(audioFormat) error {
+		return errors.E(errors.SourceFormat)
+	})
+	defer ff.Close()
+	got, _ := ff.Next()
+	assert.Equal(t, silence, got)
+	assert.Empty(t, ff.file)
+}
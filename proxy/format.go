@@ -0,0 +1,197 @@
+package proxy
+
+import (
+	"fmt"
+	"mime"
+
+	"github.com/R-a-dio/valkyrie/errors"
+)
+
+const (
+	mismatchAllow  = "allow"
+	mismatchWarn   = "warn"
+	mismatchReject = "reject"
+)
+
+// framer splits the audio data of a source into whole frames (or pages for Ogg)
+// so that swapping the live source never cuts one in half
+type framer interface {
+	// Push adds p to the stream and returns all whole frames found so far, the
+	// returned slice is only valid until the next call to Push
+	Push(p []byte) []byte
+	// Format returns the format of the stream, or false if it isn't known yet
+	Format() (audioFormat, bool)
+	// Headers returns the header pages of the stream seen so far and true if
+	// they changed since the last call, these have to be send before any
+	// other data for a decoder to make sense of the stream
+	Headers() ([]byte, bool)
+	// Metadata returns the metadata found in the stream and true if it changed
+	// since the last call
+	Metadata() (string, bool)
+}
+
+// newFramer returns the framer for the content-type given, or nil if the
+// content-type isn't supported, data of such sources is passed through as-is
+func newFramer(contentType string) framer {
+	switch {
+	case isMP3(contentType):
+		return newMP3Framer()
+	case isOgg(contentType):
+		return newOggFramer()
+	case isAAC(contentType):
+		return newAACFramer()
+	}
+	return nil
+}
+
+// noStreamInfo implements the parts of framer for formats that have no stream
+// headers or in-stream metadata
+type noStreamInfo struct{}
+
+func (noStreamInfo) Headers() ([]byte, bool) {
+	return nil, false
+}
+
+func (noStreamInfo) Metadata() (string, bool) {
+	return "", false
+}
+
+// mediaType returns the content-type without any parameters
+func mediaType(contentType string) string {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return contentType
+	}
+	return mt
+}
+
+// isMP3 returns true if the content-type given is for mp3 audio
+func isMP3(contentType string) bool {
+	switch mediaType(contentType) {
+	case "audio/mpeg", "audio/mp3":
+		return true
+	}
+	return false
+}
+
+// isOgg returns true if the content-type given is for audio in an Ogg container
+func isOgg(contentType string) bool {
+	switch mediaType(contentType) {
+	case "audio/ogg", "application/ogg", "audio/opus", "audio/vorbis":
+		return true
+	}
+	return false
+}
+
+// isAAC returns true if the content-type given is for ADTS AAC audio
+func isAAC(contentType string) bool {
+	switch mediaType(contentType) {
+	case "audio/aac", "audio/aacp", "audio/x-aac":
+		return true
+	}
+	return false
+}
+
+// audioFormat is the format of an audio stream, sources streaming to the same
+// mount should all use the same format
+type audioFormat struct {
+	Codec      string
+	SampleRate int
+	Channels   int
+	// Bitrate is the bitrate in bit/s, for mp3 this is the bitrate of the first
+	// frame and can differ between frames for variable bitrate streams. Zero
+	// if the format doesn't say
+	Bitrate int
+	// CBR is true if the stream is known to use a constant bitrate, this is
+	// only set for mp3
+	CBR bool
+}
+
+func (f audioFormat) String() string {
+	if f.Bitrate == 0 {
+		return fmt.Sprintf("%s %dHz/%dch", f.Codec, f.SampleRate, f.Channels)
+	}
+	return fmt.Sprintf("%s %dHz/%dch/%dkbps", f.Codec, f.SampleRate, f.Channels, f.Bitrate/1000)
+}
+
+// checkFormat compares the format of a source to the format of the mount, the
+// first format that isn't rejected becomes the format of the mount. It returns
+// an error of kind SourceFormat if the format doesn't match and sources with a
+// mismatching format should be rejected
+func (m *Mount) checkFormat(format audioFormat) error {
+	m.formatMu.Lock()
+	defer m.formatMu.Unlock()
+
+	expected := format
+	if m.format != nil {
+		expected = *m.format
+	}
+
+	if err := m.compareFormat(format, expected); err != nil {
+		return err
+	}
+	if m.format == nil {
+		m.format = &format
+	}
+	return nil
+}
+
+// compareFormat returns an error if format doesn't match the expected format
+// and mismatching formats should be rejected, a warning is logged instead if
+// configured
+func (m *Mount) compareFormat(format, expected audioFormat) error {
+	const op errors.Op = "proxy/Mount.compareFormat"
+
+	if m.formatMatches(format, expected) {
+		return nil
+	}
+
+	mode := m.cfg.Conf().Proxy.MismatchedFormat
+	if mode == "" || mode == mismatchAllow {
+		return nil
+	}
+
+	if mode == mismatchReject {
+		return errors.E(op, errors.SourceFormat, errors.Info(
+			fmt.Sprintf("source is %s but mount is %s", format, expected),
+		))
+	}
+
+	m.logger.Warn().
+		Stringer("format", format).
+		Stringer("expected", expected).
+		Msg("source format doesn't match mount")
+	return nil
+}
+
+// formatMatches returns true if a source with the format given can stream to a
+// mount with the expected format. The bitrate of an mp3 frame differs between
+// frames for variable bitrate streams, so for mp3 the bitrate is only compared
+// if the mount has one configured or is known to use a constant bitrate
+func (m *Mount) formatMatches(format, expected audioFormat) bool {
+	if format.Codec != "mp3" || expected.Codec != "mp3" {
+		return format == expected
+	}
+
+	if format.SampleRate != expected.SampleRate || format.Channels != expected.Channels {
+		return false
+	}
+	if bitrate := m.configuredBitrate(); bitrate > 0 {
+		return format.Bitrate == bitrate
+	}
+	if expected.CBR {
+		return format.Bitrate == expected.Bitrate
+	}
+	return true
+}
+
+// configuredBitrate returns the bitrate in bit/s configured for the fallback of
+// this mount, or zero if there is none
+func (m *Mount) configuredBitrate() int {
+	for _, fb := range m.cfg.Conf().Proxy.Fallbacks {
+		if fb.Mount == m.Name {
+			return fb.Bitrate * 1000
+		}
+	}
+	return 0
+}
@@ -0,0 +1,89 @@
+package proxy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMountCheckFormat(t *testing.T) {
+	ctx := context.Background()
+	first := audioFormat{Codec: "mp3", SampleRate: 44100, Channels: 2, Bitrate: 192000}
+	other := audioFormat{Codec: "mp3", SampleRate: 48000, Channels: 2, Bitrate: 320000}
+
+	for _, mode := range []string{mismatchAllow, mismatchWarn, mismatchReject} {
+		t.Run(mode, func(t *testing.T) {
+			cfg := config.TestConfig()
+			c := cfg.Conf()
+			c.Proxy.MismatchedFormat = mode
+			cfg.StoreConf(c)
+
+			mount := NewMount(ctx, cfg, nil, NewEventHandler(ctx, cfg), "/test.mp3", "audio/mpeg", nil)
+
+			// the first format is always fine and becomes the mount format
+			require.NoError(t, mount.checkFormat(first))
+			require.NoError(t, mount.checkFormat(first))
+
+			err := mount.checkFormat(other)
+			if mode == mismatchReject {
+				assert.True(t, errors.Is(errors.SourceFormat, err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMountCheckFormatMP3Bitrate(t *testing.T) {
+	ctx := context.Background()
+	vbr := audioFormat{Codec: "mp3", SampleRate: 44100, Channels: 2, Bitrate: 128000}
+	cbr := vbr
+	cbr.CBR = true
+	otherBitrate := audioFormat{Codec: "mp3", SampleRate: 44100, Channels: 2, Bitrate: 192000}
+	otherRate := audioFormat{Codec: "mp3", SampleRate: 48000, Channels: 2, Bitrate: 128000}
+	mono := audioFormat{Codec: "mp3", SampleRate: 44100, Channels: 1, Bitrate: 128000}
+
+	newMount := func(fallbacks string) *Mount {
+		cfg, err := config.Load(strings.NewReader(`
+[proxy]
+masterserver = ""
+mismatchedformat = "reject"
+` + fallbacks))
+		require.NoError(t, err)
+		return NewMount(ctx, cfg, nil, NewEventHandler(ctx, cfg), "/test.mp3", "audio/mpeg", nil)
+	}
+
+	t.Run("variable bitrate", func(t *testing.T) {
+		mount := newMount("")
+		require.NoError(t, mount.checkFormat(vbr))
+		// the bitrate of a single frame says nothing about the stream
+		assert.NoError(t, mount.checkFormat(otherBitrate))
+		assert.Error(t, mount.checkFormat(otherRate))
+		assert.Error(t, mount.checkFormat(mono))
+	})
+
+	t.Run("constant bitrate", func(t *testing.T) {
+		mount := newMount("")
+		require.NoError(t, mount.checkFormat(cbr))
+		assert.NoError(t, mount.checkFormat(vbr))
+		assert.Error(t, mount.checkFormat(otherBitrate))
+	})
+
+	t.Run("configured bitrate", func(t *testing.T) {
+		mount := newMount(`
+[[proxy.fallbacks]]
+mount = "/test.mp3"
+bitrate = 192
+`)
+		// the first source doesn't get to decide the bitrate
+		assert.Error(t, mount.checkFormat(vbr))
+		assert.Nil(t, mount.format, "a rejected source shouldn't set the mount format")
+		assert.NoError(t, mount.checkFormat(otherBitrate))
+		assert.Error(t, mount.checkFormat(otherRate))
+	})
+}
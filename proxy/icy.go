@@ -0,0 +1,88 @@
+package proxy
+
+import (
+	"io"
+	"strings"
+)
+
+// icyMaxMetadata is the maximum length of an ICY metadata block, the length is
+// send as a single byte multiplied by 16
+const icyMaxMetadata = 255 * 16
+
+// icyWriter interleaves ICY metadata blocks into the audio data written to it
+// every metaint bytes
+type icyWriter struct {
+	w        io.Writer
+	metaint  int
+	metadata func() string
+
+	// remaining is the amount of audio bytes left until the next metadata block
+	remaining int
+	// last is the last metadata send
+	last string
+}
+
+func newIcyWriter(w io.Writer, metaint int, metadata func() string) *icyWriter {
+	return &icyWriter{
+		w:         w,
+		metaint:   metaint,
+		metadata:  metadata,
+		remaining: metaint,
+	}
+}
+
+func (iw *icyWriter) Write(p []byte) (int, error) {
+	var n int
+	for len(p) > 0 {
+		chunk := min(len(p), iw.remaining)
+
+		written, err := iw.w.Write(p[:chunk])
+		n += written
+		if err != nil {
+			return n, err
+		}
+
+		p = p[chunk:]
+		iw.remaining -= chunk
+		if iw.remaining > 0 {
+			continue
+		}
+
+		if err = iw.writeMetadata(); err != nil {
+			return n, err
+		}
+		iw.remaining = iw.metaint
+	}
+	return n, nil
+}
+
+// writeMetadata writes a metadata block, the block is empty if the metadata
+// didn't change since the last one
+func (iw *icyWriter) writeMetadata() error {
+	metadata := iw.metadata()
+	if metadata == iw.last {
+		_, err := iw.w.Write([]byte{0})
+		return err
+	}
+
+	iw.last = metadata
+	_, err := iw.w.Write(icyMetadataBlock(metadata))
+	return err
+}
+
+// icyMetadataBlock returns the metadata as an ICY metadata block, including the
+// leading length byte
+func icyMetadataBlock(metadata string) []byte {
+	// the quote and semicolon at the end take up two bytes
+	content := "StreamTitle='" + metadata
+	if len(content) > icyMaxMetadata-2 {
+		content = strings.ToValidUTF8(content[:icyMaxMetadata-2], "")
+	}
+	content += "';"
+
+	length := (len(content) + 15) / 16
+	block := make([]byte, 1+length*16)
+	block[0] = byte(length)
+	copy(block[1:], content)
+	return block
+}
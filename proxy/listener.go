@@ -0,0 +1,350 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/rs/zerolog/hlog"
+)
+
+// listenerWriteTimeout is how long a single write to a listener can take before
+// we consider them gone
+const listenerWriteTimeout = time.Second * 10
+
+// ListenerRecorder is told about listeners joining and leaving, this is
+// implemented by the tracker
+type ListenerRecorder interface {
+	ListenerAdd(context.Context, radio.Listener)
+	ListenerRemove(context.Context, radio.ListenerClientID)
+}
+
+// Broadcaster sends the audio data of a mount to the listeners connected to the
+// proxy. A Broadcaster outlives the Mount it belongs to so that listeners stay
+// connected while the sources are swapped out
+type Broadcaster struct {
+	cfg  config.Config
+	Name string
+
+	// metadata is the metadata of the live source
+	metadata *util.TypedValue[string]
+	// contentType is the content-type of the mount
+	contentType *util.TypedValue[string]
+
+	// mu protects the fields below
+	mu        sync.Mutex
+	burst     []byte
+	listeners map[*ListenerClient]struct{}
+	// headers are the stream headers send to new listeners before the burst,
+	// only used by Ogg mounts
+	headers []byte
+}
+
+func NewBroadcaster(cfg config.Config, name, contentType string) *Broadcaster {
+	return &Broadcaster{
+		cfg:         cfg,
+		Name:        name,
+		metadata:    util.NewTypedValue(""),
+		contentType: util.NewTypedValue(contentType),
+		listeners:   make(map[*ListenerClient]struct{}),
+	}
+}
+
+// Write sends p to all listeners, listeners that can't keep up are disconnected
+func (b *Broadcaster) Write(p []byte) (int, error) {
+	cfg := b.cfg.Conf().Proxy.Listeners
+	// the listeners hold onto the chunk so we need our own copy
+	chunk := bytes.Clone(p)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.burst = appendBurst(b.burst, chunk, cfg.Burst)
+
+	for lc := range b.listeners {
+		if !lc.send(chunk, cfg.Buffer) {
+			delete(b.listeners, lc)
+			lc.Close()
+		}
+	}
+	return len(p), nil
+}
+
+// appendBurst appends p to burst and drops data from the front if it becomes
+// longer than size, the buffer is only compacted once it's twice as big to
+// avoid copying on every write
+func appendBurst(burst, p []byte, size int) []byte {
+	if size <= 0 {
+		return burst[:0]
+	}
+
+	burst = append(burst, p...)
+	if len(burst) > size*2 {
+		burst = append(burst[:0], burst[len(burst)-size:]...)
+	}
+	return burst
+}
+
+// SetHeaders sets the stream headers send to new listeners, the burst is reset
+// since it's from before these headers
+func (b *Broadcaster) SetHeaders(headers []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.headers = append(b.headers[:0], headers...)
+	b.burst = b.burst[:0]
+}
+
+// SetMetadata sets the metadata send to listeners that asked for it
+func (b *Broadcaster) SetMetadata(metadata string) {
+	b.metadata.Store(metadata)
+}
+
+// Metadata returns the current metadata
+func (b *Broadcaster) Metadata() string {
+	return b.metadata.Load()
+}
+
+// SetContentType sets the content-type send to new listeners
+func (b *Broadcaster) SetContentType(contentType string) {
+	b.contentType.Store(contentType)
+}
+
+// ContentType returns the content-type of the mount
+func (b *Broadcaster) ContentType() string {
+	return b.contentType.Load()
+}
+
+// AddListener adds the listener given and queues the burst for them
+func (b *Broadcaster) AddListener(lc *ListenerClient) {
+	cfg := b.cfg.Conf().Proxy.Listeners
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	burst := b.burst
+	if len(burst) > cfg.Burst {
+		burst = burst[len(burst)-cfg.Burst:]
+	}
+	if len(b.headers) > 0 || len(burst) > 0 {
+		chunk := append(bytes.Clone(b.headers), burst...)
+		lc.send(chunk, cfg.Buffer+len(chunk))
+	}
+	b.listeners[lc] = struct{}{}
+}
+
+// RemoveListener removes the listener given
+func (b *Broadcaster) RemoveListener(lc *ListenerClient) {
+	b.mu.Lock()
+	delete(b.listeners, lc)
+	b.mu.Unlock()
+}
+
+// ListenerCount returns the amount of listeners connected
+func (b *Broadcaster) ListenerCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.listeners)
+}
+
+// ListenerClient is a single listener connected to the proxy
+type ListenerClient struct {
+	Listener radio.Listener
+
+	data chan []byte
+	// queued is the amount of bytes in data
+	queued    atomic.Int64
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func NewListenerClient(listener radio.Listener, buffer int) *ListenerClient {
+	// chunks are at most a single read of a source, which is 4096 bytes but
+	// usually a lot smaller, the byte count in send is the actual limit
+	return &ListenerClient{
+		Listener: listener,
+		data:     make(chan []byte, max(buffer/512, 16)),
+		done:     make(chan struct{}),
+	}
+}
+
+// send queues chunk for the listener, it returns false if the listener has
+// more than limit bytes queued or is closed
+func (lc *ListenerClient) send(chunk []byte, limit int) bool {
+	if lc.queued.Load()+int64(len(chunk)) > int64(limit) {
+		return false
+	}
+
+	select {
+	case <-lc.done:
+		return false
+	case lc.data <- chunk:
+		lc.queued.Add(int64(len(chunk)))
+		return true
+	default:
+		return false
+	}
+}
+
+// Close disconnects the listener
+func (lc *ListenerClient) Close() {
+	lc.closeOnce.Do(func() { close(lc.done) })
+}
+
+// Run writes the data queued for the listener to w until either the listener is
+// closed or the context is canceled
+func (lc *ListenerClient) Run(ctx context.Context, w io.Writer, flush func() error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-lc.done:
+			return errSlowListener
+		case chunk := <-lc.data:
+			lc.queued.Add(-int64(len(chunk)))
+
+			_, err := w.Write(chunk)
+			if err != nil {
+				return err
+			}
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var errSlowListener = errors.New("listener is too slow")
+
+// GetListener serves the mount to a listener
+func (s *Server) GetListener(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cfg := s.cfg.Conf().Proxy.Listeners
+
+	if !cfg.Enabled {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	mountName := GetMountpoint(r)
+	b := s.proxy.Broadcaster(mountName)
+	if b == nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	listener := newProxyListener(r)
+	lc := NewListenerClient(listener, cfg.Buffer)
+	logger := hlog.FromRequest(r).With().
+		Str("mount", mountName).
+		Str("listener_id", listener.ID.String()).
+		Logger()
+
+	rc := http.NewResponseController(w)
+	// the write timeout of the server is for the whole response, so we have to
+	// use our own deadline for each write instead
+	var out io.Writer = deadlineWriter{w, rc}
+
+	w.Header().Set("Content-Type", b.ContentType())
+	w.Header().Set("Cache-Control", "no-cache, no-store")
+	// Ogg streams carry their own metadata so they never get ICY metadata
+	if r.Header.Get("Icy-MetaData") == "1" && cfg.MetaInt > 0 && !isOgg(b.ContentType()) {
+		w.Header().Set("Icy-Metaint", strconv.Itoa(cfg.MetaInt))
+		out = newIcyWriter(out, cfg.MetaInt, b.Metadata)
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		logger.Error().Err(err).Msg("failed to flush listener header")
+		return
+	}
+
+	b.AddListener(lc)
+	defer b.RemoveListener(lc)
+	defer lc.Close()
+
+	if s.recorder != nil {
+		go s.recorder.ListenerAdd(context.WithoutCancel(ctx), listener)
+		defer func() {
+			go s.recorder.ListenerRemove(context.WithoutCancel(ctx), listener.ID)
+		}()
+	}
+
+	logger.Info().Msg("listener connected")
+	err := lc.Run(ctx, out, rc.Flush)
+	logger.Info().Err(err).Msg("listener disconnected")
+}
+
+// deadlineWriter sets a write deadline of listenerWriteTimeout before each write
+type deadlineWriter struct {
+	w  io.Writer
+	rc *http.ResponseController
+}
+
+func (dw deadlineWriter) Write(p []byte) (int, error) {
+	_ = dw.rc.SetWriteDeadline(time.Now().Add(listenerWriteTimeout))
+	return dw.w.Write(p)
+}
+
+// newProxyListener returns the radio.Listener for a listener request made to
+// the proxy
+func newProxyListener(r *http.Request) radio.Listener {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return radio.Listener{
+		// icecast ids are sequential from zero, random ids won't clash with
+		// those in practice
+		ID:        radio.ListenerClientID(rand.Uint64()),
+		UserAgent: r.UserAgent(),
+		IP:        ip,
+		Start:     time.Now(),
+	}
+}
+
+// Broadcaster returns the Broadcaster for the mount given, or nil if the mount
+// has never had a source
+func (pm *ProxyManager) Broadcaster(name string) *Broadcaster {
+	pm.broadcastersMu.Lock()
+	defer pm.broadcastersMu.Unlock()
+	return pm.broadcasters[name]
+}
+
+// broadcaster returns the Broadcaster for the mount given, creating one if it
+// doesn't exist yet
+func (pm *ProxyManager) broadcaster(name, contentType string) *Broadcaster {
+	pm.broadcastersMu.Lock()
+	defer pm.broadcastersMu.Unlock()
+
+	b, ok := pm.broadcasters[name]
+	if !ok {
+		b = NewBroadcaster(pm.cfg, name, contentType)
+		pm.broadcasters[name] = b
+		return b
+	}
+
+	b.SetContentType(contentType)
+	return b
+}
+
+// ListenerCount returns the amount of listeners connected to the mount given
+func (pm *ProxyManager) ListenerCount(name string) int {
+	b := pm.Broadcaster(name)
+	if b == nil {
+		return 0
+	}
+	return b.ListenerCount()
+}
@@ -0,0 +1,94 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testListenerConfig(burst, buffer int) config.Config {
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Proxy.Listeners.Burst = burst
+	c.Proxy.Listeners.Buffer = buffer
+	cfg.StoreConf(c)
+	return cfg
+}
+
+func TestIcyWriter(t *testing.T) {
+	var buf bytes.Buffer
+	metadata := "hello world"
+	iw := newIcyWriter(&buf, 4, func() string { return metadata })
+
+	n, err := iw.Write([]byte("aaaabbbbcc"))
+	require.NoError(t, err)
+	assert.Equal(t, 10, n)
+
+	block := icyMetadataBlock("hello world")
+	expected := []byte("aaaa")
+	expected = append(expected, block...)
+	expected = append(expected, "bbbb"...)
+	// metadata didn't change so an empty block is send
+	expected = append(expected, 0)
+	expected = append(expected, "cc"...)
+	assert.Equal(t, expected, buf.Bytes())
+
+	// the block should be a multiple of 16 with the length in front
+	assert.Equal(t, 0, (len(block)-1)%16)
+	assert.Equal(t, byte((len(block)-1)/16), block[0])
+	assert.True(t, bytes.HasPrefix(block[1:], []byte("StreamTitle='hello world';")))
+}
+
+func TestBroadcasterBurst(t *testing.T) {
+	b := NewBroadcaster(testListenerConfig(8, 1024), "/main.mp3", "audio/mpeg")
+
+	for _, chunk := range []string{"0123", "4567", "89ab"} {
+		_, err := b.Write([]byte(chunk))
+		require.NoError(t, err)
+	}
+
+	lc := NewListenerClient(radio.Listener{}, 1024)
+	b.AddListener(lc)
+	assert.Equal(t, 1, b.ListenerCount())
+
+	_, err := b.Write([]byte("cdef"))
+	require.NoError(t, err)
+
+	var got []byte
+	for len(lc.data) > 0 {
+		got = append(got, <-lc.data...)
+	}
+	// the listener gets the last 8 bytes as burst and then the new data
+	assert.Equal(t, "456789abcdef", string(got))
+}
+
+func TestBroadcasterSlowListener(t *testing.T) {
+	b := NewBroadcaster(testListenerConfig(0, 8), "/main.mp3", "audio/mpeg")
+
+	lc := NewListenerClient(radio.Listener{}, 8)
+	b.AddListener(lc)
+
+	// nobody is reading from the listener so the second write goes over the
+	// buffer and should disconnect them
+	_, _ = b.Write([]byte("01234"))
+	assert.Equal(t, 1, b.ListenerCount())
+	_, _ = b.Write([]byte("56789"))
+	assert.Equal(t, 0, b.ListenerCount())
+
+	err := lc.Run(context.Background(), &bytes.Buffer{}, func() error { return nil })
+	assert.ErrorIs(t, err, errSlowListener)
+}
+
+func TestAppendBurst(t *testing.T) {
+	var burst []byte
+	for i := 0; i < 10; i++ {
+		burst = appendBurst(burst, []byte("abcd"), 6)
+		assert.LessOrEqual(t, len(burst), 12)
+	}
+	assert.True(t, bytes.HasSuffix(burst, []byte("cdabcd")))
+}
@@ -42,10 +42,14 @@ func Execute(ctx context.Context, cfg config.Config) error {
 		done()
 	}
 
-	errCh := make(chan error, 1)
+	errCh := make(chan error, 2)
 	go func() {
 		errCh <- srv.Start(ctx)
 	}()
+	go func() {
+		errCh <- srv.StartRPC(ctx)
+	}()
+	go cleanRecordings(ctx, cfg)
 
 	select {
 	case <-ctx.Done():
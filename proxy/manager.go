@@ -26,6 +26,9 @@ type ProxyManager struct {
 	mountsMu sync.Mutex
 	mounts   map[string]*Mount
 	cleanup  map[string]*time.Timer
+	// broadcastersMu protects broadcasters
+	broadcastersMu sync.Mutex
+	broadcasters   map[string]*Broadcaster
 }
 
 func NewProxyManager(ctx context.Context, cfg config.Config, eh *EventHandler) (*ProxyManager, error) {
@@ -37,7 +40,9 @@ func NewProxyManager(ctx context.Context, cfg config.Config, eh *EventHandler) (
 		metaStore:    make(map[Identifier]*Metadata),
 		mounts:       make(map[string]*Mount),
 		cleanup:      make(map[string]*time.Timer),
+		broadcasters: make(map[string]*Broadcaster),
 	}
+	eh.sources = m.sources
 	return m, nil
 }
 
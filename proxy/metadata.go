@@ -74,7 +74,10 @@ func (s *Server) GetMetadata(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) GetListClients(w http.ResponseWriter, r *http.Request) {
-	response := []byte("<?xml version=\"1.0\"?>\n<icestats><source mount=\"" + html.EscapeString(GetMountpoint(r)) + "\"><Listeners>0</Listeners></source></icestats>\n")
+	mount := GetMountpoint(r)
+	count := strconv.Itoa(s.proxy.ListenerCount(mount))
+
+	response := []byte("<?xml version=\"1.0\"?>\n<icestats><source mount=\"" + html.EscapeString(mount) + "\"><Listeners>" + count + "</Listeners></source></icestats>\n")
 
 	w.Header().Set("Content-Length", strconv.Itoa(len(response)))
 	_, err := w.Write(response)
@@ -201,11 +204,14 @@ func GetMountpoint(r *http.Request) string {
 }
 
 func GetAudioFormat(r *http.Request) string {
-	switch r.Header.Get("Content-Type") {
-	case "audio/mpeg":
+	contentType := r.Header.Get("Content-Type")
+	switch {
+	case isMP3(contentType):
 		return "MP3"
-	case "audio/ogg", "application/ogg":
+	case isOgg(contentType):
 		return "OGG"
+	case isAAC(contentType):
+		return "AAC"
 	}
 	return ""
 }
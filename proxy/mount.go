@@ -1,23 +1,27 @@
 package proxy
 
 import (
+	"bytes"
 	"cmp"
 	"context"
 	"fmt"
 	"io"
 	"net"
-	"net/url"
+	"os"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/proxy/recording"
 	"github.com/R-a-dio/valkyrie/streamer/icecast"
 	"github.com/R-a-dio/valkyrie/util"
 	"github.com/R-a-dio/valkyrie/util/graceful"
-	"github.com/cenkalti/backoff"
 	"github.com/rs/zerolog"
+	"github.com/spf13/afero"
 )
 
 const mountTimeout = time.Second * 5
@@ -28,20 +32,42 @@ type Mount struct {
 	pm     *ProxyManager
 	events *EventHandler
 
-	backOff backoff.BackOff
 	// ContentType of this mount, this can only be set during creation and all
 	// future clients afterwards will use the same content type
 	ContentType string
 	// Name of the mountpoint
 	Name string
 
-	// Conn is the conn to the icecast server
-	Conn *util.TypedValue[net.Conn]
+	// ctx is canceled when the mount is closed, this stops the upstreams
+	ctx    context.Context
+	cancel context.CancelFunc
+	// upstreams are the master servers the mount sends its data to, they are
+	// started on the first write
+	upstreams     []*upstream
+	upstreamsOnce *sync.Once
+	// headers are the stream headers of the current source, these are send to
+	// upstreams when they (re)connect
+	headers *util.TypedValue[[]byte]
+	// Broadcaster is where listeners of the proxy get their data from, this is
+	// nil if the mount has no ProxyManager
+	Broadcaster *Broadcaster
 
 	// Sources is the different sources of audio data, the mount
 	// broadcasts the data of the first entry and voids the others
 	SourcesMu *sync.RWMutex
 	Sources   []*MountSourceClient
+	// fallback is the fallback audio that is running while there are no
+	// sources, protected by SourcesMu
+	fallback *fallback
+
+	// format is the format of the first source, all sources after it should
+	// use the same format
+	formatMu *sync.Mutex
+	format   *audioFormat
+
+	// recordings is where the live sources of this mount are recorded to, nil
+	// if the mount isn't recorded
+	recordings *recording.Store
 }
 
 func NewMount(ctx context.Context,
@@ -50,94 +76,145 @@ func NewMount(ctx context.Context,
 	eh *EventHandler,
 	name string, // name of the mount
 	contentType string, // content-type of the new mount
-	conn net.Conn, // optional connection to the master server to re-use
+	conns map[string]net.Conn, // optional connections to the master servers to re-use, keyed by upstreamKey
 ) *Mount {
 	logger := zerolog.Ctx(ctx).With().Str("mount", name).Logger()
 
-	bo := config.NewConnectionBackoff(ctx)
-
 	mount := &Mount{
-		logger:      logger,
-		cfg:         cfg,
-		pm:          pm,
-		events:      eh,
-		backOff:     bo,
-		ContentType: contentType,
-		Name:        name,
-		Conn:        util.NewTypedValue(conn),
-		SourcesMu:   new(sync.RWMutex),
+		logger:        logger,
+		cfg:           cfg,
+		pm:            pm,
+		events:        eh,
+		ContentType:   contentType,
+		Name:          name,
+		upstreamsOnce: new(sync.Once),
+		headers:       util.NewTypedValue[[]byte](nil),
+		SourcesMu:     new(sync.RWMutex),
+		formatMu:      new(sync.Mutex),
+	}
+	mount.ctx, mount.cancel = context.WithCancel(ctx)
+	if pm != nil {
+		mount.Broadcaster = pm.broadcaster(name, contentType)
+	}
+	if conf := cfg.Conf().Proxy; conf.Recordings.Enabled && name == conf.PrimaryMountName {
+		mount.recordings = recording.NewStore(afero.NewOsFs(), conf.Recordings.Path)
+	}
+
+	for _, uri := range upstreamURLs(cfg, name) {
+		conn := conns[upstreamKey(uri)]
+		mount.upstreams = append(mount.upstreams,
+			newUpstream(logger, uri, contentType, mount.headers.Load, conn),
+		)
 	}
 
 	return mount
 }
 
-func (m *Mount) newConn() (net.Conn, error) {
-	var err error
-	var conn net.Conn
-	err = backoff.Retry(func() error {
-		uri := m.masterURL()
+// hasMaster returns true if a master server is configured, without one the
+// proxy only serves its own listeners
+func (m *Mount) hasMaster() bool {
+	return len(m.upstreams) > 0
+}
 
-		m.logger.Info().Str("url", uri.Redacted()).Msg("dialing icecast")
-		conn, err = icecast.DialURL(context.TODO(), uri, icecast.ContentType(m.ContentType))
-		if err != nil {
-			m.logger.Error().Err(err).Msg("failed connecting to master server")
-			return err
-		}
+func (m *Mount) sendMetadata(ctx context.Context, meta string) error {
+	m.events.eventLiveMetadataUpdate(ctx, m.Name, meta)
+	if m.Broadcaster != nil {
+		m.Broadcaster.SetMetadata(meta)
+	}
+	if !m.hasMaster() || isOgg(m.ContentType) {
+		// icecast reads the metadata of Ogg streams from the stream itself
 		return nil
-	}, m.backOff)
-	if err != nil {
-		return nil, err
 	}
 
-	return conn, nil
-}
+	// send the metadata to all upstreams at the same time so that a slow one
+	// doesn't delay the others
+	errs := make([]error, len(m.upstreams))
+	var wg sync.WaitGroup
+	for i, up := range m.upstreams {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = icecast.MetadataURL(up.url)(ctx, meta)
+			if errs[i] != nil {
+				up.logger.Error().Err(errs[i]).Msg("failed to send metadata to upstream")
+			}
+		}()
+	}
+	wg.Wait()
 
-func (m *Mount) masterURL() *url.URL {
-	master := m.cfg.Conf().Proxy.MasterServer.URL()
-	master.Path = m.Name
-	return master
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (m *Mount) sendMetadata(ctx context.Context, meta string) error {
-	m.events.eventLiveMetadataUpdate(ctx, m.Name, meta)
-	return icecast.MetadataURL(m.masterURL())(ctx, meta)
+// SetHeaders sets the stream headers that new listeners and upstreams get
+// before any other data, this should be called after the headers have been
+// written
+func (m *Mount) SetHeaders(headers []byte) {
+	m.headers.Store(bytes.Clone(headers))
+	if m.Broadcaster != nil {
+		m.Broadcaster.SetHeaders(headers)
+	}
 }
 
+// Write sends b to the listeners and upstreams of the mount, it never fails
+// and upstreams that can't keep up miss out on data instead
 func (m *Mount) Write(b []byte) (n int, err error) {
-	conn := m.Conn.Load()
-retry:
-	if conn == nil {
-		conn, err = m.newConn()
-		if err != nil {
-			return 0, err
+	if m.Broadcaster != nil {
+		// never fails, slow listeners are dropped instead
+		_, _ = m.Broadcaster.Write(b)
+	}
+	if !m.hasMaster() {
+		return len(b), nil
+	}
+
+	m.upstreamsOnce.Do(func() {
+		for _, up := range m.upstreams {
+			go up.run(m.ctx)
 		}
-		m.Conn.Store(conn)
+	})
+	for _, up := range m.upstreams {
+		up.Write(b)
 	}
+	return len(b), nil
+}
 
-	n, err = conn.Write(b)
-	if err != nil {
-		m.logger.Error().Err(err).Msg("failed to write to master")
-		// reset our connection
-		conn.Close()
-		conn = nil
-		goto retry
+// ListUpstreams returns the state of the upstreams of this mount
+func (m *Mount) ListUpstreams() []radio.ProxyUpstream {
+	res := make([]radio.ProxyUpstream, 0, len(m.upstreams))
+	for _, up := range m.upstreams {
+		res = append(res, up.ProxyUpstream(m.Name))
 	}
-	return n, err
+	return res
 }
 
 func (m *Mount) Close() error {
-	conn := m.Conn.Swap(nil)
-	if conn != nil {
-		return conn.Close()
-	}
+	m.cancel()
 
-	return nil
+	var err error
+	for _, up := range m.upstreams {
+		if cerr := up.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
 }
 
 type wireMount struct {
 	ContentType string
 	Name        string
 	SourceCount int
+	// Upstreams are the keys of the upstream connections that are send, the
+	// first one is send with the mount and the others each in their own
+	// wireUpstream message after it
+	Upstreams []string
+}
+
+type wireUpstream struct {
+	URL string
 }
 
 func (m *Mount) writeSelf(dst *net.UnixConn) error {
@@ -152,16 +229,40 @@ func (m *Mount) writeSelf(dst *net.UnixConn) error {
 		SourceCount: count,
 	}
 
-	fd, err := getFile(m.Conn.Load())
-	if err != nil {
-		return fmt.Errorf("fd failure in mountpoint: %w", err)
+	var fds []*os.File
+	defer func() {
+		for _, fd := range fds {
+			fd.Close()
+		}
+	}()
+	for _, up := range m.upstreams {
+		conn := up.conn.Load()
+		if conn == nil {
+			continue
+		}
+		fd, err := getFile(conn)
+		if err != nil {
+			return fmt.Errorf("fd failure in mountpoint: %w", err)
+		}
+		fds = append(fds, fd)
+		wm.Upstreams = append(wm.Upstreams, up.key)
 	}
-	defer fd.Close()
 
-	err = graceful.WriteJSONFile(dst, wm, fd)
+	var err error
+	if len(fds) > 0 {
+		err = graceful.WriteJSONFile(dst, wm, fds[0])
+	} else {
+		err = graceful.WriteJSON(dst, wm)
+	}
 	if err != nil {
 		return err
 	}
+	for i := 1; i < len(fds); i++ {
+		err = graceful.WriteJSONFile(dst, wireUpstream{URL: wm.Upstreams[i]}, fds[i])
+		if err != nil {
+			return err
+		}
+	}
 
 	for _, msc := range m.Sources {
 		err = msc.Source.writeSelf(dst)
@@ -177,20 +278,58 @@ func (m *Mount) readSelf(ctx context.Context, cfg config.Config, src *net.UnixCo
 
 	zerolog.Ctx(ctx).Debug().Msg("resume: reading mount")
 
-	conn, err := graceful.ReadJSONConn(src, &wm)
+	file, err := graceful.ReadJSONFile(src, &wm)
 	if err != nil {
 		return err
 	}
 
 	zerolog.Ctx(ctx).Debug().Any("wireMount", wm).Msg("resume")
 
-	newmount := NewMount(ctx, cfg, m.pm, m.pm.events, wm.Name, wm.ContentType, conn)
+	conns := make(map[string]net.Conn)
+	if file != nil {
+		conn, err := net.FileConn(file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+
+		key := ""
+		if len(wm.Upstreams) > 0 {
+			key = wm.Upstreams[0]
+		} else if urls := upstreamURLs(cfg, wm.Name); len(urls) > 0 {
+			// sent by an older version that only knew of a single master
+			key = upstreamKey(urls[0])
+		}
+		conns[key] = conn
+	}
+	for i := 1; i < len(wm.Upstreams); i++ {
+		var wu wireUpstream
+		conn, err := graceful.ReadJSONConn(src, &wu)
+		if err != nil {
+			return err
+		}
+		conns[wu.URL] = conn
+	}
+
+	newmount := NewMount(ctx, cfg, m.pm, m.pm.events, wm.Name, wm.ContentType, conns)
 	*m = *newmount
 
+	// close any connections to upstreams that are no longer configured
+	for key, conn := range conns {
+		if !slices.ContainsFunc(m.upstreams, func(up *upstream) bool { return up.key == key }) {
+			conn.Close()
+		}
+	}
+
 	if wm.SourceCount == 0 {
-		// this indicates the mount was probably in cleanup state and was gonna
-		// close connections soon, we do the same
-		m.pm.RemoveMount(newmount)
+		// this indicates the mount was either running its fallback, or was in
+		// cleanup state and was gonna close connections soon, we do the same
+		m.SourcesMu.Lock()
+		hasFallback := m.startFallback(ctx)
+		m.SourcesMu.Unlock()
+		if !hasFallback {
+			m.pm.RemoveMount(m)
+		}
 		return nil
 	}
 
@@ -245,19 +384,61 @@ type MountSourceClient struct {
 	Priority uint
 	// MW is the writer this source is writing to
 	MW *MountMetadataWriter
+	// recordings is where the source is recorded to while it's live, nil if
+	// the mount isn't recorded
+	recordings *recording.Store
 
+	cfg    config.Config
 	logger zerolog.Logger
 }
 
 func (msc *MountSourceClient) GoLive(ctx context.Context, out MetadataWriter) {
 	msc.MW.SetWriter(out)
 	msc.MW.SetLive(ctx, true)
+	msc.startRecording()
 	msc.logger.Info().
 		Str("req_id", msc.Source.ID.String()).
 		Any("identifier", msc.Source.Identifier).
 		Msg("switching to live")
 }
 
+func (msc *MountSourceClient) GoOffline(ctx context.Context) {
+	msc.MW.SetLive(ctx, false)
+	msc.MW.SetWriter(nil)
+	msc.stopRecording()
+	msc.logger.Info().
+		Str("req_id", msc.Source.ID.String()).
+		Any("identifier", msc.Source.Identifier).
+		Msg("switching to offline")
+}
+
+// startRecording starts a new recording of the source if the mount is recorded,
+// robots aren't recorded since they play what we already have
+func (msc *MountSourceClient) startRecording() {
+	if msc.recordings == nil {
+		return
+	}
+	if msc.cfg.Conf().Manager.Handover.IsRobot(&msc.Source.User) {
+		return
+	}
+
+	rec, err := msc.recordings.Create(msc.Source.User, msc.Source.MountName, msc.Source.ContentType)
+	if err != nil {
+		msc.logger.Error().Err(err).Msg("failed to start recording")
+		return
+	}
+	msc.MW.StartRecording(rec)
+	msc.logger.Info().Str("recording_id", rec.ID().String()).Msg("started recording")
+}
+
+// stopRecording stops the recording of the source if there is one
+func (msc *MountSourceClient) stopRecording() {
+	err := msc.MW.StopRecording()
+	if err != nil {
+		msc.logger.Error().Err(err).Msg("failed to stop recording")
+	}
+}
+
 // SendMetadata finds the source associated with this metadata and updates
 // their internal metadata. This does no transmission of metadata to the
 // master server.
@@ -275,19 +456,24 @@ func (m *Mount) SendMetadata(ctx context.Context, meta *Metadata) {
 }
 
 func (m *Mount) AddSource(ctx context.Context, source *SourceClient) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("address", source.conn.RemoteAddr().String()).
+		Str("mount", source.MountName).
+		Str("username", source.User.Username).
+		Logger()
+
 	mw := &MountMetadataWriter{
 		metadataFn: m.sendMetadata,
+		logger:     logger,
 	}
 
 	msc := &MountSourceClient{
-		Source:   source,
-		Priority: 0,
-		MW:       mw,
-		logger: zerolog.Ctx(ctx).With().
-			Str("address", source.conn.RemoteAddr().String()).
-			Str("mount", source.MountName).
-			Str("username", source.User.Username).
-			Logger(),
+		Source:     source,
+		Priority:   0,
+		MW:         mw,
+		recordings: m.recordings,
+		cfg:        m.cfg,
+		logger:     logger,
 	}
 	m.SourcesMu.Lock()
 	defer m.SourcesMu.Unlock()
@@ -302,6 +488,8 @@ func (m *Mount) AddSource(ctx context.Context, source *SourceClient) {
 	// check if this is our first source, if it is we can bump them
 	// live right away
 	if len(m.Sources) == 1 {
+		// take over from the fallback if it was running
+		m.stopFallback()
 		msc.GoLive(ctx, m)
 		// send event that we went live
 		m.events.eventNewLiveSource(ctx, m.Name, source)
@@ -337,6 +525,8 @@ func (m *Mount) RemoveSource(ctx context.Context, id SourceID) {
 		// and swap to another source if possible
 		m.liveSourceSwap(ctx)
 	}
+	// the session of the source is over, so is its recording
+	removed.stopRecording()
 
 	// send an event that we disconnected
 	m.events.eventSourceDisconnect(ctx, removed.Source)
@@ -348,7 +538,19 @@ func (m *Mount) RemoveSource(ctx context.Context, id SourceID) {
 func (m *Mount) liveSourceSwap(ctx context.Context) {
 	next := mostPriority(m.Sources)
 	if next != nil {
+		if next.MW.GetLive() {
+			// already live, nothing to swap
+			return
+		}
+		// take the live-ness away from the current live source, this only
+		// happens when the priorities were changed by hand
+		for _, msc := range m.Sources {
+			if msc.MW.GetLive() {
+				msc.GoOffline(ctx)
+			}
+		}
 		// let the next client go live
+		m.stopFallback()
 		next.GoLive(ctx, m)
 		// send event that we went live
 		m.events.eventNewLiveSource(ctx, m.Name, next.Source)
@@ -357,6 +559,10 @@ func (m *Mount) liveSourceSwap(ctx context.Context) {
 
 	// nobody to swap with, so that means we're empty send a nil event
 	m.events.eventNewLiveSource(ctx, m.Name, nil)
+	// keep the master connection and listeners around if we have a fallback
+	if m.startFallback(ctx) {
+		return
+	}
 	// nobody here, clean ourselves up
 	if m.pm != nil {
 		m.pm.RemoveMount(m)
@@ -382,6 +588,11 @@ func (m *Mount) RunMountSourceClient(ctx context.Context, msc *MountSourceClient
 	// the last time we send metadata
 	lastMetadata := time.Time{}
 
+	// data is only written in whole frames so that swapping the live source
+	// never cuts a frame in half
+	framer := newFramer(m.ContentType)
+	checkedFormat := false
+
 	<-graceful.Sync(ctx)
 
 	for {
@@ -402,17 +613,53 @@ func (m *Mount) RunMountSourceClient(ctx context.Context, msc *MountSourceClient
 			return
 		}
 
-		writen, err := msc.MW.Write(buf[:readn])
+		msc.Source.received.Add(uint64(readn))
+
+		data := buf[:readn]
+		if framer != nil {
+			data = framer.Push(data)
+			if format, ok := framer.Format(); ok && !checkedFormat {
+				checkedFormat = true
+				if err = m.checkFormat(format); err != nil {
+					msc.logger.Error().Err(err).Msg("rejecting source")
+					return
+				}
+			}
+		}
+
+		writen, err := msc.MW.Write(data)
 		if err != nil {
 			msc.logger.Error().Err(err).Msg("failed to write data")
 			return
 		}
-		if readn != writen {
+		if len(data) != writen {
 			// we didn't actually send all the data, there isn't much we can really do
 			// here, but this is most likely a network failure and we will be exiting soon
 			msc.logger.Info().Msg("failed to write all data")
 		}
 
+		if framer != nil {
+			// the headers are only updated after the write above, the data we
+			// just wrote can contain them already
+			if headers, ok := framer.Headers(); ok {
+				msc.MW.SetHeaders(headers)
+			}
+			// metadata from inside the stream is handled the same as metadata
+			// from the metadata route
+			if value, ok := framer.Metadata(); ok {
+				meta := &Metadata{
+					Time:       time.Now(),
+					Identifier: msc.Source.Identifier,
+					User:       msc.Source.User,
+					MountName:  msc.Source.MountName,
+					Addr:       msc.Source.conn.RemoteAddr().String(),
+					Value:      value,
+				}
+				msc.Source.Metadata.Store(meta)
+				m.events.eventMetadataUpdate(ctx, meta)
+			}
+		}
+
 		// then see if we have new metadata to send
 		meta := msc.Source.Metadata.Load()
 		if meta != nil && meta.Time.After(lastMetadata) {
@@ -432,13 +679,45 @@ type MountMetadataWriter struct {
 	Live bool
 	// out is the writer we write into
 	Out io.Writer
+
+	// headers are the stream headers of the source, these are written before
+	// anything else when we start writing to a new Out
+	headers []byte
+	// needHeaders is set when Out changed and the headers haven't been written
+	needHeaders atomic.Bool
+
+	// recording is what we're recording into while live, nil if we aren't
+	// being recorded
+	recording *recording.Writer
+	// recordingHeaders is set when the headers haven't been written to the
+	// recording yet
+	recordingHeaders atomic.Bool
+	// recordingFailed is set when writing to the recording failed, we don't
+	// try again after that
+	recordingFailed atomic.Bool
+
+	logger zerolog.Logger
+}
+
+// headerSetter is implemented by writers that want to know about the stream
+// headers written to them
+type headerSetter interface {
+	SetHeaders(headers []byte)
 }
 
 func (mmw *MountMetadataWriter) SendMetadata(ctx context.Context, meta *Metadata) {
 	mmw.mu.Lock()
 	mmw.Metadata = meta.Value
+	rec := mmw.recording
 	mmw.mu.Unlock()
 
+	if rec != nil {
+		err := rec.SetMetadata(meta.Value)
+		if err != nil {
+			mmw.logger.Error().Err(err).Str("metadata", meta.Value).Msg("failed to record metadata")
+		}
+	}
+
 	mmw.sendMetadata(ctx)
 }
 
@@ -468,12 +747,102 @@ func (mmw *MountMetadataWriter) Write(p []byte) (n int, err error) {
 		return len(p), nil
 	}
 
+	// a decoder can't do anything with our data without the headers, so if
+	// we just switched to this writer send those first. Unless we're at the
+	// start of a new stream in which case the headers are in p already
+	if mmw.needHeaders.Swap(false) && len(mmw.headers) > 0 && !oggStartsStream(p) {
+		_, err = mmw.Out.Write(mmw.headers)
+		if err != nil {
+			return 0, err
+		}
+		if hs, ok := mmw.Out.(headerSetter); ok {
+			hs.SetHeaders(mmw.headers)
+		}
+	}
+
+	if mmw.recording != nil {
+		mmw.record(p)
+	}
+
 	return mmw.Out.Write(p)
 }
 
+// record writes p to the recording, a broken recording shouldn't take the
+// source down with it so errors are only logged
+//
+// record should only be called with mmw.mu held
+func (mmw *MountMetadataWriter) record(p []byte) {
+	if mmw.recordingFailed.Load() {
+		return
+	}
+
+	var err error
+	// the same goes for recordings as for Out, they need the headers first
+	if mmw.recordingHeaders.Swap(false) && len(mmw.headers) > 0 && !oggStartsStream(p) {
+		_, err = mmw.recording.Write(mmw.headers)
+	}
+	if err == nil {
+		_, err = mmw.recording.Write(p)
+	}
+	if err != nil {
+		mmw.recordingFailed.Store(true)
+		mmw.logger.Error().Err(err).Str("recording_id", mmw.recording.ID().String()).Msg("failed to write to recording")
+	}
+}
+
+// StartRecording starts writing everything written to us into rec, any
+// previous recording is stopped
+func (mmw *MountMetadataWriter) StartRecording(rec *recording.Writer) {
+	mmw.mu.Lock()
+	old := mmw.recording
+	mmw.recording = rec
+	mmw.recordingHeaders.Store(true)
+	mmw.recordingFailed.Store(false)
+	metadata := mmw.Metadata
+	mmw.mu.Unlock()
+
+	if old != nil {
+		if err := old.Close(); err != nil {
+			mmw.logger.Error().Err(err).Msg("failed to stop recording")
+		}
+	}
+	if metadata != "" {
+		if err := rec.SetMetadata(metadata); err != nil {
+			mmw.logger.Error().Err(err).Str("metadata", metadata).Msg("failed to record metadata")
+		}
+	}
+}
+
+// StopRecording stops the recording if there is one
+func (mmw *MountMetadataWriter) StopRecording() error {
+	mmw.mu.Lock()
+	rec := mmw.recording
+	mmw.recording = nil
+	mmw.mu.Unlock()
+
+	if rec == nil {
+		return nil
+	}
+	return rec.Close()
+}
+
+// SetHeaders sets the stream headers of the source, this should be called
+// after the headers have been written
+func (mmw *MountMetadataWriter) SetHeaders(headers []byte) {
+	mmw.mu.Lock()
+	mmw.headers = append(mmw.headers[:0], headers...)
+	out := mmw.Out
+	mmw.mu.Unlock()
+
+	if hs, ok := out.(headerSetter); ok {
+		hs.SetHeaders(headers)
+	}
+}
+
 func (mmw *MountMetadataWriter) SetWriter(new io.Writer) {
 	mmw.mu.Lock()
 	mmw.Out = new
+	mmw.needHeaders.Store(new != nil)
 	mmw.mu.Unlock()
 }
 
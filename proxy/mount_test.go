@@ -3,13 +3,20 @@ package proxy
 import (
 	"bytes"
 	"context"
+	"io"
 	"net"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/proxy/recording"
 	"github.com/rs/xid"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLeastPriority(t *testing.T) {
@@ -77,7 +84,7 @@ func TestMostPriority(t *testing.T) {
 	prio := func(p uint) *MountSourceClient {
 		return &MountSourceClient{
 			Source: &SourceClient{
-				ID: SourceID{xid.New()},
+				ID: SourceID{ID: xid.New()},
 			},
 			Priority: p,
 		}
@@ -133,7 +140,7 @@ func TestMountRemoveSource(t *testing.T) {
 
 	user := newTestUser("test", "test")
 	req := httptest.NewRequest("PUT", mountName, conn2)
-	id := SourceID{xid.New()}
+	id := SourceID{ID: xid.New()}
 	identifier := IdentFromRequest(req)
 	metadata := &Metadata{}
 	_ = conn1
@@ -150,6 +157,63 @@ func TestMountRemoveSource(t *testing.T) {
 	assert.Equal(t, 0, getSourcesLength(mount), "should have no sources")
 }
 
+func TestMountSetPriorities(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.TestConfig()
+
+	eh := NewEventHandler(ctx, cfg)
+
+	mountName := "/test.mp3"
+	contentType := "audio/mpeg"
+
+	mount := NewMount(ctx, cfg, nil, eh, mountName, contentType, nil)
+	user := newTestUser("test", "test")
+
+	var sources []*SourceClient
+	for range 3 {
+		conn1, conn2 := net.Pipe()
+		defer conn1.Close()
+		req := httptest.NewRequest("PUT", mountName, conn2)
+		source := NewSourceClient(SourceID{ID: xid.New()}, "test", contentType,
+			mountName, conn2, *user, IdentFromRequest(req), &Metadata{})
+		mount.AddSource(ctx, source)
+		sources = append(sources, source)
+	}
+
+	live := func() []SourceID {
+		var ids []SourceID
+		for _, s := range mount.ListSources() {
+			if s.Live {
+				ids = append(ids, s.ID)
+			}
+		}
+		return ids
+	}
+	order := func() []SourceID {
+		var ids []SourceID
+		for _, s := range mount.ListSources() {
+			ids = append(ids, s.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []SourceID{sources[0].ID}, live(), "first source should be live")
+
+	err := mount.SetPriorities(ctx, []SourceID{sources[2].ID})
+	assert.NoError(t, err)
+	assert.Equal(t, []SourceID{sources[2].ID}, live(), "moved source should be live")
+	assert.Equal(t, []SourceID{sources[2].ID, sources[0].ID, sources[1].ID}, order())
+
+	err = mount.SetPriorities(ctx, []SourceID{sources[2].ID, sources[1].ID})
+	assert.NoError(t, err)
+	assert.Equal(t, []SourceID{sources[2].ID}, live(), "live source should stay live")
+	assert.Equal(t, []SourceID{sources[2].ID, sources[1].ID, sources[0].ID}, order())
+
+	err = mount.SetPriorities(ctx, []SourceID{{ID: xid.New()}})
+	assert.Error(t, err, "unknown source should error")
+	assert.Equal(t, []SourceID{sources[2].ID, sources[1].ID, sources[0].ID}, order())
+}
+
 func getSourcesLength(mount *Mount) int {
 	mount.SourcesMu.RLock()
 	defer mount.SourcesMu.RUnlock()
@@ -257,3 +321,91 @@ func TestMountMetadataWriterSendMetadata(t *testing.T) {
 	assert.True(t, called, "metadataFn should've been called after going live")
 	assert.Equal(t, meta.Value, calledValue)
 }
+
+func TestMountRecording(t *testing.T) {
+	ctx := context.Background()
+	mountName := "/test.mp3"
+	contentType := "audio/mpeg"
+
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Proxy.MasterServer = ""
+	c.Proxy.PrimaryMountName = mountName
+	c.Proxy.Recordings.Enabled = true
+	c.Proxy.Recordings.Path = t.TempDir()
+	cfg.StoreConf(c)
+	// the primary mount sends its metadata to the manager
+	cfg.Manager = &mocks.ManagerServiceMock{
+		UpdateSongFunc: func(context.Context, *radio.SongUpdate) error { return nil },
+		UpdateUserFunc: func(context.Context, *radio.User) error { return nil },
+	}
+
+	mount := NewMount(ctx, cfg, nil, NewEventHandler(ctx, cfg), mountName, contentType, nil)
+	require.NotNil(t, mount.recordings, "primary mount should be recorded")
+
+	conn1, conn2 := net.Pipe()
+	defer conn1.Close()
+	req := httptest.NewRequest("PUT", mountName, conn2)
+	source := NewSourceClient(SourceID{ID: xid.New()}, "test", contentType,
+		mountName, conn2, *newTestUser("test", "test"), IdentFromRequest(req), &Metadata{})
+	mount.AddSource(ctx, source)
+
+	frame, _, err := silentFrame(128, 44100, 2)
+	require.NoError(t, err)
+
+	source.Metadata.Store(&Metadata{Time: time.Now(), Value: "some song"})
+	var sent []byte
+	for range 10 {
+		_, err = conn1.Write(frame)
+		require.NoError(t, err)
+		sent = append(sent, frame...)
+	}
+
+	// the source leaving ends the recording
+	conn1.Close()
+	require.Eventually(t, func() bool {
+		return getSourcesLength(mount) == 0
+	}, time.Second*5, time.Millisecond*10)
+
+	store := recording.NewStore(afero.NewOsFs(), c.Proxy.Recordings.Path)
+	recs, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+
+	f, rec, err := store.Open(recs[0].ID)
+	require.NoError(t, err)
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	assert.Equal(t, sent, data)
+	assert.Equal(t, "test", rec.Username)
+	assert.Equal(t, mountName, rec.MountName)
+	if assert.Len(t, rec.Metadata, 1) {
+		assert.Equal(t, "some song", rec.Metadata[0].Value)
+	}
+
+	// robots aren't recorded
+	conn1, conn2 = net.Pipe()
+	defer conn1.Close()
+	robot := NewSourceClient(SourceID{ID: xid.New()}, "test", contentType,
+		mountName, conn2, *newTestUser("AFK", "test"), IdentFromRequest(req), &Metadata{})
+	require.True(t, c.Manager.Handover.IsRobot(&robot.User))
+	mount.AddSource(ctx, robot)
+	for range 10 {
+		_, err = conn1.Write(frame)
+		require.NoError(t, err)
+	}
+	conn1.Close()
+	require.Eventually(t, func() bool {
+		return getSourcesLength(mount) == 0
+	}, time.Second*5, time.Millisecond*10)
+
+	recs, err = store.List()
+	require.NoError(t, err)
+	assert.Len(t, recs, 1, "robot should not be recorded")
+
+	// other mounts aren't recorded
+	other := NewMount(ctx, cfg, nil, NewEventHandler(ctx, cfg), "/other.mp3", contentType, nil)
+	assert.Nil(t, other.recordings)
+}
@@ -0,0 +1,95 @@
+package proxy
+
+import (
+	"bytes"
+
+	"github.com/tcolgate/mp3"
+)
+
+// mp3MaxPending is the amount of bytes kept around while looking for the next
+// frame, data that doesn't contain a frame past this is thrown away
+const mp3MaxPending = 64 * 1024
+
+func newMP3Format(h mp3.FrameHeader) audioFormat {
+	channels := 2
+	if h.ChannelMode() == mp3.SingleChannel {
+		channels = 1
+	}
+	return audioFormat{
+		Codec:      "mp3",
+		SampleRate: int(h.SampleRate()),
+		Channels:   channels,
+		Bitrate:    int(h.BitRate()),
+	}
+}
+
+// hasInfoTag returns true if the mp3 frame given contains an Info tag, encoders
+// write this in the first frame of constant bitrate streams and a Xing tag for
+// variable bitrate ones. The tag comes right after the side information so we
+// only look at the start of the frame
+func hasInfoTag(frame []byte) bool {
+	return bytes.Contains(frame[:min(len(frame), 64)], []byte("Info"))
+}
+
+// mp3Framer splits a stream of mp3 data into whole frames, anything in between
+// frames such as ID3 tags is dropped
+type mp3Framer struct {
+	noStreamInfo
+
+	// pending is the data that doesn't make up a whole frame yet
+	pending []byte
+	frame   mp3.Frame
+	out     bytes.Buffer
+
+	// format is the format of the first frame found
+	format *audioFormat
+}
+
+func newMP3Framer() *mp3Framer {
+	return &mp3Framer{}
+}
+
+// Push adds p to the stream and returns all whole frames found so far, the
+// returned slice is only valid until the next call to Push
+func (mf *mp3Framer) Push(p []byte) []byte {
+	mf.pending = append(mf.pending, p...)
+	mf.out.Reset()
+
+	r := bytes.NewReader(mf.pending)
+	dec := mp3.NewDecoder(r)
+	// consumed is the amount of bytes that made up whole frames
+	var consumed int
+	for {
+		var skipped int
+		err := dec.Decode(&mf.frame, &skipped)
+		if err != nil {
+			// not enough data left for a whole frame, wait for more
+			break
+		}
+
+		consumed = len(mf.pending) - r.Len()
+		start := mf.out.Len()
+		_, _ = mf.out.ReadFrom(mf.frame.Reader())
+		if mf.format == nil {
+			format := newMP3Format(mf.frame.Header())
+			format.CBR = hasInfoTag(mf.out.Bytes()[start:])
+			mf.format = &format
+		}
+	}
+
+	mf.pending = append(mf.pending[:0], mf.pending[consumed:]...)
+	if len(mf.pending) > mp3MaxPending {
+		// this isn't mp3 or it's very broken, either way we can't use it
+		mf.pending = mf.pending[:0]
+	}
+	return mf.out.Bytes()
+}
+
+// Format returns the format of the first frame, or false if no frame has been
+// found yet
+func (mf *mp3Framer) Format() (audioFormat, bool) {
+	if mf.format == nil {
+		return audioFormat{}, false
+	}
+	return *mf.format, true
+}
@@ -0,0 +1,57 @@
+package proxy
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMP3Framer(t *testing.T) {
+	frame, _, err := silentFrame(128, 44100, 2)
+	require.NoError(t, err)
+
+	var frames []byte
+	for range 10 {
+		frames = append(frames, frame...)
+	}
+	// some junk in front that isn't a frame, like an ID3 tag
+	stream := append([]byte("ID3\x03\x00\x00\x00\x00\x00\x00"), frames...)
+
+	for _, size := range []int{1, 7, 100, len(frame), 4096} {
+		mf := newMP3Framer()
+		var out []byte
+		for i := 0; i < len(stream); i += size {
+			got := mf.Push(stream[i:min(i+size, len(stream))])
+			// every push should only return whole frames
+			assert.Zero(t, len(got)%len(frame), "chunk size %d", size)
+			out = append(out, got...)
+		}
+		assert.True(t, bytes.Equal(frames, out), "chunk size %d", size)
+
+		format, ok := mf.Format()
+		require.True(t, ok)
+		assert.Equal(t, audioFormat{Codec: "mp3", SampleRate: 44100, Channels: 2, Bitrate: 128000}, format)
+	}
+}
+
+func TestMP3FramerInfoTag(t *testing.T) {
+	frame, _, err := silentFrame(128, 44100, 2)
+	require.NoError(t, err)
+
+	mf := newMP3Framer()
+	mf.Push(frame)
+	format, ok := mf.Format()
+	require.True(t, ok)
+	assert.False(t, format.CBR)
+
+	// the tag goes right after the side information
+	tagged := bytes.Clone(frame)
+	copy(tagged[36:], "Info")
+	mf = newMP3Framer()
+	mf.Push(append(tagged, frame...))
+	format, ok = mf.Format()
+	require.True(t, ok)
+	assert.True(t, format.CBR)
+}
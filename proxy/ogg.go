@@ -0,0 +1,334 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+)
+
+const (
+	// oggHeaderSize is the size of an Ogg page header without the segment table
+	oggHeaderSize = 27
+	// oggMaxHeaders is the most header data kept for a single logical stream,
+	// comment headers can include cover art and we don't want to keep around
+	// megabytes of that
+	oggMaxHeaders = 1024 * 1024
+
+	// oggFlagBOS marks the first page of a logical stream
+	oggFlagBOS = 0x02
+)
+
+var oggCapturePattern = []byte("OggS")
+
+// oggCRCTable is the lookup table for the Ogg page checksum, this is a CRC-32
+// with polynomial 0x04c11db7 that isn't bit-reflected like the usual one
+var oggCRCTable = func() (table [256]uint32) {
+	for i := range table {
+		r := uint32(i) << 24
+		for range 8 {
+			if r&0x80000000 != 0 {
+				r = r<<1 ^ 0x04c11db7
+			} else {
+				r <<= 1
+			}
+		}
+		table[i] = r
+	}
+	return table
+}()
+
+// oggChecksum returns the checksum of the page given, the checksum field in the
+// page is treated as zero
+func oggChecksum(page []byte) uint32 {
+	var crc uint32
+	for i, b := range page {
+		if i >= 22 && i < 26 {
+			b = 0
+		}
+		crc = crc<<8 ^ oggCRCTable[byte(crc>>24)^b]
+	}
+	return crc
+}
+
+// oggFramer splits an Ogg stream into whole pages and keeps track of the
+// header pages and Vorbis comments of the current logical stream. Chained
+// streams, where a new logical stream starts after the previous one ended, are
+// supported but multiplexed streams are not
+type oggFramer struct {
+	// pending is the data that doesn't make up a whole page yet
+	pending []byte
+	out     bytes.Buffer
+
+	// headers are the header pages of the current logical stream
+	headers        []byte
+	headersChanged bool
+	// headerPackets is the amount of header packets the codec uses, and
+	// packets the amount of packets completed since the start of the stream
+	headerPackets int
+	packets       int
+	// packet is the header packet being put together
+	packet []byte
+
+	// format is the format of the first logical stream
+	format *audioFormat
+
+	metadata        string
+	metadataChanged bool
+}
+
+func newOggFramer() *oggFramer {
+	return &oggFramer{}
+}
+
+// Push adds p to the stream and returns all whole pages found so far, the
+// returned slice is only valid until the next call to Push
+func (of *oggFramer) Push(p []byte) []byte {
+	of.pending = append(of.pending, p...)
+	of.out.Reset()
+
+	var pos int
+	for {
+		i := bytes.Index(of.pending[pos:], oggCapturePattern)
+		if i < 0 {
+			// keep a possible partial capture pattern around
+			pos = max(pos, len(of.pending)-len(oggCapturePattern)+1)
+			break
+		}
+		pos += i
+
+		page, ok := of.page(of.pending[pos:])
+		if !ok {
+			// either not enough data yet, or this isn't actually a page
+			if page == nil {
+				break
+			}
+			pos++
+			continue
+		}
+
+		of.handlePage(page)
+		of.out.Write(page)
+		pos += len(page)
+	}
+
+	of.pending = append(of.pending[:0], of.pending[pos:]...)
+	return of.out.Bytes()
+}
+
+// page returns the page at the start of b and true if it's valid, if there
+// isn't enough data for the whole page it returns nil and false
+func (of *oggFramer) page(b []byte) ([]byte, bool) {
+	if len(b) < oggHeaderSize {
+		return nil, false
+	}
+	if b[4] != 0 {
+		// only version 0 exists
+		return b[:1], false
+	}
+
+	segments := int(b[26])
+	if len(b) < oggHeaderSize+segments {
+		return nil, false
+	}
+
+	size := oggHeaderSize + segments
+	for _, lace := range b[oggHeaderSize : oggHeaderSize+segments] {
+		size += int(lace)
+	}
+	if len(b) < size {
+		return nil, false
+	}
+
+	page := b[:size]
+	if binary.LittleEndian.Uint32(page[22:26]) != oggChecksum(page) {
+		return b[:1], false
+	}
+	return page, true
+}
+
+// handlePage collects the header pages and parses the header packets
+func (of *oggFramer) handlePage(page []byte) {
+	segments := int(page[26])
+	lacing := page[oggHeaderSize : oggHeaderSize+segments]
+	body := page[oggHeaderSize+segments:]
+
+	if page[5]&oggFlagBOS != 0 {
+		// start of a new logical stream, the headers of the old one are of no
+		// use anymore
+		of.headers = of.headers[:0]
+		of.packet = of.packet[:0]
+		of.packets = 0
+		of.headerPackets = oggHeaderPackets(body)
+	}
+
+	if of.packets >= of.headerPackets {
+		// an audio page
+		return
+	}
+
+	if len(of.headers)+len(page) > oggMaxHeaders {
+		// too big to keep around, act like there were no headers
+		of.headers = of.headers[:0]
+		of.packet = of.packet[:0]
+		of.headerPackets = 0
+		return
+	}
+	of.headers = append(of.headers, page...)
+	of.headersChanged = true
+
+	for _, lace := range lacing {
+		of.packet = append(of.packet, body[:lace]...)
+		body = body[lace:]
+		if lace == 255 {
+			// packet continues in the next segment
+			continue
+		}
+
+		switch of.packets {
+		case 0:
+			if format, ok := oggParseFormat(of.packet); ok && of.format == nil {
+				of.format = &format
+			}
+		case 1:
+			if metadata, ok := oggParseComments(of.packet); ok && metadata != of.metadata {
+				of.metadata = metadata
+				of.metadataChanged = true
+			}
+		}
+		of.packets++
+		of.packet = of.packet[:0]
+	}
+}
+
+// Format returns the format of the first logical stream, or false if no
+// stream has been found yet
+func (of *oggFramer) Format() (audioFormat, bool) {
+	if of.format == nil {
+		return audioFormat{}, false
+	}
+	return *of.format, true
+}
+
+// Headers returns the header pages of the current logical stream
+func (of *oggFramer) Headers() ([]byte, bool) {
+	changed := of.headersChanged
+	of.headersChanged = false
+	return of.headers, changed
+}
+
+// Metadata returns the metadata from the Vorbis comments of the current
+// logical stream
+func (of *oggFramer) Metadata() (string, bool) {
+	changed := of.metadataChanged
+	of.metadataChanged = false
+	return of.metadata, changed
+}
+
+// oggHeaderPackets returns the amount of header packets of the codec that
+// starts with the packet given
+func oggHeaderPackets(packet []byte) int {
+	switch {
+	case bytes.HasPrefix(packet, []byte("\x01vorbis")):
+		// identification, comment and setup
+		return 3
+	case bytes.HasPrefix(packet, []byte("OpusHead")):
+		// identification and comment
+		return 2
+	}
+	// we don't know what this is, so only keep the first page around
+	return 1
+}
+
+// oggParseFormat parses the identification header of Vorbis and Opus streams
+func oggParseFormat(packet []byte) (audioFormat, bool) {
+	switch {
+	case bytes.HasPrefix(packet, []byte("\x01vorbis")) && len(packet) >= 24:
+		return audioFormat{
+			Codec:      "vorbis",
+			Channels:   int(packet[11]),
+			SampleRate: int(binary.LittleEndian.Uint32(packet[12:16])),
+			// this is the nominal bitrate and can be unset
+			Bitrate: max(0, int(int32(binary.LittleEndian.Uint32(packet[20:24])))),
+		}, true
+	case bytes.HasPrefix(packet, []byte("OpusHead")) && len(packet) >= 19:
+		return audioFormat{
+			Codec:    "opus",
+			Channels: int(packet[9]),
+			// opus is always decoded at 48kHz, the sample rate in the header
+			// is only the rate of the original input
+			SampleRate: 48000,
+		}, true
+	}
+	return audioFormat{}, false
+}
+
+// oggParseComments parses the comment header of Vorbis and Opus streams and
+// returns the metadata made from it in the same way icecast does
+func oggParseComments(packet []byte) (string, bool) {
+	switch {
+	case bytes.HasPrefix(packet, []byte("\x03vorbis")):
+		packet = packet[7:]
+	case bytes.HasPrefix(packet, []byte("OpusTags")):
+		packet = packet[8:]
+	default:
+		return "", false
+	}
+
+	next := func() ([]byte, bool) {
+		if len(packet) < 4 {
+			return nil, false
+		}
+		n := binary.LittleEndian.Uint32(packet)
+		packet = packet[4:]
+		if uint64(n) > uint64(len(packet)) {
+			return nil, false
+		}
+		b := packet[:n]
+		packet = packet[n:]
+		return b, true
+	}
+
+	// vendor string
+	if _, ok := next(); !ok {
+		return "", false
+	}
+	if len(packet) < 4 {
+		return "", false
+	}
+	count := binary.LittleEndian.Uint32(packet)
+	packet = packet[4:]
+
+	var artist, title string
+	for range count {
+		comment, ok := next()
+		if !ok {
+			return "", false
+		}
+		key, value, ok := strings.Cut(string(comment), "=")
+		if !ok {
+			continue
+		}
+		switch strings.ToUpper(key) {
+		case "ARTIST":
+			artist = value
+		case "TITLE":
+			title = value
+		}
+	}
+
+	switch {
+	case artist != "" && title != "":
+		return artist + " - " + title, true
+	case title != "":
+		return title, true
+	case artist != "":
+		return artist, true
+	}
+	return "", false
+}
+
+// oggStartsStream returns true if b starts with the first page of a logical
+// stream
+func oggStartsStream(b []byte) bool {
+	return len(b) >= oggHeaderSize && bytes.HasPrefix(b, oggCapturePattern) && b[5]&oggFlagBOS != 0
+}
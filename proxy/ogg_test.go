@@ -0,0 +1,174 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// oggTestPage returns an Ogg page containing the packets given
+func oggTestPage(flags byte, serial, sequence uint32, packets ...[]byte) []byte {
+	var lacing, body []byte
+	for _, packet := range packets {
+		n := len(packet)
+		for n >= 255 {
+			lacing = append(lacing, 255)
+			n -= 255
+		}
+		lacing = append(lacing, byte(n))
+		body = append(body, packet...)
+	}
+
+	page := make([]byte, oggHeaderSize, oggHeaderSize+len(lacing)+len(body))
+	copy(page, oggCapturePattern)
+	page[5] = flags
+	binary.LittleEndian.PutUint32(page[14:18], serial)
+	binary.LittleEndian.PutUint32(page[18:22], sequence)
+	page[26] = byte(len(lacing))
+	page = append(page, lacing...)
+	page = append(page, body...)
+	binary.LittleEndian.PutUint32(page[22:26], oggChecksum(page))
+	return page
+}
+
+func vorbisTestIdent(channels byte, sampleRate uint32) []byte {
+	packet := make([]byte, 30)
+	copy(packet, "\x01vorbis")
+	packet[11] = channels
+	binary.LittleEndian.PutUint32(packet[12:16], sampleRate)
+	binary.LittleEndian.PutUint32(packet[20:24], 128000)
+	return packet
+}
+
+func vorbisTestComments(comments ...string) []byte {
+	packet := []byte("\x03vorbis")
+	packet = binary.LittleEndian.AppendUint32(packet, 4)
+	packet = append(packet, "test"...)
+	packet = binary.LittleEndian.AppendUint32(packet, uint32(len(comments)))
+	for _, c := range comments {
+		packet = binary.LittleEndian.AppendUint32(packet, uint32(len(c)))
+		packet = append(packet, c...)
+	}
+	return packet
+}
+
+// vorbisTestStream returns the header pages and audio pages of a vorbis stream
+func vorbisTestStream(serial uint32, comments ...string) (headers, audio []byte) {
+	headers = append(headers, oggTestPage(oggFlagBOS, serial, 0, vorbisTestIdent(2, 44100))...)
+	headers = append(headers, oggTestPage(0, serial, 1,
+		vorbisTestComments(comments...),
+		append([]byte("\x05vorbis"), bytes.Repeat([]byte{0}, 300)...),
+	)...)
+	for i := range uint32(5) {
+		audio = append(audio, oggTestPage(0, serial, 2+i, bytes.Repeat([]byte{byte(i)}, 600))...)
+	}
+	return headers, audio
+}
+
+func TestOggFramer(t *testing.T) {
+	headers, audio := vorbisTestStream(1, "ARTIST=someone", "title=something")
+	nextHeaders, nextAudio := vorbisTestStream(2, "TITLE=else")
+
+	var stream []byte
+	stream = append(stream, "junk"...)
+	stream = append(stream, headers...)
+	stream = append(stream, audio...)
+	// a page with a broken checksum should be dropped
+	broken := oggTestPage(0, 1, 7, []byte("broken"))
+	broken[len(broken)-1] ^= 0xFF
+	stream = append(stream, broken...)
+	stream = append(stream, nextHeaders...)
+	stream = append(stream, nextAudio...)
+
+	var expected []byte
+	expected = append(expected, headers...)
+	expected = append(expected, audio...)
+	expected = append(expected, nextHeaders...)
+	expected = append(expected, nextAudio...)
+
+	for _, size := range []int{1, 7, 100, 1000} {
+		of := newOggFramer()
+		var out []byte
+		var metadata []string
+		var lastHeaders []byte
+		for i := 0; i < len(stream); i += size {
+			got := of.Push(stream[i:min(i+size, len(stream))])
+			// every push should only return whole pages
+			for rest := got; len(rest) > 0; {
+				page, valid := of.page(rest)
+				if !assert.True(t, valid, "chunk size %d", size) {
+					break
+				}
+				rest = rest[len(page):]
+			}
+			out = append(out, got...)
+
+			if value, ok := of.Metadata(); ok {
+				metadata = append(metadata, value)
+			}
+			if h, ok := of.Headers(); ok {
+				lastHeaders = bytes.Clone(h)
+			}
+		}
+		assert.Equal(t, expected, out, "chunk size %d", size)
+		assert.Equal(t, []string{"someone - something", "else"}, metadata, "chunk size %d", size)
+		assert.Equal(t, nextHeaders, lastHeaders, "chunk size %d", size)
+
+		format, ok := of.Format()
+		require.True(t, ok)
+		assert.Equal(t, audioFormat{Codec: "vorbis", SampleRate: 44100, Channels: 2, Bitrate: 128000}, format)
+	}
+}
+
+func TestMountMetadataWriterHeaders(t *testing.T) {
+	ctx := context.Background()
+	headers, audio := vorbisTestStream(1, "TITLE=something")
+
+	mw := &MountMetadataWriter{
+		metadataFn: func(context.Context, string) error { return nil },
+	}
+
+	// the source starts offline, nothing should reach the writer
+	of := newOggFramer()
+	_, err := mw.Write(of.Push(headers))
+	require.NoError(t, err)
+	h, ok := of.Headers()
+	require.True(t, ok)
+	mw.SetHeaders(h)
+
+	// going live in the middle of the stream should send the headers first
+	var out bytes.Buffer
+	mw.SetWriter(&out)
+	mw.SetLive(ctx, true)
+	_, err = mw.Write(of.Push(audio))
+	require.NoError(t, err)
+	assert.Equal(t, append(bytes.Clone(headers), audio...), out.Bytes())
+
+	// but not if we went live right at the start of a new stream
+	out.Reset()
+	mw.SetWriter(&out)
+	_, err = mw.Write(of.Push(headers))
+	require.NoError(t, err)
+	assert.Equal(t, headers, out.Bytes())
+}
+
+func TestBroadcasterHeaders(t *testing.T) {
+	headers, audio := vorbisTestStream(1, "TITLE=something")
+
+	b := NewBroadcaster(testListenerConfig(1024*1024, 1024*1024), "/test.ogg", "audio/ogg")
+	_, _ = b.Write(headers)
+	b.SetHeaders(headers)
+	_, _ = b.Write(audio)
+
+	// new listeners should get the headers followed by the burst, without
+	// the headers showing up twice
+	lc := NewListenerClient(radio.Listener{}, 1024*1024)
+	b.AddListener(lc)
+	chunk := <-lc.data
+	assert.Equal(t, append(bytes.Clone(headers), audio...), chunk)
+}
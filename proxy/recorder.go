@@ -0,0 +1,80 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/rs/zerolog"
+)
+
+// trackerRecorder reports listeners to the tracker the same way icecast does
+// with its url authentication
+type trackerRecorder struct {
+	cfg    config.Config
+	logger zerolog.Logger
+	client *http.Client
+}
+
+// NewTrackerRecorder returns a ListenerRecorder that reports listeners to the
+// tracker configured, it returns nil if no tracker is configured
+func NewTrackerRecorder(ctx context.Context, cfg config.Config) ListenerRecorder {
+	if cfg.Conf().Proxy.Listeners.TrackerURL == "" {
+		return nil
+	}
+
+	return &trackerRecorder{
+		cfg:    cfg,
+		logger: *zerolog.Ctx(ctx),
+		client: &http.Client{Timeout: time.Second * 5},
+	}
+}
+
+func (tr *trackerRecorder) ListenerAdd(ctx context.Context, listener radio.Listener) {
+	err := tr.post(ctx, "/listener_joined", url.Values{
+		"client": {listener.ID.String()},
+		"ip":     {listener.IP},
+		"agent":  {listener.UserAgent},
+	})
+	if err != nil {
+		tr.logger.Error().Err(err).Str("listener_id", listener.ID.String()).Msg("failed to report listener join")
+	}
+}
+
+func (tr *trackerRecorder) ListenerRemove(ctx context.Context, id radio.ListenerClientID) {
+	err := tr.post(ctx, "/listener_left", url.Values{
+		"client": {id.String()},
+	})
+	if err != nil {
+		tr.logger.Error().Err(err).Str("listener_id", id.String()).Msg("failed to report listener leave")
+	}
+}
+
+func (tr *trackerRecorder) post(ctx context.Context, path string, form url.Values) error {
+	const op errors.Op = "proxy/trackerRecorder.post"
+
+	uri := strings.TrimSuffix(tr.cfg.Conf().Proxy.Listeners.TrackerURL, "/") + path
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.E(op, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", tr.cfg.Conf().UserAgent)
+
+	resp, err := tr.client.Do(req)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.E(op, "unexpected status code: "+resp.Status)
+	}
+	return nil
+}
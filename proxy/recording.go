@@ -0,0 +1,44 @@
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/proxy/recording"
+	"github.com/rs/zerolog"
+	"github.com/spf13/afero"
+)
+
+// recordingCleanInterval is how often recordings past their retention are
+// looked for
+const recordingCleanInterval = time.Hour
+
+// cleanRecordings removes the recordings that are past their retention, it
+// runs until ctx is canceled
+func cleanRecordings(ctx context.Context, cfg config.Config) {
+	logger := zerolog.Ctx(ctx)
+
+	ticker := time.NewTicker(recordingCleanInterval)
+	defer ticker.Stop()
+
+	for {
+		conf := cfg.Conf().Proxy.Recordings
+		if conf.Enabled && conf.Retention > 0 {
+			before := time.Now().Add(-time.Duration(conf.Retention))
+			removed, err := recording.NewStore(afero.NewOsFs(), conf.Path).Clean(before)
+			if err != nil {
+				logger.Error().Err(err).Msg("failed to clean recordings")
+			}
+			if removed > 0 {
+				logger.Info().Int("removed", removed).Msg("cleaned recordings")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
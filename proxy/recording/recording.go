@@ -0,0 +1,325 @@
+// Package recording stores recordings of live sources on disk. Every recording
+// is an audio file with a JSON sidecar next to it that describes the recording
+// and the metadata changes during it
+package recording
+
+import (
+	"encoding/json"
+	"mime"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/spf13/afero"
+)
+
+// sidecarExt is the extension of the sidecar files
+const sidecarExt = ".json"
+
+// Store is a directory of recordings
+type Store struct {
+	fs  afero.Fs
+	dir string
+}
+
+// NewStore returns a Store that keeps its recordings in dir on the fs given
+func NewStore(fs afero.Fs, dir string) *Store {
+	return &Store{fs: fs, dir: dir}
+}
+
+// Create starts a new recording of user streaming to the mount given
+func (s *Store) Create(user radio.User, mountName, contentType string) (*Writer, error) {
+	const op errors.Op = "recording/Store.Create"
+
+	err := s.fs.MkdirAll(s.dir, 0755)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	id := radio.NewRecordingID()
+	rec := radio.Recording{
+		ID:          id,
+		UserID:      user.ID,
+		Username:    user.Username,
+		MountName:   mountName,
+		ContentType: contentType,
+		Filename:    id.String() + extension(contentType),
+		Start:       time.Now(),
+	}
+
+	f, err := s.fs.OpenFile(filepath.Join(s.dir, rec.Filename), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	w := &Writer{
+		store: s,
+		file:  f,
+		rec:   rec,
+	}
+	// write the sidecar right away so that the recording shows up even if we
+	// never get to close it
+	err = s.writeSidecar(rec)
+	if err != nil {
+		f.Close()
+		s.fs.Remove(f.Name())
+		return nil, errors.E(op, err)
+	}
+	return w, nil
+}
+
+// List returns all recordings, newest first
+func (s *Store) List() ([]radio.Recording, error) {
+	const op errors.Op = "recording/Store.List"
+
+	entries, err := afero.ReadDir(s.fs, s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// nothing has been recorded yet
+			return nil, nil
+		}
+		return nil, errors.E(op, err)
+	}
+
+	var recs []radio.Recording
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, sidecarExt) {
+			continue
+		}
+
+		id, err := radio.ParseRecordingID(strings.TrimSuffix(name, sidecarExt))
+		if err != nil {
+			// not one of ours
+			continue
+		}
+
+		rec, err := s.Get(id)
+		if err != nil {
+			// the audio file might have been removed by hand, or we're racing
+			// with a cleanup, either way just skip it
+			continue
+		}
+		recs = append(recs, rec)
+	}
+
+	slices.SortFunc(recs, func(a, b radio.Recording) int {
+		return b.Start.Compare(a.Start)
+	})
+	return recs, nil
+}
+
+// Get returns the recording with the id given
+func (s *Store) Get(id radio.RecordingID) (radio.Recording, error) {
+	const op errors.Op = "recording/Store.Get"
+
+	var rec radio.Recording
+
+	data, err := afero.ReadFile(s.fs, s.sidecarPath(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return rec, errors.E(op, errors.RecordingUnknown, err)
+		}
+		return rec, errors.E(op, err)
+	}
+
+	err = json.Unmarshal(data, &rec)
+	if err != nil {
+		return rec, errors.E(op, err)
+	}
+
+	fi, err := s.fs.Stat(filepath.Join(s.dir, filepath.Base(rec.Filename)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return rec, errors.E(op, errors.RecordingUnknown, err)
+		}
+		return rec, errors.E(op, err)
+	}
+	rec.Size = fi.Size()
+	if rec.End.IsZero() {
+		// the recording was never stopped, so the last write is as close as we
+		// can get to when it ended
+		rec.End = fi.ModTime()
+	}
+	return rec, nil
+}
+
+// Open returns the recording with the id given and its audio file
+func (s *Store) Open(id radio.RecordingID) (afero.File, radio.Recording, error) {
+	const op errors.Op = "recording/Store.Open"
+
+	rec, err := s.Get(id)
+	if err != nil {
+		return nil, rec, errors.E(op, err)
+	}
+
+	f, err := s.fs.Open(filepath.Join(s.dir, filepath.Base(rec.Filename)))
+	if err != nil {
+		return nil, rec, errors.E(op, err)
+	}
+	return f, rec, nil
+}
+
+// Clean removes the recordings that ended before the time given, it returns
+// the amount of recordings removed
+func (s *Store) Clean(before time.Time) (int, error) {
+	const op errors.Op = "recording/Store.Clean"
+
+	recs, err := s.List()
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+
+	var removed int
+	for _, rec := range recs {
+		if !rec.End.Before(before) {
+			continue
+		}
+
+		err = s.fs.Remove(filepath.Join(s.dir, filepath.Base(rec.Filename)))
+		if err != nil && !os.IsNotExist(err) {
+			return removed, errors.E(op, err)
+		}
+		err = s.fs.Remove(s.sidecarPath(rec.ID))
+		if err != nil && !os.IsNotExist(err) {
+			return removed, errors.E(op, err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+func (s *Store) sidecarPath(id radio.RecordingID) string {
+	return filepath.Join(s.dir, id.String()+sidecarExt)
+}
+
+// writeSidecar writes the sidecar of the recording given, the old sidecar is
+// replaced in one go so that readers never see a partial file
+func (s *Store) writeSidecar(rec radio.Recording) error {
+	const op errors.Op = "recording/Store.writeSidecar"
+
+	data, err := json.MarshalIndent(rec, "", "\t")
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	path := s.sidecarPath(rec.ID)
+	tmp := path + ".tmp"
+	err = afero.WriteFile(s.fs, tmp, data, 0644)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	err = s.fs.Rename(tmp, path)
+	if err != nil {
+		s.fs.Remove(tmp)
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// Writer writes a single recording
+type Writer struct {
+	store *Store
+
+	mu     sync.Mutex
+	file   afero.File
+	rec    radio.Recording
+	closed bool
+}
+
+// ID returns the id of the recording
+func (w *Writer) ID() radio.RecordingID {
+	return w.rec.ID
+}
+
+// Write writes audio data to the recording
+func (w *Writer) Write(p []byte) (int, error) {
+	const op errors.Op = "recording/Writer.Write"
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return 0, errors.E(op, os.ErrClosed)
+	}
+
+	n, err := w.file.Write(p)
+	w.rec.Size += int64(n)
+	if err != nil {
+		return n, errors.E(op, err)
+	}
+	return n, nil
+}
+
+// SetMetadata records a metadata change at the current position in the
+// recording, metadata equal to the previous metadata or set after Close is
+// ignored
+func (w *Writer) SetMetadata(value string) error {
+	const op errors.Op = "recording/Writer.SetMetadata"
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	if n := len(w.rec.Metadata); n > 0 && w.rec.Metadata[n-1].Value == value {
+		return nil
+	}
+
+	w.rec.Metadata = append(w.rec.Metadata, radio.RecordingMetadata{
+		Offset: time.Since(w.rec.Start),
+		Value:  value,
+	})
+
+	err := w.store.writeSidecar(w.rec)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// Close stops the recording
+func (w *Writer) Close() error {
+	const op errors.Op = "recording/Writer.Close"
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	w.rec.End = time.Now()
+
+	err := w.file.Close()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	err = w.store.writeSidecar(w.rec)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// extension returns the file extension used for audio of the content type
+func extension(contentType string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "audio/mpeg", "audio/mp3":
+		return ".mp3"
+	case "audio/ogg", "application/ogg", "audio/vorbis":
+		return ".ogg"
+	case "audio/opus":
+		return ".opus"
+	case "audio/aac", "audio/aacp", "audio/x-aac":
+		return ".aac"
+	}
+	return ".bin"
+}
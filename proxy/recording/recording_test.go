@@ -0,0 +1,100 @@
+package recording
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	store := NewStore(fs, "/recordings")
+
+	recs, err := store.List()
+	require.NoError(t, err)
+	assert.Empty(t, recs, "missing directory should have no recordings")
+
+	user := radio.User{ID: 5, Username: "dj", Password: "secret"}
+	w, err := store.Create(user, "/main.mp3", "audio/mpeg")
+	require.NoError(t, err)
+
+	// an unfinished recording should show up already
+	recs, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	assert.Equal(t, w.ID(), recs[0].ID)
+
+	_, err = w.Write([]byte("some "))
+	require.NoError(t, err)
+	require.NoError(t, w.SetMetadata("first song"))
+	require.NoError(t, w.SetMetadata("first song"))
+	_, err = w.Write([]byte("audio"))
+	require.NoError(t, err)
+	require.NoError(t, w.SetMetadata("second song"))
+	require.NoError(t, w.Close())
+	require.NoError(t, w.SetMetadata("too late"))
+
+	_, err = w.Write([]byte("more"))
+	assert.Error(t, err, "writing after close should fail")
+
+	f, rec, err := store.Open(w.ID())
+	require.NoError(t, err)
+	data, err := io.ReadAll(f)
+	f.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "some audio", string(data))
+
+	assert.Equal(t, radio.UserID(5), rec.UserID)
+	assert.Equal(t, "dj", rec.Username)
+	assert.Equal(t, "/main.mp3", rec.MountName)
+	assert.Equal(t, w.ID().String()+".mp3", rec.Filename)
+	assert.EqualValues(t, len(data), rec.Size)
+	assert.False(t, rec.End.Before(rec.Start))
+	if assert.Len(t, rec.Metadata, 2) {
+		assert.Equal(t, "first song", rec.Metadata[0].Value)
+		assert.Equal(t, "second song", rec.Metadata[1].Value)
+		assert.LessOrEqual(t, rec.Metadata[0].Offset, rec.Metadata[1].Offset)
+	}
+
+	// the sidecar shouldn't contain anything of the user but their name and id
+	sidecar, err := afero.ReadFile(fs, "/recordings/"+w.ID().String()+sidecarExt)
+	require.NoError(t, err)
+	assert.NotContains(t, string(sidecar), "secret")
+
+	_, err = store.Get(radio.NewRecordingID())
+	assert.Error(t, err)
+}
+
+func TestStoreClean(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	store := NewStore(fs, "/recordings")
+
+	old, err := store.Create(radio.User{ID: 1}, "/main.mp3", "audio/ogg")
+	require.NoError(t, err)
+	require.NoError(t, old.Close())
+
+	cutoff := time.Now()
+	time.Sleep(time.Millisecond * 10)
+
+	recent, err := store.Create(radio.User{ID: 1}, "/main.mp3", "audio/ogg")
+	require.NoError(t, err)
+	require.NoError(t, recent.Close())
+
+	removed, err := store.Clean(cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	recs, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	assert.Equal(t, recent.ID(), recs[0].ID)
+
+	exists, err := afero.Exists(fs, "/recordings/"+old.ID().String()+".ogg")
+	require.NoError(t, err)
+	assert.False(t, exists, "audio file should be removed")
+}
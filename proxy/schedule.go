@@ -0,0 +1,66 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	unscheduledAllow  = "allow"
+	unscheduledWarn   = "warn"
+	unscheduledReject = "reject"
+)
+
+// checkSchedule checks if the user given is allowed to stream to the primary mount
+// right now, a DJ is unscheduled if someone else owns the active schedule slot. It
+// returns an error of kind AccessDenied if the user should be rejected
+func (s *Server) checkSchedule(ctx context.Context, user *radio.User) error {
+	const op errors.Op = "proxy/Server.checkSchedule"
+
+	cfg := s.cfg.Conf()
+	mode := cfg.Proxy.UnscheduledDJ
+	if mode == "" || mode == unscheduledAllow || cfg.Manager.Handover.IsRobot(user) {
+		return nil
+	}
+
+	schedule, err := s.storage.Schedule(ctx).Latest()
+	if err != nil {
+		// we don't want to keep people off the stream because the schedule
+		// is unavailable, so let them through
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to load schedule")
+		return nil
+	}
+
+	entry := radio.ActiveScheduleEntry(schedule, time.Now())
+	if entry == nil || entry.Owner == nil || entry.IsOwner(user) {
+		return nil
+	}
+
+	if mode == unscheduledReject {
+		return errors.E(op, errors.AccessDenied, errors.Info("another DJ is scheduled"))
+	}
+
+	zerolog.Ctx(ctx).Warn().
+		Str("username", user.Username).
+		Str("scheduled", entry.Owner.Username).
+		Msg("unscheduled dj connected")
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*10)
+		defer cancel()
+
+		err := s.cfg.IRC.AnnounceStaff(ctx, fmt.Sprintf(
+			"%s connected while %s is scheduled to stream",
+			user.Username, entry.Owner.Username,
+		))
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to warn about unscheduled dj")
+		}
+	}()
+	return nil
+}
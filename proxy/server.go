@@ -11,12 +11,15 @@ import (
 	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/proxy/compat"
+	"github.com/R-a-dio/valkyrie/rpc"
 	"github.com/R-a-dio/valkyrie/website"
 	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/cenkalti/backoff"
 	"github.com/go-chi/chi/v5"
 	chiware "github.com/go-chi/chi/v5/middleware"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/hlog"
+	"google.golang.org/grpc"
 )
 
 type Server struct {
@@ -24,8 +27,9 @@ type Server struct {
 	listenerMu sync.Mutex
 	listener   net.Listener
 	proxy      *ProxyManager
-	storage    radio.UserStorageService
+	storage    radio.StorageService
 	manager    radio.ManagerService
+	recorder   ListenerRecorder
 	http       *http.Server
 }
 
@@ -37,7 +41,7 @@ func zerologLoggerFunc(r *http.Request, status, size int, duration time.Duration
 		Msg("http request")
 }
 
-func NewServer(ctx context.Context, cfg config.Config, manager radio.ManagerService, storage radio.UserStorageService) (*Server, error) {
+func NewServer(ctx context.Context, cfg config.Config, manager radio.ManagerService, storage radio.StorageService) (*Server, error) {
 	const op errors.Op = "proxy.NewServer"
 
 	eh := NewEventHandler(ctx, cfg)
@@ -46,10 +50,11 @@ func NewServer(ctx context.Context, cfg config.Config, manager radio.ManagerServ
 		return nil, errors.E(op, err)
 	}
 	var srv = &Server{
-		cfg:     cfg,
-		proxy:   pm,
-		manager: manager,
-		storage: storage,
+		cfg:      cfg,
+		proxy:    pm,
+		manager:  manager,
+		storage:  storage,
+		recorder: NewTrackerRecorder(ctx, cfg),
 	}
 
 	// older icecast source clients still use the SOURCE method instead of PUT
@@ -71,18 +76,22 @@ func NewServer(ctx context.Context, cfg config.Config, manager radio.ManagerServ
 		hlog.AccessHandler(zerologLoggerFunc),
 	)
 	r.Use(chiware.Recoverer)
-	// handle basic authentication
-	r.Use(middleware.BasicAuth(storage))
-	// and generate an identifier for the user
-	r.Use(IdentifierMiddleware)
-	// metadata route used to update mp3 metadata out-of-bound
-	r.Get("/admin/metadata", middleware.RequirePermission(radio.PermDJ, srv.GetMetadata))
-	// listclients would normally listen all listener clients but since we
-	// don't have any of those we could normally just not implement this. But
-	// some streaming software assumes this endpoint exists to display a listener
-	// count, so we just return a static 0;
-	r.Get("/admin/listclients", srv.GetListClients)
-	r.HandleFunc("/*", middleware.RequirePermission(radio.PermDJ, srv.PutSource))
+	r.Group(func(r chi.Router) {
+		// handle basic authentication
+		r.Use(SourceAuth(cfg, storage))
+		// and generate an identifier for the user
+		r.Use(IdentifierMiddleware)
+		// metadata route used to update mp3 metadata out-of-bound
+		r.Get("/admin/metadata", middleware.RequirePermission(radio.PermDJ, srv.GetMetadata))
+		// listclients would normally list all listener clients but we only
+		// return a count. Some streaming software assumes this endpoint exists
+		// to display a listener count
+		r.Get("/admin/listclients", srv.GetListClients)
+		r.HandleFunc("/*", middleware.RequirePermission(radio.PermDJ, srv.PutSource))
+	})
+	// listeners don't authenticate, this has to be registered after the source
+	// route above since that one would otherwise also take GET requests
+	r.Get("/*", srv.GetListener)
 
 	srv.http = &http.Server{
 		Handler:      r,
@@ -116,3 +125,36 @@ func (s *Server) Start(ctx context.Context) error {
 	logger.Info().Str("address", ln.Addr().String()).Msg("proxy started listening")
 	return s.http.Serve(ln)
 }
+
+func NewGRPCServer(ctx context.Context, ps radio.ProxyService) *grpc.Server {
+	gs := rpc.NewGrpcServer(ctx)
+	rpc.RegisterProxyServer(gs, rpc.NewProxy(ps))
+	return gs
+}
+
+// StartRPC starts the RPC server used by the admin panel to control the sources
+// of the proxy
+func (s *Server) StartRPC(ctx context.Context) error {
+	logger := zerolog.Ctx(ctx)
+
+	// after a graceful restart the old process might still be holding onto the
+	// address for a little while, so retry a few times
+	var ln net.Listener
+	err := backoff.Retry(func() error {
+		var err error
+		ln, err = net.Listen("tcp", s.cfg.Conf().Proxy.RPCAddr)
+		return err
+	}, config.NewConnectionBackoff(ctx))
+	if err != nil {
+		return err
+	}
+
+	gs := NewGRPCServer(ctx, s.proxy)
+	go func() {
+		<-ctx.Done()
+		gs.Stop()
+	}()
+
+	logger.Info().Str("address", ln.Addr().String()).Msg("proxy rpc started listening")
+	return gs.Serve(ln)
+}
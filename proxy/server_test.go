@@ -75,6 +75,13 @@ func TestServer(t *testing.T) {
 				},
 			}
 		},
+		ScheduleFunc: func(contextMoqParam context.Context) radio.ScheduleStorage {
+			return &mocks.ScheduleStorageMock{
+				LatestFunc: func() ([]*radio.ScheduleEntry, error) {
+					return make([]*radio.ScheduleEntry, 7), nil
+				},
+			}
+		},
 	}
 	cfg.Manager = manager
 
@@ -15,7 +15,6 @@ import (
 	"github.com/R-a-dio/valkyrie/storage"
 	"github.com/R-a-dio/valkyrie/util/graceful"
 	"github.com/R-a-dio/valkyrie/website/middleware"
-	"github.com/rs/xid"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/hlog"
 )
@@ -42,6 +41,17 @@ func (s *Server) PutSource(w http.ResponseWriter, r *http.Request) {
 
 	mountName := GetMountpoint(r)
 
+	// check if the user is allowed to stream right now if they're trying to
+	// stream to the primary mount
+	if mountName == s.cfg.Conf().Proxy.PrimaryMountName {
+		err := s.checkSchedule(ctx, user)
+		if err != nil {
+			hlog.FromRequest(r).Info().Err(err).Msg("rejected unscheduled source")
+			http.Error(w, "another DJ is scheduled to stream right now", http.StatusForbidden)
+			return
+		}
+	}
+
 	// get ready to hijack and proceed with data handling
 	rc := http.NewResponseController(w)
 
@@ -113,14 +123,12 @@ func (s *Server) PutSource(w http.ResponseWriter, r *http.Request) {
 
 func NewSourceID(r *http.Request) SourceID {
 	if id, ok := hlog.IDFromRequest(r); ok {
-		return SourceID{id}
+		return SourceID{ID: id}
 	}
 	panic("NewSourceID called without hlog.RequestIDHandler middleware")
 }
 
-type SourceID struct {
-	xid.ID
-}
+type SourceID = radio.SourceID
 
 func NewSourceClient(id SourceID, ua, ct, mount string, conn net.Conn, user radio.User, identifier Identifier, metadata *Metadata) *SourceClient {
 	meta := new(atomic.Pointer[Metadata])
@@ -137,6 +145,8 @@ func NewSourceClient(id SourceID, ua, ct, mount string, conn net.Conn, user radi
 		Identifier:  identifier,
 		conn:        conn,
 		Metadata:    meta,
+		Start:       time.Now(),
+		received:    new(atomic.Uint64),
 	}
 }
 
@@ -158,6 +168,28 @@ type SourceClient struct {
 	Identifier Identifier
 	// Metadata is a pointer to the last Metadata received for this client
 	Metadata *atomic.Pointer[Metadata]
+	// Start is when the client connected
+	Start time.Time
+	// received is the amount of bytes read from the client
+	received *atomic.Uint64
+}
+
+// ProxySource returns the client as a radio.ProxySource, the fields that depend
+// on the mount are left empty
+func (sc *SourceClient) ProxySource() radio.ProxySource {
+	source := radio.ProxySource{
+		ID:            sc.ID,
+		MountName:     sc.MountName,
+		User:          sc.User,
+		UserAgent:     sc.UserAgent,
+		ContentType:   sc.ContentType,
+		Start:         sc.Start,
+		BytesReceived: sc.received.Load(),
+	}
+	if meta := sc.Metadata.Load(); meta != nil {
+		source.Metadata = meta.Value
+	}
+	return source
 }
 
 type wireSource struct {
@@ -168,6 +200,8 @@ type wireSource struct {
 	Username    string
 	Identifier  Identifier
 	Metadata    *Metadata
+	Start       time.Time
+	Received    uint64
 }
 
 func (sc *SourceClient) writeSelf(dst *net.UnixConn) error {
@@ -179,6 +213,8 @@ func (sc *SourceClient) writeSelf(dst *net.UnixConn) error {
 		Username:    sc.User.Username,
 		Identifier:  sc.Identifier,
 		Metadata:    sc.Metadata.Load(),
+		Start:       sc.Start,
+		Received:    sc.received.Load(),
 	}
 
 	fd, err := getFile(sc.conn)
@@ -229,6 +265,9 @@ func (sc *SourceClient) readSelf(ctx context.Context, cfg config.Config, src *ne
 		ws.Identifier,
 		ws.Metadata,
 	)
+	// keep the connection statistics from before the restart
+	new.Start = ws.Start
+	new.received.Store(ws.Received)
 	*sc = *new
 	return nil
 }
@@ -0,0 +1,267 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/streamer/icecast"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/cenkalti/backoff"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// upstreamBuffer is the amount of bytes that can be queued for an upstream
+	// before data is dropped, this is a few seconds of audio
+	upstreamBuffer = 256 * 1024
+	// upstreamWriteTimeout is how long a single write to an upstream can take
+	// before we give up on the connection
+	upstreamWriteTimeout = time.Second * 10
+)
+
+// upstreamURLs returns the urls of all master servers configured with the path
+// set to the mount given
+func upstreamURLs(cfg config.Config, mountName string) []*url.URL {
+	conf := cfg.Conf().Proxy
+
+	var servers []config.URL
+	if conf.MasterServer != "" {
+		servers = append(servers, conf.MasterServer)
+	}
+	servers = append(servers, conf.MasterServers...)
+
+	urls := make([]*url.URL, 0, len(servers))
+	for _, server := range servers {
+		if server == "" {
+			continue
+		}
+		uri := server.URL()
+		uri.Path = mountName
+		urls = append(urls, uri)
+	}
+	return urls
+}
+
+// upstreamKey returns the url given without credentials, this identifies an
+// upstream between restarts and is safe to show to users
+func upstreamKey(uri *url.URL) string {
+	key := *uri
+	key.User = nil
+	return key.String()
+}
+
+// upstream is a master server that a mount sends its live source to, every
+// upstream has its own connection and queue so that a slow or unreachable
+// upstream doesn't hold up the others
+type upstream struct {
+	logger      zerolog.Logger
+	url         *url.URL
+	key         string
+	contentType string
+	// headers returns the stream headers that have to be send on a new
+	// connection before any other data
+	headers func() []byte
+
+	conn      *util.TypedValue[net.Conn]
+	connected atomic.Bool
+	data      chan []byte
+	// queued is the amount of bytes in data
+	queued  atomic.Int64
+	sent    atomic.Uint64
+	dropped atomic.Uint64
+
+	// mu protects the fields below
+	mu sync.Mutex
+	// since is when the upstream last connected or disconnected
+	since time.Time
+	// lastError is the last error that occurred
+	lastError string
+}
+
+func newUpstream(logger zerolog.Logger, uri *url.URL, contentType string, headers func() []byte, conn net.Conn) *upstream {
+	key := upstreamKey(uri)
+	up := &upstream{
+		logger:      logger.With().Str("upstream", key).Logger(),
+		url:         uri,
+		key:         key,
+		contentType: contentType,
+		headers:     headers,
+		conn:        util.NewTypedValue(conn),
+		data:        make(chan []byte, 256),
+	}
+	if conn != nil {
+		// a resumed connection is ready to be written to
+		up.connected.Store(true)
+		up.since = time.Now()
+	}
+	return up
+}
+
+// Write queues p to be send to the upstream, data is dropped if the upstream
+// isn't connected or can't keep up
+func (up *upstream) Write(p []byte) {
+	if !up.connected.Load() || up.queued.Load()+int64(len(p)) > upstreamBuffer {
+		up.dropped.Add(uint64(len(p)))
+		return
+	}
+
+	up.queued.Add(int64(len(p)))
+	select {
+	case up.data <- bytes.Clone(p):
+	default:
+		up.queued.Add(-int64(len(p)))
+		up.dropped.Add(uint64(len(p)))
+	}
+}
+
+// run connects to the upstream and sends it the queued data, it reconnects
+// with a backoff if the connection fails and returns once ctx is canceled
+func (up *upstream) run(ctx context.Context) {
+	bo := config.NewConnectionBackoff(ctx)
+
+	for {
+		conn := up.conn.Load()
+		if conn == nil {
+			conn = up.dial(ctx, bo)
+			if conn == nil {
+				// canceled
+				return
+			}
+			up.conn.Store(conn)
+		}
+		up.setState(true, nil)
+
+		err := up.stream(ctx, conn)
+
+		// this stops new data from being queued
+		up.setState(false, err)
+		if conn = up.conn.Swap(nil); conn != nil {
+			conn.Close()
+		}
+		up.drain()
+		if ctx.Err() != nil {
+			return
+		}
+
+		up.logger.Error().Err(err).Msg("failed to write to upstream")
+	}
+}
+
+// dial connects to the upstream, it only returns nil if ctx was canceled
+func (up *upstream) dial(ctx context.Context, bo config.BackOff) net.Conn {
+	for {
+		var conn net.Conn
+		err := backoff.Retry(func() error {
+			var err error
+
+			up.logger.Info().Msg("dialing icecast")
+			conn, err = icecast.DialURL(ctx, up.url, icecast.ContentType(up.contentType))
+			if err != nil {
+				up.logger.Error().Err(err).Msg("failed connecting to upstream")
+				up.setState(false, err)
+				return err
+			}
+			return nil
+		}, bo)
+		if err == nil {
+			bo.Reset()
+			return conn
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		// we ran out of retries, but the upstream might come back later so
+		// just start over
+		bo.Reset()
+	}
+}
+
+// stream writes the queued data to conn until an error occurs or ctx is
+// canceled, it returns nil in the latter case
+func (up *upstream) stream(ctx context.Context, conn net.Conn) error {
+	write := func(p []byte) error {
+		_ = conn.SetWriteDeadline(time.Now().Add(upstreamWriteTimeout))
+		n, err := conn.Write(p)
+		up.sent.Add(uint64(n))
+		return err
+	}
+
+	// a new connection needs the stream headers before anything else, this is
+	// only the case for Ogg streams
+	if headers := up.headers(); len(headers) > 0 {
+		if err := write(headers); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case chunk := <-up.data:
+			up.queued.Add(-int64(len(chunk)))
+			if err := write(chunk); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// drain throws away the queued data, this is done after a disconnect since
+// the data would be outdated by the time we reconnect
+func (up *upstream) drain() {
+	for {
+		select {
+		case chunk := <-up.data:
+			up.queued.Add(-int64(len(chunk)))
+			up.dropped.Add(uint64(len(chunk)))
+		default:
+			return
+		}
+	}
+}
+
+func (up *upstream) setState(connected bool, err error) {
+	up.mu.Lock()
+	defer up.mu.Unlock()
+
+	if up.connected.Load() != connected {
+		up.since = time.Now()
+	}
+	if err != nil {
+		up.lastError = err.Error()
+	}
+	up.connected.Store(connected)
+}
+
+// Close closes the connection to the upstream if there is one
+func (up *upstream) Close() error {
+	up.connected.Store(false)
+	if conn := up.conn.Swap(nil); conn != nil {
+		return conn.Close()
+	}
+	return nil
+}
+
+// ProxyUpstream returns the state of the upstream
+func (up *upstream) ProxyUpstream(mountName string) radio.ProxyUpstream {
+	up.mu.Lock()
+	defer up.mu.Unlock()
+
+	return radio.ProxyUpstream{
+		MountName:    mountName,
+		URL:          up.key,
+		Connected:    up.connected.Load(),
+		Since:        up.since,
+		Error:        up.lastError,
+		BytesSent:    up.sent.Load(),
+		BytesDropped: up.dropped.Load(),
+	}
+}
@@ -3,11 +3,15 @@ package radio
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"crypto/sha1"
+	"crypto/sha256"
 	"database/sql/driver"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -24,21 +28,73 @@ const (
 	LimitReasonLength = 120
 )
 
-// CalculateRequestDelay returns the delay between two requests of a song
-func CalculateRequestDelay(requestCount int) time.Duration {
-	if requestCount > 30 {
-		requestCount = 30
+// DefaultRequestCurve is the request curve used when none is configured
+var DefaultRequestCurve = RequestCurve{
+	Knee:        7,
+	MaxCount:    30,
+	Quadratic:   [3]float64{-11057, 172954, 81720},
+	Exponential: [3]float64{599955, 0.0372, 0.5},
+	Scale:       0.5,
+}
+
+// RequestCurve is the curve used to calculate the delay between two requests
+// of a song from the amount of times it has been requested
+type RequestCurve struct {
+	// Knee is the request count after which the curve switches from the
+	// quadratic to the exponential part
+	Knee int
+	// MaxCount is the request count after which the delay stops growing
+	MaxCount int
+	// Quadratic is a, b and c of a*n^2 + b*n + c in seconds
+	Quadratic [3]float64
+	// Exponential is a, b and c of a*e^(b*n) + c in seconds
+	Exponential [3]float64
+	// Scale is multiplied with the result of the curve
+	Scale float64
+}
+
+// Delay returns the delay between two requests of a song that has been
+// requested requestCount times
+func (c RequestCurve) Delay(requestCount int) time.Duration {
+	if requestCount > c.MaxCount {
+		requestCount = c.MaxCount
 	}
 
+	n := float64(requestCount)
 	var dur float64
-	if requestCount >= 0 && requestCount <= 7 {
-		dur = -11057*math.Pow(float64(requestCount), 2) +
-			172954*float64(requestCount) + 81720
+	if requestCount >= 0 && requestCount <= c.Knee {
+		q := c.Quadratic
+		dur = q[0]*math.Pow(n, 2) + q[1]*n + q[2]
 	} else {
-		dur = 599955*math.Exp(0.0372*float64(requestCount)) + 0.5
+		e := c.Exponential
+		dur = e[0]*math.Exp(e[1]*n) + e[2]
 	}
 
-	return time.Duration(time.Duration(dur/2) * time.Second)
+	return time.Duration(time.Duration(dur*c.Scale) * time.Second)
+}
+
+// Cooldown returns the time until the song given can be requested again and
+// true if it can be requested right now
+func (c RequestCurve) Cooldown(s *Song) (time.Duration, bool) {
+	if s == nil || s.DatabaseTrack == nil {
+		return veryFarAway, false
+	}
+	delay := c.Delay(s.RequestCount)
+	if delay <= 0 {
+		// unknown song delay
+		return veryFarAway, false
+	}
+
+	furthest := s.LastPlayed
+	if s.LastRequested.After(furthest) {
+		furthest = s.LastRequested
+	}
+
+	until := time.Until(furthest.Add(delay))
+	if until <= 0 {
+		return 0, true
+	}
+	return until, false
 }
 
 // CalculateCooldown sees if the cooldown given has passed since `last` and returns
@@ -262,6 +318,73 @@ func GenerateHashFromPassword(passwd string) (string, error) {
 	return string(h), nil
 }
 
+// StreamKeyPrefix is the prefix of every stream key, this lets the proxy tell
+// stream keys and passwords apart
+const StreamKeyPrefix = "sk_"
+
+// StreamKeyID is the identifier of a stream key
+type StreamKeyID uint64
+
+func ParseStreamKeyID(s string) (StreamKeyID, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return StreamKeyID(id), nil
+}
+
+func (id StreamKeyID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// StreamKey is a key that can be used by a DJ to stream to the proxy instead of
+// their password
+type StreamKey struct {
+	ID     StreamKeyID
+	UserID UserID
+	// Name is a description of the key, such as the software it's used in
+	Name string
+	// Hash is the hex encoded SHA-256 hash of the key, the key itself is never
+	// stored
+	Hash string
+	// Mount is the only mount the key can stream to, empty means any mount
+	Mount string
+	// ExpiresAt is when the key stops working, nil means it never expires
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+}
+
+// GenerateStreamKey returns a new random stream key and its hash
+func GenerateStreamKey() (key string, hash string, err error) {
+	var b [32]byte
+	if _, err = rand.Read(b[:]); err != nil {
+		return "", "", err
+	}
+	key = StreamKeyPrefix + base64.RawURLEncoding.EncodeToString(b[:])
+	return key, HashStreamKey(key), nil
+}
+
+// HashStreamKey returns the hash of the stream key given
+func HashStreamKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
+
+// IsStreamKey returns true if s looks like a stream key
+func IsStreamKey(s string) bool {
+	return strings.HasPrefix(s, StreamKeyPrefix)
+}
+
+// Expired returns true if the key is expired at the time given
+func (sk StreamKey) Expired(now time.Time) bool {
+	return sk.ExpiresAt != nil && !now.Before(*sk.ExpiresAt)
+}
+
+// AllowsMount returns true if the key can be used to stream to the mount given
+func (sk StreamKey) AllowsMount(mount string) bool {
+	return sk.Mount == "" || sk.Mount == mount
+}
+
 // DJID is an identifier corresponding to a dj
 type DJID uint64
 
@@ -345,6 +468,157 @@ type Thread = string
 // Listeners is a dedicated type for an amount of listeners
 type Listeners = int64
 
+// ListenerSample is the amount of listeners at a point during a play
+type ListenerSample struct {
+	// Offset is the time since the start of the play
+	Offset    time.Duration
+	Listeners Listeners
+}
+
+// ListenerCurve is the listener count sampled during a play, samples are only
+// added when the count changes so it stays compact
+type ListenerCurve []ListenerSample
+
+// Add appends a sample to the curve if it differs from the last sample and
+// returns the new curve
+func (lc ListenerCurve) Add(offset time.Duration, listeners Listeners) ListenerCurve {
+	if len(lc) > 0 && lc[len(lc)-1].Listeners == listeners {
+		return lc
+	}
+	return append(lc, ListenerSample{Offset: offset, Listeners: listeners})
+}
+
+// Start returns the listener count at the start of the curve
+func (lc ListenerCurve) Start() Listeners {
+	if len(lc) == 0 {
+		return 0
+	}
+	return lc[0].Listeners
+}
+
+// End returns the listener count at the end of the curve
+func (lc ListenerCurve) End() Listeners {
+	if len(lc) == 0 {
+		return 0
+	}
+	return lc[len(lc)-1].Listeners
+}
+
+// Lost returns the amount of listeners lost during the curve, this is the sum
+// of every drop between samples so listeners joining don't hide those leaving
+func (lc ListenerCurve) Lost() Listeners {
+	var lost Listeners
+	for i := 1; i < len(lc); i++ {
+		if d := lc[i-1].Listeners - lc[i].Listeners; d > 0 {
+			lost += d
+		}
+	}
+	return lost
+}
+
+// String returns the curve as comma separated "seconds:listeners" pairs
+func (lc ListenerCurve) String() string {
+	var b strings.Builder
+	for i, sample := range lc {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatInt(int64(sample.Offset/time.Second), 10))
+		b.WriteByte(':')
+		b.WriteString(strconv.FormatInt(sample.Listeners, 10))
+	}
+	return b.String()
+}
+
+// ParseListenerCurve reverts ListenerCurve.String
+func ParseListenerCurve(s string) (ListenerCurve, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var lc ListenerCurve
+	for _, pair := range strings.Split(s, ",") {
+		rawOffset, rawListeners, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid listener sample: %q", pair)
+		}
+		offset, err := strconv.ParseInt(rawOffset, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		listeners, err := strconv.ParseInt(rawListeners, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		lc = append(lc, ListenerSample{
+			Offset:    time.Duration(offset) * time.Second,
+			Listeners: listeners,
+		})
+	}
+	return lc, nil
+}
+
+// Value implements sql/driver.Valuer
+func (lc ListenerCurve) Value() (driver.Value, error) {
+	if len(lc) == 0 {
+		return nil, nil
+	}
+	return lc.String(), nil
+}
+
+// Scan implements sql.Scanner
+func (lc *ListenerCurve) Scan(src any) error {
+	var err error
+	switch v := src.(type) {
+	case nil:
+		*lc = nil
+	case []byte:
+		*lc, err = ParseListenerCurve(string(v))
+	case string:
+		*lc, err = ParseListenerCurve(v)
+	default:
+		err = fmt.Errorf("unsupported type in ListenerCurve.Scan: %T", src)
+	}
+	return err
+}
+
+// PlayCurve is the listener curve of a single play
+type PlayCurve struct {
+	PlayedAt time.Time
+	DJ       DJ
+	Curve    ListenerCurve
+}
+
+// ListenerRetention is how well listeners were kept during plays
+type ListenerRetention struct {
+	// Plays is the amount of plays with a listener curve
+	Plays int64
+	// Start is the average listener count at the start of a play
+	Start float64
+	// Lost is the average amount of listeners lost during a play
+	Lost float64
+}
+
+// Rate returns the fraction of starting listeners that stayed during a play
+func (lr ListenerRetention) Rate() float64 {
+	if lr.Start <= 0 {
+		return 1
+	}
+	return max(0, 1-lr.Lost/lr.Start)
+}
+
+// SongRetention is the ListenerRetention of a single song
+type SongRetention struct {
+	Song Song
+	ListenerRetention
+}
+
+// DJRetention is the ListenerRetention of the songs played by a single DJ
+type DJRetention struct {
+	DJ DJ
+	ListenerRetention
+}
+
 // ListenerClientID is an identifier unique to each listener
 type ListenerClientID uint64
 
@@ -376,6 +650,175 @@ type ListenerTrackerService interface {
 	RemoveClient(context.Context, ListenerClientID) error
 }
 
+// SourceID is the identifier of a source client connected to the proxy
+type SourceID struct {
+	xid.ID
+}
+
+// ParseSourceID parses the string given as a SourceID
+func ParseSourceID(s string) (SourceID, error) {
+	id, err := xid.FromString(s)
+	return SourceID{ID: id}, err
+}
+
+// ProxySource is a source client connected to the proxy
+type ProxySource struct {
+	ID SourceID
+	// MountName is the mount the source is streaming to
+	MountName string
+	// User is the user that is streaming
+	User        User
+	UserAgent   string
+	ContentType string
+	// Priority is the position of the source when picking the next live source,
+	// lower goes first
+	Priority uint
+	// Live indicates if this source is the one being broadcast
+	Live bool
+	// Metadata is the last metadata received for this source
+	Metadata string
+	// Start is when the source connected
+	Start time.Time
+	// BytesReceived is the amount of audio data received from the source
+	BytesReceived uint64
+}
+
+// ProxyUpstream is an upstream server that a mount of the proxy sends its live
+// source to
+type ProxyUpstream struct {
+	// MountName is the mount this upstream belongs to
+	MountName string
+	// URL is the address of the upstream without credentials
+	URL string
+	// Connected indicates if the upstream is currently connected
+	Connected bool
+	// Since is when the upstream last connected or disconnected
+	Since time.Time
+	// Error is the last error that occurred, empty if there was none
+	Error string
+	// BytesSent is the amount of audio data send to the upstream
+	BytesSent uint64
+	// BytesDropped is the amount of audio data thrown away because the
+	// upstream was disconnected or couldn't keep up
+	BytesDropped uint64
+}
+
+type ProxyService interface {
+	// ListSources returns the sources connected to every mount
+	ListSources(context.Context) ([]ProxySource, error)
+	// ListUpstreams returns the upstream servers of every mount
+	ListUpstreams(context.Context) ([]ProxyUpstream, error)
+	// KickSource disconnects the source given
+	KickSource(context.Context, SourceID) error
+	// SetLive makes the source given the live source of its mount and gives it
+	// the highest priority
+	SetLive(context.Context, SourceID) error
+	// SetPriorities reorders the sources of the mount given, the first source
+	// in order gets the highest priority and goes live. Sources not in order
+	// keep their relative order after the ones given
+	SetPriorities(ctx context.Context, mount string, order []SourceID) error
+	// Events returns a stream of the changes to the sources of the proxy
+	Events(context.Context) (eventstream.Stream[ProxyEvent], error)
+}
+
+// RecordingID is the identifier of a recording of a live source
+type RecordingID struct {
+	xid.ID
+}
+
+// NewRecordingID returns a new unique RecordingID
+func NewRecordingID() RecordingID {
+	return RecordingID{xid.New()}
+}
+
+// ParseRecordingID parses the string given as a RecordingID
+func ParseRecordingID(s string) (RecordingID, error) {
+	id, err := xid.FromString(s)
+	return RecordingID{ID: id}, err
+}
+
+// Recording is a recording of a single live session on the primary mount of
+// the proxy
+type Recording struct {
+	ID RecordingID
+	// UserID and Username are of the user that was live
+	UserID   UserID
+	Username string
+	// MountName is the mount that was recorded
+	MountName   string
+	ContentType string
+	// Filename is the name of the audio file of the recording
+	Filename string
+	// Start is when the recording started
+	Start time.Time
+	// End is when the recording stopped
+	End time.Time
+	// Size is the size of the audio file in bytes
+	Size int64
+	// Metadata is every metadata change during the recording
+	Metadata []RecordingMetadata
+}
+
+// Duration returns the length of the recording
+func (r Recording) Duration() time.Duration {
+	return r.End.Sub(r.Start)
+}
+
+// RecordingMetadata is a metadata change in a recording
+type RecordingMetadata struct {
+	// Offset is the time since the start of the recording
+	Offset time.Duration
+	// Value is the new metadata
+	Value string
+}
+
+// ProxyEventKind is the kind of change a ProxyEvent describes
+type ProxyEventKind int
+
+const (
+	// ProxyEventInit is the event used before any changes have happened
+	ProxyEventInit ProxyEventKind = iota
+	// ProxyEventConnect is used when a source connected
+	ProxyEventConnect
+	// ProxyEventDisconnect is used when a source disconnected
+	ProxyEventDisconnect
+	// ProxyEventLive is used when a mount switched to another live source, or
+	// to no source at all
+	ProxyEventLive
+	// ProxyEventMetadata is used when metadata was send to a mount
+	ProxyEventMetadata
+)
+
+func (k ProxyEventKind) String() string {
+	switch k {
+	case ProxyEventInit:
+		return "init"
+	case ProxyEventConnect:
+		return "connect"
+	case ProxyEventDisconnect:
+		return "disconnect"
+	case ProxyEventLive:
+		return "live"
+	case ProxyEventMetadata:
+		return "metadata"
+	}
+	return fmt.Sprintf("ProxyEventKind(%d)", int(k))
+}
+
+// ProxyEvent is a change to the sources of the proxy
+type ProxyEvent struct {
+	Kind ProxyEventKind
+	// MountName is the mount the change happened on
+	MountName string
+	// Source is the source that was changed, this is empty for events that
+	// don't involve a single source
+	Source ProxySource
+	// Metadata is the metadata send for ProxyEventMetadata events
+	Metadata string
+	// Sources is every source connected after the change
+	Sources []ProxySource
+}
+
 type ManagerService interface {
 	CurrentUser(context.Context) (eventstream.Stream[*User], error)
 	UpdateUser(context.Context, *User) error
@@ -387,16 +830,93 @@ type ManagerService interface {
 	UpdateListeners(context.Context, Listeners) error
 
 	CurrentStatus(context.Context) (eventstream.Stream[Status], error)
+
+	// Events returns a stream of the stream handover transitions the manager
+	// goes through
+	Events(context.Context) (eventstream.Stream[ManagerEvent], error)
+}
+
+// ManagerEventKind is the kind of transition a ManagerEvent describes
+type ManagerEventKind int
+
+const (
+	// ManagerEventInit is the event used before any transitions have happened
+	ManagerEventInit ManagerEventKind = iota
+	// ManagerEventFallback is used when nobody is streaming and the fallback
+	// stream is playing
+	ManagerEventFallback
+	// ManagerEventStreamerStart is used when the automated streamer was started
+	ManagerEventStreamerStart
+	// ManagerEventStreamerRetry is used when starting the automated streamer
+	// failed and another attempt is scheduled
+	ManagerEventStreamerRetry
+	// ManagerEventStreamerStop is used when the automated streamer was stopped
+	// because a DJ took over
+	ManagerEventStreamerStop
+	// ManagerEventTakeover is used when a human DJ took over the stream
+	ManagerEventTakeover
+)
+
+func (k ManagerEventKind) String() string {
+	switch k {
+	case ManagerEventInit:
+		return "init"
+	case ManagerEventFallback:
+		return "fallback"
+	case ManagerEventStreamerStart:
+		return "streamer start"
+	case ManagerEventStreamerRetry:
+		return "streamer retry"
+	case ManagerEventStreamerStop:
+		return "streamer stop"
+	case ManagerEventTakeover:
+		return "takeover"
+	}
+	return fmt.Sprintf("ManagerEventKind(%d)", int(k))
+}
+
+// ManagerEvent is a stream handover transition made by the manager
+type ManagerEvent struct {
+	Kind ManagerEventKind
+	// User is the user involved in the transition, this is nil for events
+	// that don't involve a user
+	User *User
+	// Time is when the transition happened
+	Time time.Time
+	// Delay is how long until the next attempt for ManagerEventStreamerRetry
+	// or until the streamer is started for ManagerEventFallback
+	Delay time.Duration
 }
 
 type StreamerService interface {
 	Start(context.Context) error
 	Stop(ctx context.Context, force bool) error
+	// Skip skips the track that is currently playing
+	Skip(context.Context) error
 
-	RequestSong(context.Context, Song, string) error
+	RequestSong(context.Context, Song, Requester) error
 	Queue(context.Context) ([]QueueEntry, error)
 }
 
+// Requester is whoever is making a request
+type Requester struct {
+	// Identifier is a way to identify the requester, such as an IP or hostname
+	Identifier string
+	// Staff indicates the requester is part of staff, which can exempt them
+	// from some request limits
+	Staff bool
+}
+
+// NewRequester returns the Requester with the identifier given, user is the
+// user the requester is known as and can be nil. Users with the DJ permission
+// are staff
+func NewRequester(identifier string, user *User) Requester {
+	return Requester{
+		Identifier: identifier,
+		Staff:      user != nil && user.UserPermissions.Has(PermDJ),
+	}
+}
+
 func NewQueueID() QueueID {
 	return QueueID{xid.New()}
 }
@@ -453,13 +973,71 @@ type QueueService interface {
 	ResetReserved(context.Context) error
 	// Remove removes the first occurence of the given entry from the queue
 	Remove(context.Context, QueueID) (bool, error)
+	// Move moves the given entry to position in the queue, it returns false
+	// if the entry doesn't exist. Entries can't be moved in front of or from
+	// the reserved part of the queue
+	Move(ctx context.Context, id QueueID, position int) (bool, error)
+	// InsertAt inserts the song given at position in the queue, positions
+	// past the end of the queue append the song
+	InsertAt(ctx context.Context, song Song, position int) error
+	// Promote moves the given entry to be the next unreserved entry, it returns
+	// false if the entry doesn't exist
+	Promote(context.Context, QueueID) (bool, error)
 	// Entries returns all entries in the queue
 	Entries(context.Context) ([]QueueEntry, error)
+	// Events returns a stream of changes made to the queue, the first event
+	// received is the last change made before subscribing
+	Events(context.Context) (eventstream.Stream[QueueEvent], error)
+}
+
+// QueueEventKind is the kind of change a QueueEvent describes
+type QueueEventKind int
+
+const (
+	// QueueEventInit is the event used before any changes have happened
+	QueueEventInit QueueEventKind = iota
+	// QueueEventAdd is used when an entry was added to the queue
+	QueueEventAdd
+	// QueueEventRemove is used when an entry was removed from the queue
+	QueueEventRemove
+	// QueueEventReorder is used when an entry was moved in the queue
+	QueueEventReorder
+	// QueueEventReserve is used when an entry was reserved, or when the
+	// reserved entries were reset
+	QueueEventReserve
+)
+
+func (k QueueEventKind) String() string {
+	switch k {
+	case QueueEventInit:
+		return "init"
+	case QueueEventAdd:
+		return "add"
+	case QueueEventRemove:
+		return "remove"
+	case QueueEventReorder:
+		return "reorder"
+	case QueueEventReserve:
+		return "reserve"
+	}
+	return fmt.Sprintf("QueueEventKind(%d)", int(k))
+}
+
+// QueueEvent is a change made to the queue
+type QueueEvent struct {
+	Kind QueueEventKind
+	// Entry is the entry that was changed, this is empty for events that
+	// don't involve a single entry
+	Entry QueueEntry
+	// Queue is the complete queue after the change
+	Queue []QueueEntry
 }
 
 type AnnounceService interface {
 	AnnounceSong(context.Context, Status) error
 	AnnounceRequest(context.Context, Song) error
+	// AnnounceStaff sends a message meant for staff only
+	AnnounceStaff(context.Context, string) error
 }
 
 // SongID is a songs identifier
@@ -621,61 +1199,72 @@ type DatabaseTrack struct {
 	LastRequested time.Time
 
 	RequestCount int
-}
 
-// Requestable returns whether this song can be requested by a user
-func (s *Song) Requestable() bool {
-	if s == nil || s.DatabaseTrack == nil {
-		return false
+	// Loudness is the stored loudness analysis of the track, it is zero if
+	// the track hasn't been analyzed yet
+	Loudness Loudness
+	// CueIn is the position in the file where playback starts
+	CueIn time.Duration
+	// CueOut is the position in the file where playback ends, it is zero if
+	// no cue points are stored
+	CueOut time.Duration
+}
+
+// Playable returns the playable length of a file with the length given,
+// taking the cue points into account
+func (t DatabaseTrack) Playable(length time.Duration) time.Duration {
+	if t.CueOut > 0 && t.CueOut < length {
+		length = t.CueOut
 	}
-	delay := s.RequestDelay()
-	if delay == 0 {
-		// unknown song delay
-		return false
-	}
-	if time.Since(s.LastPlayed) < delay {
-		return false
-	}
-	if time.Since(s.LastRequested) < delay {
-		return false
+	if length < t.CueIn {
+		return 0
 	}
+	return length - t.CueIn
+}
 
-	return true
+// Loudness is the result of a loudness analysis of a track, as measured by the
+// analysis pass of the ffmpeg loudnorm filter
+type Loudness struct {
+	// Integrated is the integrated loudness in LUFS
+	Integrated float64
+	// Range is the loudness range in LU
+	Range float64
+	// TruePeak is the true peak in dBTP
+	TruePeak float64
+	// Threshold is the gating threshold in LUFS
+	Threshold float64
+	// Offset is the offset gain in LU to reach the normalization target
+	Offset float64
+}
+
+// IsZero returns true if no loudness analysis has been done
+func (l Loudness) IsZero() bool {
+	return l == Loudness{}
+}
+
+// Requestable returns whether this song can be requested by a user with the
+// request curve given
+func (s *Song) Requestable(curve RequestCurve) bool {
+	_, ok := curve.Cooldown(s)
+	return ok
 }
 
 var veryFarAway = time.Hour * 24 * 90
 
-func (s *Song) RequestDelay() time.Duration {
+// RequestDelay returns the delay between two requests of this song with the
+// request curve given
+func (s *Song) RequestDelay(curve RequestCurve) time.Duration {
 	if s == nil || s.DatabaseTrack == nil {
 		return 0
 	}
-	return CalculateRequestDelay(s.RequestCount)
+	return curve.Delay(s.RequestCount)
 }
 
-// UntilRequestable returns the time until this song can be requested again, returns 0
-// if song.Requestable() == true
-func (s *Song) UntilRequestable() time.Duration {
-	if s.Requestable() {
-		return 0
-	}
-	delay := s.RequestDelay()
-	if delay == 0 {
-		return veryFarAway
-	}
-
-	var furthest time.Time
-	if s.LastPlayed.After(s.LastRequested) {
-		furthest = s.LastPlayed
-	} else {
-		furthest = s.LastRequested
-	}
-
-	if furthest.IsZero() {
-		return veryFarAway
-	}
-
-	furthest = furthest.Add(delay)
-	return time.Until(furthest)
+// UntilRequestable returns the time until this song can be requested again with
+// the request curve given, returns 0 if song.Requestable(curve) == true
+func (s *Song) UntilRequestable(curve RequestCurve) time.Duration {
+	until, _ := curve.Cooldown(s)
+	return until
 }
 
 // Hydrate tries to fill Song with data from other fields, mostly useful
@@ -748,6 +1337,8 @@ type StorageService interface {
 	SubmissionStorageService
 	NewsStorageService
 	ScheduleStorageService
+	ProgrammingBlockStorageService
+	WebhookStorageService
 }
 
 // SessionStorageService is a service that supplies a SessionStorage
@@ -815,8 +1406,20 @@ type SongStorage interface {
 	PlayedCount(Song) (int64, error)
 	// AddPlay adds a play to the song. streamer is the dj that played the song.
 	// If present, ldiff is the difference in amount of listeners between
-	// song-start and song-end.
-	AddPlay(song Song, streamer User, ldiff *Listeners) error
+	// song-start and song-end. curve is the listener count sampled during the
+	// play and can be empty.
+	AddPlay(song Song, streamer User, ldiff *Listeners, curve ListenerCurve) error
+	// ListenerCurves returns the listener curves of the last limit plays of
+	// the song that have one
+	ListenerCurves(song Song, limit int64) ([]PlayCurve, error)
+	// Retention returns the listener retention of the song over all its plays
+	Retention(Song) (ListenerRetention, error)
+	// RetentionBySong returns the retention of songs played since the time
+	// given, ordered by the worst retention first
+	RetentionBySong(since time.Time, limit int64) ([]SongRetention, error)
+	// RetentionByDJ returns the retention of songs played by each DJ since the
+	// time given, ordered by the worst retention first
+	RetentionByDJ(since time.Time) ([]DJRetention, error)
 
 	// FavoriteCount returns the amount of users that have added this song to
 	// their favorite list
@@ -836,6 +1439,13 @@ type SongStorage interface {
 	UpdateHashLink(entry SongHash, hashLink SongHash) error
 }
 
+// FavoritedSong is a song together with the amount of users that have it on
+// their favorite list
+type FavoritedSong struct {
+	Song
+	Favorites int64
+}
+
 // TrackStorageService is a service able to supply a TrackStorage
 type TrackStorageService interface {
 	Track(context.Context) TrackStorage
@@ -849,6 +1459,9 @@ type TrackStorageService interface {
 type TrackStorage interface {
 	// Get returns a single track with the TrackID given
 	Get(TrackID) (*Song, error)
+	// GetWithFavorites returns the tracks with the TrackIDs given together
+	// with their favorite count, TrackIDs that don't exist are left out
+	GetWithFavorites([]TrackID) ([]FavoritedSong, error)
 	// All returns all tracks in storage
 	All() ([]Song, error)
 	// Delete removes a track from storage
@@ -861,6 +1474,14 @@ type TrackStorage interface {
 	UpdateMetadata(song Song) error
 	// UpdateUsable sets usable to the state given
 	UpdateUsable(song Song, state TrackState) error
+	// UpdateLoudness stores the loudness analysis of the track, passing in a
+	// zero Loudness clears it
+	UpdateLoudness(TrackID, Loudness) error
+	// Unmeasured returns up to limit tracks that have no loudness analysis stored
+	Unmeasured(limit int64) ([]Song, error)
+	// UpdateCue stores the cue points of the track and updates the song
+	// length to match, passing in zero for both clears them
+	UpdateCue(id TrackID, in, out time.Duration) error
 
 	// UpdateRequestInfo is called after a track has been requested, this should do any
 	// necessary book-keeping related to that
@@ -896,6 +1517,12 @@ type RequestStorage interface {
 	// UpdateLastRequest updates the LastRequest time to the current time for the
 	// identifier given
 	UpdateLastRequest(identifier string) error
+	// RequestTimes returns the times the identifier given requested a song
+	// since the time given, ordered from newest to oldest
+	RequestTimes(identifier string, since time.Time) ([]time.Time, error)
+	// AllRequestTimes returns the times anyone requested a song since the
+	// time given, ordered from newest to oldest
+	AllRequestTimes(since time.Time) ([]time.Time, error)
 }
 
 // UserStorageService is a service able to supply a UserStorage
@@ -928,6 +1555,14 @@ type UserStorage interface {
 	Permissions() ([]UserPermission, error)
 	// RecordListeners records a history of listener count
 	RecordListeners(Listeners, User) error
+	// StreamKeys returns the stream keys of the user given
+	StreamKeys(UserID) ([]StreamKey, error)
+	// CreateStreamKey stores a new stream key, the Hash should be set
+	CreateStreamKey(StreamKey) (StreamKeyID, error)
+	// RevokeStreamKey removes the stream key of the user given
+	RevokeStreamKey(UserID, StreamKeyID) error
+	// GetByStreamKey returns the user and stream key matching the hash given
+	GetByStreamKey(hash string) (*User, *StreamKey, error)
 }
 
 // StatusStorageService is a service able to supply a StatusStorage
@@ -1319,6 +1954,11 @@ type ScheduleEntry struct {
 	Text string
 	// Owner is who "owns" this day for streaming rights
 	Owner *User
+	// Start is the time of day the slot of Owner starts at
+	Start time.Duration
+	// Length is the length of the slot of Owner, zero means the slot lasts until
+	// the end of the day
+	Length time.Duration
 	// UpdatedAt is when this was updated
 	UpdatedAt time.Time
 	// UpdatedBy is who updated this
@@ -1326,3 +1966,220 @@ type ScheduleEntry struct {
 	// Notification indicates if we should notify users of this entry
 	Notification bool
 }
+
+// Window returns the start and end of the occurence of the slot that is
+// active at t or that comes next after t
+func (e ScheduleEntry) Window(t time.Time) (start, end time.Time) {
+	length := e.Length
+	if length <= 0 {
+		length = time.Hour*24 - e.Start
+	}
+	return weeklyWindow(t, e.Weekday, e.Start, length)
+}
+
+// Active returns true if the slot is active at t
+func (e ScheduleEntry) Active(t time.Time) bool {
+	start, end := e.Window(t)
+	return !t.Before(start) && t.Before(end)
+}
+
+// IsOwner returns true if the user given owns the slot
+func (e ScheduleEntry) IsOwner(u *User) bool {
+	return e.Owner != nil && u != nil && e.Owner.ID == u.ID
+}
+
+// ActiveScheduleEntry returns the entry in schedule that is active at t, nil
+// entries are skipped and nil is returned if no entry is active
+func ActiveScheduleEntry(schedule []*ScheduleEntry, t time.Time) *ScheduleEntry {
+	for _, entry := range schedule {
+		if entry != nil && entry.Active(t) {
+			return entry
+		}
+	}
+	return nil
+}
+
+type ProgrammingBlockStorageService interface {
+	ProgrammingBlock(context.Context) ProgrammingBlockStorage
+	ProgrammingBlockTx(context.Context, StorageTx) (ProgrammingBlockStorage, StorageTx, error)
+}
+
+type ProgrammingBlockStorage interface {
+	// All returns all programming blocks ordered by weekday and start
+	All() ([]ProgrammingBlock, error)
+	// Create creates a new programming block and returns its ID
+	Create(ProgrammingBlock) (ProgrammingBlockID, error)
+	// Update updates the programming block with the ID of the one given
+	Update(ProgrammingBlock) error
+	// Delete deletes the programming block with the ID given
+	Delete(ProgrammingBlockID) error
+	// Candidates returns tracks that are candidates to be queue'd while the
+	// programming block given is active
+	Candidates(ProgrammingBlock) ([]TrackID, error)
+}
+
+type ProgrammingBlockID uint64
+
+func ParseProgrammingBlockID(s string) (ProgrammingBlockID, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	return ProgrammingBlockID(id), err
+}
+
+func (id ProgrammingBlockID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// ProgrammingBlock is a weekly window of time in which the automated streamer
+// only plays songs matching the block
+type ProgrammingBlock struct {
+	ID ProgrammingBlockID
+	// Name is the name of the block, such as "Vocaloid hour"
+	Name string
+	// Weekday is the day the block starts on
+	Weekday ScheduleDay
+	// Start is the time since midnight the block starts at
+	Start time.Duration
+	// Length is how long the block lasts
+	Length time.Duration
+
+	// Tags restricts the block to songs that have any of these tags
+	Tags []string
+	// Artists restricts the block to songs by any of these artists
+	Artists []string
+	// Playlist restricts the block to these tracks
+	Playlist []TrackID
+
+	// UpdatedAt is when this was last updated
+	UpdatedAt time.Time
+}
+
+// weekStart returns the start of the week t is in, weeks start on Monday
+// same as ScheduleDay
+func weekStart(t time.Time) time.Time {
+	// time.Weekday starts at Sunday, we start at Monday
+	day := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-day, 0, 0, 0, 0, t.Location())
+}
+
+// weeklyWindow returns the start and end of the occurence of something that
+// happens every week on weekday at the start and length given, that is active
+// at t or that comes next after t
+func weeklyWindow(t time.Time, weekday ScheduleDay, start, length time.Duration) (time.Time, time.Time) {
+	week := weekStart(t)
+	var s, e time.Time
+	// the window might've started last week and still be going
+	for _, offset := range []int{-7, 0, 7} {
+		s = week.AddDate(0, 0, int(weekday)+offset).Add(start)
+		e = s.Add(length)
+		if t.Before(e) {
+			return s, e
+		}
+	}
+	return s, e
+}
+
+// Window returns the start and end of the occurence of the block that is
+// active at t or that comes next after t
+func (pb ProgrammingBlock) Window(t time.Time) (start, end time.Time) {
+	return weeklyWindow(t, pb.Weekday, pb.Start, pb.Length)
+}
+
+// Active returns true if the block is active at t
+func (pb ProgrammingBlock) Active(t time.Time) bool {
+	start, end := pb.Window(t)
+	return !t.Before(start) && t.Before(end)
+}
+
+// Matches returns true if the song given is allowed to play during the block,
+// a block without any restrictions matches all songs
+func (pb ProgrammingBlock) Matches(s Song) bool {
+	if len(pb.Tags) == 0 && len(pb.Artists) == 0 && len(pb.Playlist) == 0 {
+		return true
+	}
+	if !s.HasTrack() {
+		return false
+	}
+
+	if slices.Contains(pb.Playlist, s.TrackID) {
+		return true
+	}
+	for _, artist := range pb.Artists {
+		if strings.EqualFold(strings.TrimSpace(s.Artist), artist) {
+			return true
+		}
+	}
+	for _, tag := range strings.Fields(s.Tags) {
+		for _, want := range pb.Tags {
+			if strings.EqualFold(tag, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ActiveProgrammingBlock returns the block in blocks that is active at t, if
+// multiple blocks overlap the one that started last is returned
+func ActiveProgrammingBlock(blocks []ProgrammingBlock, t time.Time) *ProgrammingBlock {
+	var active *ProgrammingBlock
+	var activeStart time.Time
+
+	for i := range blocks {
+		if !blocks[i].Active(t) {
+			continue
+		}
+		start, _ := blocks[i].Window(t)
+		if active == nil || start.After(activeStart) {
+			active, activeStart = &blocks[i], start
+		}
+	}
+	return active
+}
+
+type WebhookStorageService interface {
+	Webhook(context.Context) WebhookStorage
+	WebhookTx(context.Context, StorageTx) (WebhookStorage, StorageTx, error)
+}
+
+type WebhookStorage interface {
+	// AddDelivery records the outcome of a webhook delivery
+	AddDelivery(WebhookDelivery) (WebhookDeliveryID, error)
+	// Deliveries returns the deliveries made, newest first
+	Deliveries(limit, offset int64) (WebhookDeliveryList, error)
+}
+
+type WebhookDeliveryList struct {
+	Entries []WebhookDelivery
+	Total   int
+}
+
+type WebhookDeliveryID uint64
+
+func (id WebhookDeliveryID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// WebhookDelivery is the outcome of sending an event to a webhook endpoint
+type WebhookDelivery struct {
+	ID WebhookDeliveryID
+	// Event is the name of the event that was sent
+	Event string
+	// URL is the endpoint the event was sent to
+	URL string
+	// Payload is the JSON payload that was sent
+	Payload string
+	// StatusCode is the HTTP status code of the last attempt, zero if no
+	// response was received
+	StatusCode int
+	// Error is the error of the last attempt, empty if the delivery succeeded
+	Error string
+	// Attempts is the amount of attempts made
+	Attempts int
+	// CreatedAt is when the first attempt was made
+	CreatedAt time.Time
+}
+
+// Success returns true if the delivery succeeded
+func (wd WebhookDelivery) Success() bool {
+	return wd.Error == "" && wd.StatusCode >= 200 && wd.StatusCode < 300
+}
@@ -247,15 +247,15 @@ func TestSongRequestable(t *testing.T) {
 
 	p.Property("if Requestable then UntilRequestable == 0", prop.ForAll(
 		func(s Song) bool {
-			return s.UntilRequestable() == 0
+			return s.UntilRequestable(DefaultRequestCurve) == 0
 		},
-		aNoTimes.GenForType(songType).SuchThat(func(s Song) bool { return s.Requestable() }),
+		aNoTimes.GenForType(songType).SuchThat(func(s Song) bool { return s.Requestable(DefaultRequestCurve) }),
 	))
 
 	p.Property("if not Requestable then UntilRequestable > 0", prop.ForAll(
 		func(s Song) bool {
-			return s.UntilRequestable() > 0
-		}, a.GenForType(songType).SuchThat(func(s Song) bool { return !s.Requestable() }),
+			return s.UntilRequestable(DefaultRequestCurve) > 0
+		}, a.GenForType(songType).SuchThat(func(s Song) bool { return !s.Requestable(DefaultRequestCurve) }),
 	))
 
 	p.TestingRun(t)
@@ -291,7 +291,7 @@ func TestCalculateCooldown(t *testing.T) {
 	}
 }
 
-func TestCalculateRequestDelay(t *testing.T) {
+func TestRequestCurveDelay(t *testing.T) {
 	tp := gopter.DefaultTestParameters()
 	tp.MinSuccessfulTests = 10000
 	a := arbitrary.DefaultArbitraries()
@@ -299,11 +299,11 @@ func TestCalculateRequestDelay(t *testing.T) {
 
 	p.Property("+1 should be higher or equal", a.ForAll(
 		func(i int) bool {
-			return CalculateRequestDelay(i) <= CalculateRequestDelay(i+1)
+			return DefaultRequestCurve.Delay(i) <= DefaultRequestCurve.Delay(i+1)
 		}))
 	p.Property("+1 should be higher or equal", prop.ForAll(
 		func(i int) bool {
-			return CalculateRequestDelay(i) <= CalculateRequestDelay(i+1)
+			return DefaultRequestCurve.Delay(i) <= DefaultRequestCurve.Delay(i+1)
 		},
 		gen.IntRange(0, 50),
 	))
@@ -536,3 +536,138 @@ func TestSongHashJSON(t *testing.T) {
 	}))
 	p.TestingRun(t)
 }
+
+func TestProgrammingBlockActive(t *testing.T) {
+	// 2024-01-05 is a Friday
+	friday := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	vocaloid := ProgrammingBlock{
+		ID:      1,
+		Weekday: Friday,
+		Start:   time.Hour * 20,
+		Length:  time.Hour,
+	}
+	// a block that wraps around into next week
+	lateSunday := ProgrammingBlock{
+		ID:      2,
+		Weekday: Sunday,
+		Start:   time.Hour * 23,
+		Length:  time.Hour * 2,
+	}
+
+	cases := []struct {
+		name   string
+		block  ProgrammingBlock
+		t      time.Time
+		active bool
+	}{
+		{"before start", vocaloid, friday.Add(time.Hour*20 - time.Second), false},
+		{"at start", vocaloid, friday.Add(time.Hour * 20), true},
+		{"during", vocaloid, friday.Add(time.Hour*20 + time.Minute*30), true},
+		{"at end", vocaloid, friday.Add(time.Hour * 21), false},
+		{"other day", vocaloid, friday.AddDate(0, 0, 1).Add(time.Hour * 20), false},
+		{"next week", vocaloid, friday.AddDate(0, 0, 7).Add(time.Hour * 20), true},
+		{"wrap on sunday", lateSunday, friday.AddDate(0, 0, 2).Add(time.Hour * 23), true},
+		{"wrap into monday", lateSunday, friday.AddDate(0, 0, 3).Add(time.Minute * 30), true},
+		{"wrap ended", lateSunday, friday.AddDate(0, 0, 3).Add(time.Hour), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.active, c.block.Active(c.t))
+		})
+	}
+
+	// overlapping blocks should pick the one that started last
+	quarter := ProgrammingBlock{ID: 3, Weekday: Friday, Start: time.Hour*20 + time.Minute*15, Length: time.Minute * 15}
+	blocks := []ProgrammingBlock{lateSunday, vocaloid, quarter}
+	if active := ActiveProgrammingBlock(blocks, friday.Add(time.Hour*20+time.Minute*20)); assert.NotNil(t, active) {
+		assert.Equal(t, quarter.ID, active.ID)
+	}
+	if active := ActiveProgrammingBlock(blocks, friday.Add(time.Hour*20+time.Minute*45)); assert.NotNil(t, active) {
+		assert.Equal(t, vocaloid.ID, active.ID)
+	}
+	assert.Nil(t, ActiveProgrammingBlock(blocks, friday))
+}
+
+func TestProgrammingBlockMatches(t *testing.T) {
+	song := Song{DatabaseTrack: &DatabaseTrack{
+		TrackID: 50,
+		Artist:  "Hatsune Miku",
+		Tags:    "vocaloid electronic",
+	}}
+
+	assert.True(t, ProgrammingBlock{}.Matches(song), "empty block should match everything")
+	assert.True(t, ProgrammingBlock{Tags: []string{"Vocaloid"}}.Matches(song))
+	assert.False(t, ProgrammingBlock{Tags: []string{"vocal"}}.Matches(song), "tags should match whole")
+	assert.True(t, ProgrammingBlock{Artists: []string{"hatsune miku"}}.Matches(song))
+	assert.True(t, ProgrammingBlock{Playlist: []TrackID{10, 50}}.Matches(song))
+	assert.False(t, ProgrammingBlock{Playlist: []TrackID{10}}.Matches(song))
+	assert.False(t, ProgrammingBlock{Tags: []string{"vocaloid"}}.Matches(Song{}), "songs without track shouldn't match")
+}
+
+func TestScheduleEntryActive(t *testing.T) {
+	// 2024-01-05 is a Friday
+	friday := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	wholeDay := ScheduleEntry{Weekday: Friday}
+	evening := ScheduleEntry{Weekday: Friday, Start: time.Hour * 20, Length: time.Hour * 2}
+
+	cases := []struct {
+		name   string
+		entry  ScheduleEntry
+		t      time.Time
+		active bool
+	}{
+		{"whole day start", wholeDay, friday, true},
+		{"whole day end", wholeDay, friday.Add(time.Hour*24 - time.Second), true},
+		{"whole day next day", wholeDay, friday.Add(time.Hour * 24), false},
+		{"before slot", evening, friday.Add(time.Hour * 19), false},
+		{"during slot", evening, friday.Add(time.Hour * 21), true},
+		{"after slot", evening, friday.Add(time.Hour * 22), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.active, c.entry.Active(c.t))
+		})
+	}
+
+	schedule := []*ScheduleEntry{nil, &evening, nil}
+	assert.Equal(t, &evening, ActiveScheduleEntry(schedule, friday.Add(time.Hour*21)))
+	assert.Nil(t, ActiveScheduleEntry(schedule, friday.Add(time.Hour*12)))
+}
+
+func TestListenerCurve(t *testing.T) {
+	var curve ListenerCurve
+	curve = curve.Add(0, 100)
+	curve = curve.Add(time.Second*10, 100) // same count, should be skipped
+	curve = curve.Add(time.Second*20, 90)
+	curve = curve.Add(time.Second*30, 120)
+	curve = curve.Add(time.Second*40, 110)
+
+	require.Len(t, curve, 4)
+	assert.EqualValues(t, 100, curve.Start())
+	assert.EqualValues(t, 110, curve.End())
+	assert.EqualValues(t, 20, curve.Lost())
+	assert.Equal(t, "0:100,20:90,30:120,40:110", curve.String())
+
+	parsed, err := ParseListenerCurve(curve.String())
+	require.NoError(t, err)
+	assert.Equal(t, curve, parsed)
+
+	_, err = ParseListenerCurve("10-20")
+	assert.Error(t, err)
+}
+
+func TestNewRequester(t *testing.T) {
+	r := NewRequester("127.0.0.1", nil)
+	assert.Equal(t, "127.0.0.1", r.Identifier)
+	assert.False(t, r.Staff)
+
+	user := User{UserPermissions: UserPermissions{PermActive: {}}}
+	assert.False(t, NewRequester("", &user).Staff)
+
+	user.UserPermissions[PermDJ] = struct{}{}
+	assert.True(t, NewRequester("", &user).Staff)
+}
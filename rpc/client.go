@@ -67,6 +67,80 @@ func (lt ListenerTrackerClientRPC) RemoveClient(ctx context.Context, id radio.Li
 	return nil
 }
 
+// NewProxyService returns a new client implementing radio.ProxyService
+func NewProxyService(c *grpc.ClientConn) radio.ProxyService {
+	return ProxyClientRPC{
+		rpc: NewProxyClient(c),
+	}
+}
+
+type ProxyClientRPC struct {
+	rpc ProxyClient
+}
+
+var _ radio.ProxyService = ProxyClientRPC{}
+
+func (p ProxyClientRPC) ListSources(ctx context.Context) ([]radio.ProxySource, error) {
+	resp, err := p.rpc.ListSources(ctx, new(emptypb.Empty))
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]radio.ProxySource, len(resp.Entries))
+	for i := range resp.Entries {
+		sources[i] = fromProtoProxySource(resp.Entries[i])
+	}
+	return sources, nil
+}
+
+func (p ProxyClientRPC) ListUpstreams(ctx context.Context) ([]radio.ProxyUpstream, error) {
+	resp, err := p.rpc.ListUpstreams(ctx, new(emptypb.Empty))
+	if err != nil {
+		return nil, err
+	}
+
+	upstreams := make([]radio.ProxyUpstream, len(resp.Entries))
+	for i := range resp.Entries {
+		upstreams[i] = fromProtoProxyUpstream(resp.Entries[i])
+	}
+	return upstreams, nil
+}
+
+func (p ProxyClientRPC) KickSource(ctx context.Context, id radio.SourceID) error {
+	_, err := p.rpc.KickSource(ctx, &ProxySourceRequest{
+		Id: id.String(),
+	})
+	return err
+}
+
+func (p ProxyClientRPC) SetLive(ctx context.Context, id radio.SourceID) error {
+	_, err := p.rpc.SetLive(ctx, &ProxySourceRequest{
+		Id: id.String(),
+	})
+	return err
+}
+
+func (p ProxyClientRPC) SetPriorities(ctx context.Context, mount string, order []radio.SourceID) error {
+	ids := make([]string, len(order))
+	for i := range order {
+		ids[i] = order[i].String()
+	}
+
+	_, err := p.rpc.SetPriorities(ctx, &ProxyPrioritiesRequest{
+		MountName: mount,
+		Order:     ids,
+	})
+	return err
+}
+
+// Events implements radio.ProxyService
+func (p ProxyClientRPC) Events(ctx context.Context) (eventstream.Stream[radio.ProxyEvent], error) {
+	c := func(ctx context.Context, e *emptypb.Empty, opts ...grpc.CallOption) (pbReceiver[*ProxyEvent], error) {
+		return p.rpc.Events(ctx, e, opts...)
+	}
+	return streamFromProtobuf(ctx, c, fromProtoProxyEvent)
+}
+
 // NewAnnouncerService returns a new client implementing radio.AnnounceService
 func NewAnnouncerService(c *grpc.ClientConn) radio.AnnounceService {
 	return AnnouncerClientRPC{
@@ -104,6 +178,12 @@ func (a AnnouncerClientRPC) AnnounceRequest(ctx context.Context, s radio.Song) e
 	return err
 }
 
+// AnnounceStaff implements radio.AnnounceService
+func (a AnnouncerClientRPC) AnnounceStaff(ctx context.Context, message string) error {
+	_, err := a.rpc.AnnounceStaff(ctx, wrapperspb.String(message))
+	return err
+}
+
 // NewManagerService returns a new client implementing radio.ManagerService
 func NewManagerService(c *grpc.ClientConn) radio.ManagerService {
 	return ManagerClientRPC{
@@ -126,6 +206,14 @@ func (m ManagerClientRPC) CurrentStatus(ctx context.Context) (eventstream.Stream
 	return streamFromProtobuf(ctx, c, fromProtoStatus)
 }
 
+// Events implements radio.ManagerService
+func (m ManagerClientRPC) Events(ctx context.Context) (eventstream.Stream[radio.ManagerEvent], error) {
+	c := func(ctx context.Context, e *emptypb.Empty, opts ...grpc.CallOption) (pbReceiver[*ManagerEvent], error) {
+		return m.rpc.Events(ctx, e, opts...)
+	}
+	return streamFromProtobuf(ctx, c, fromProtoManagerEvent)
+}
+
 func (m ManagerClientRPC) CurrentUser(ctx context.Context) (eventstream.Stream[*radio.User], error) {
 	c := func(ctx context.Context, e *emptypb.Empty, opts ...grpc.CallOption) (pbReceiver[*User], error) {
 		return m.rpc.CurrentUser(ctx, e, opts...)
@@ -210,15 +298,25 @@ func (s StreamerClientRPC) Stop(ctx context.Context, force bool) error {
 	return fromProtoError(resp.Error)
 }
 
+// Skip implements radio.StreamerService
+func (s StreamerClientRPC) Skip(ctx context.Context) error {
+	resp, err := s.rpc.Skip(ctx, new(emptypb.Empty))
+	if err != nil {
+		return err
+	}
+	return fromProtoError(resp.Error)
+}
+
 // RequestSong implements radio.StreamerService
-func (s StreamerClientRPC) RequestSong(ctx context.Context, song radio.Song, identifier string) error {
+func (s StreamerClientRPC) RequestSong(ctx context.Context, song radio.Song, requester radio.Requester) error {
 	if !song.HasTrack() {
 		panic("request song called with non-database track")
 	}
 
 	resp, err := s.rpc.RequestSong(ctx, &SongRequest{
-		UserIdentifier: identifier,
+		UserIdentifier: requester.Identifier,
 		Song:           toProtoSong(song),
+		Staff:          requester.Staff,
 	})
 	if err != nil {
 		return err
@@ -292,6 +390,38 @@ func (q QueueClientRPC) Remove(ctx context.Context, id radio.QueueID) (bool, err
 	return resp.Value, nil
 }
 
+// Move implements radio.QueueService
+func (q QueueClientRPC) Move(ctx context.Context, id radio.QueueID, position int) (bool, error) {
+	resp, err := q.rpc.Move(ctx, &QueueMove{
+		QueueId:  toProtoQueueID(id),
+		Position: int64(position),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Value, nil
+}
+
+// InsertAt implements radio.QueueService
+func (q QueueClientRPC) InsertAt(ctx context.Context, s radio.Song, position int) error {
+	_, err := q.rpc.InsertAt(ctx, &QueueInsert{
+		Song:     toProtoSong(s),
+		Position: int64(position),
+	})
+	return err
+}
+
+// Promote implements radio.QueueService
+func (q QueueClientRPC) Promote(ctx context.Context, id radio.QueueID) (bool, error) {
+	resp, err := q.rpc.Promote(ctx, toProtoQueueID(id))
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Value, nil
+}
+
 // Entries implements radio.QueueService
 func (q QueueClientRPC) Entries(ctx context.Context) ([]radio.QueueEntry, error) {
 	resp, err := q.rpc.Entries(ctx, new(emptypb.Empty))
@@ -306,6 +436,14 @@ func (q QueueClientRPC) Entries(ctx context.Context) ([]radio.QueueEntry, error)
 	return queue, nil
 }
 
+// Events implements radio.QueueService
+func (q QueueClientRPC) Events(ctx context.Context) (eventstream.Stream[radio.QueueEvent], error) {
+	c := func(ctx context.Context, e *emptypb.Empty, opts ...grpc.CallOption) (pbReceiver[*QueueEvent], error) {
+		return q.rpc.Events(ctx, e, opts...)
+	}
+	return streamFromProtobuf(ctx, c, fromProtoQueueEvent)
+}
+
 type pbCreator[P any] func(context.Context, *emptypb.Empty, ...grpc.CallOption) (pbReceiver[P], error)
 
 type pbReceiver[P any] interface {
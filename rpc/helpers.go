@@ -109,6 +109,15 @@ func toProtoSong(s radio.Song) *Song {
 		song.LastRequested = tp(s.LastRequested)
 		song.RequestCount = int32(s.RequestCount)
 		song.NeedReplacement = s.NeedReplacement
+		song.Loudness = &Loudness{
+			Integrated: s.Loudness.Integrated,
+			Range:      s.Loudness.Range,
+			TruePeak:   s.Loudness.TruePeak,
+			Threshold:  s.Loudness.Threshold,
+			Offset:     s.Loudness.Offset,
+		}
+		song.CueIn = dp(s.CueIn)
+		song.CueOut = dp(s.CueOut)
 	}
 
 	return song
@@ -134,6 +143,15 @@ func fromProtoSong(s *Song) radio.Song {
 			LastRequested:   t(s.LastRequested),
 			RequestCount:    int(s.RequestCount),
 			NeedReplacement: s.NeedReplacement,
+			Loudness: radio.Loudness{
+				Integrated: s.Loudness.GetIntegrated(),
+				Range:      s.Loudness.GetRange(),
+				TruePeak:   s.Loudness.GetTruePeak(),
+				Threshold:  s.Loudness.GetThreshold(),
+				Offset:     s.Loudness.GetOffset(),
+			},
+			CueIn:  d(s.CueIn),
+			CueOut: d(s.CueOut),
 		}
 	}
 
@@ -210,6 +228,58 @@ func fromProtoQueueEntry(entry *QueueEntry) radio.QueueEntry {
 	}
 }
 
+func toProtoManagerEvent(ev radio.ManagerEvent) *ManagerEvent {
+	return &ManagerEvent{
+		Kind:  int32(ev.Kind),
+		User:  toProtoUser(ev.User),
+		Time:  tp(ev.Time),
+		Delay: dp(ev.Delay),
+	}
+}
+
+func fromProtoManagerEvent(ev *ManagerEvent) radio.ManagerEvent {
+	if ev == nil {
+		return radio.ManagerEvent{}
+	}
+
+	return radio.ManagerEvent{
+		Kind:  radio.ManagerEventKind(ev.Kind),
+		User:  fromProtoUser(ev.User),
+		Time:  t(ev.Time),
+		Delay: d(ev.Delay),
+	}
+}
+
+func toProtoQueueEvent(ev radio.QueueEvent) *QueueEvent {
+	queue := make([]*QueueEntry, len(ev.Queue))
+	for i := range ev.Queue {
+		queue[i] = toProtoQueueEntry(ev.Queue[i])
+	}
+
+	return &QueueEvent{
+		Kind:  int32(ev.Kind),
+		Entry: toProtoQueueEntry(ev.Entry),
+		Queue: queue,
+	}
+}
+
+func fromProtoQueueEvent(ev *QueueEvent) radio.QueueEvent {
+	if ev == nil {
+		return radio.QueueEvent{}
+	}
+
+	queue := make([]radio.QueueEntry, len(ev.Queue))
+	for i := range ev.Queue {
+		queue[i] = fromProtoQueueEntry(ev.Queue[i])
+	}
+
+	return radio.QueueEvent{
+		Kind:  radio.QueueEventKind(ev.Kind),
+		Entry: fromProtoQueueEntry(ev.Entry),
+		Queue: queue,
+	}
+}
+
 func toProtoQueueID(rid radio.QueueID) *QueueID {
 	return &QueueID{
 		ID: rid.String(),
@@ -442,3 +512,104 @@ func fromProtoListener(l *Listener) radio.Listener {
 		Start:     t(l.Start),
 	}
 }
+
+func toProtoProxySource(s radio.ProxySource) *ProxySource {
+	return &ProxySource{
+		Id:            s.ID.String(),
+		MountName:     s.MountName,
+		User:          toProtoUser(&s.User),
+		UserAgent:     s.UserAgent,
+		ContentType:   s.ContentType,
+		Priority:      uint64(s.Priority),
+		Live:          s.Live,
+		Metadata:      s.Metadata,
+		Start:         tp(s.Start),
+		BytesReceived: s.BytesReceived,
+	}
+}
+
+func fromProtoProxySource(s *ProxySource) radio.ProxySource {
+	// the id was created by SourceID.String so it can't fail to parse
+	id, _ := radio.ParseSourceID(s.Id)
+
+	var user radio.User
+	if u := fromProtoUser(s.User); u != nil {
+		user = *u
+	}
+
+	return radio.ProxySource{
+		ID:            id,
+		MountName:     s.MountName,
+		User:          user,
+		UserAgent:     s.UserAgent,
+		ContentType:   s.ContentType,
+		Priority:      uint(s.Priority),
+		Live:          s.Live,
+		Metadata:      s.Metadata,
+		Start:         t(s.Start),
+		BytesReceived: s.BytesReceived,
+	}
+}
+
+func toProtoProxyUpstream(u radio.ProxyUpstream) *ProxyUpstream {
+	return &ProxyUpstream{
+		MountName:    u.MountName,
+		Url:          u.URL,
+		Connected:    u.Connected,
+		Since:        tp(u.Since),
+		Error:        u.Error,
+		BytesSent:    u.BytesSent,
+		BytesDropped: u.BytesDropped,
+	}
+}
+
+func fromProtoProxyUpstream(u *ProxyUpstream) radio.ProxyUpstream {
+	return radio.ProxyUpstream{
+		MountName:    u.MountName,
+		URL:          u.Url,
+		Connected:    u.Connected,
+		Since:        t(u.Since),
+		Error:        u.Error,
+		BytesSent:    u.BytesSent,
+		BytesDropped: u.BytesDropped,
+	}
+}
+
+func toProtoProxyEvent(ev radio.ProxyEvent) *ProxyEvent {
+	sources := make([]*ProxySource, len(ev.Sources))
+	for i := range ev.Sources {
+		sources[i] = toProtoProxySource(ev.Sources[i])
+	}
+
+	return &ProxyEvent{
+		Kind:      int32(ev.Kind),
+		MountName: ev.MountName,
+		Source:    toProtoProxySource(ev.Source),
+		Metadata:  ev.Metadata,
+		Sources:   sources,
+	}
+}
+
+func fromProtoProxyEvent(ev *ProxyEvent) radio.ProxyEvent {
+	if ev == nil {
+		return radio.ProxyEvent{}
+	}
+
+	sources := make([]radio.ProxySource, len(ev.Sources))
+	for i := range ev.Sources {
+		sources[i] = fromProtoProxySource(ev.Sources[i])
+	}
+
+	var source radio.ProxySource
+	if ev.Source != nil {
+		source = fromProtoProxySource(ev.Source)
+	}
+
+	return radio.ProxyEvent{
+		Kind:      radio.ProxyEventKind(ev.Kind),
+		MountName: ev.MountName,
+		Source:    source,
+		Metadata:  ev.Metadata,
+		Sources:   sources,
+	}
+}
@@ -57,6 +57,11 @@ type Song struct {
 	LastRequested   *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=last_requested,json=lastRequested,proto3" json:"last_requested,omitempty"`
 	RequestCount    int32                  `protobuf:"varint,27,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
 	RequestDelay    *durationpb.Duration   `protobuf:"bytes,28,opt,name=request_delay,json=requestDelay,proto3" json:"request_delay,omitempty"`
+	// loudness analysis of the track, zero if not measured yet
+	Loudness *Loudness `protobuf:"bytes,30,opt,name=loudness,proto3" json:"loudness,omitempty"`
+	// cue points of the track, zero if not detected yet
+	CueIn  *durationpb.Duration `protobuf:"bytes,31,opt,name=cue_in,json=cueIn,proto3" json:"cue_in,omitempty"`
+	CueOut *durationpb.Duration `protobuf:"bytes,32,opt,name=cue_out,json=cueOut,proto3" json:"cue_out,omitempty"`
 	// the time the fields above were acquired from the database
 	SyncTime *timestamppb.Timestamp `protobuf:"bytes,100,opt,name=sync_time,json=syncTime,proto3" json:"sync_time,omitempty"`
 }
@@ -240,6 +245,27 @@ func (x *Song) GetRequestDelay() *durationpb.Duration {
 	return nil
 }
 
+func (x *Song) GetLoudness() *Loudness {
+	if x != nil {
+		return x.Loudness
+	}
+	return nil
+}
+
+func (x *Song) GetCueIn() *durationpb.Duration {
+	if x != nil {
+		return x.CueIn
+	}
+	return nil
+}
+
+func (x *Song) GetCueOut() *durationpb.Duration {
+	if x != nil {
+		return x.CueOut
+	}
+	return nil
+}
+
 func (x *Song) GetSyncTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.SyncTime
@@ -247,6 +273,160 @@ func (x *Song) GetSyncTime() *timestamppb.Timestamp {
 	return nil
 }
 
+type ManagerEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind is the kind of transition, see radio.ManagerEventKind in the Go package
+	Kind int32 `protobuf:"varint,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// user is the user involved in the transition, if any
+	User *User `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// time is when the transition happened
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// delay is the time until the next attempt or start of the streamer
+	Delay *durationpb.Duration `protobuf:"bytes,4,opt,name=delay,proto3" json:"delay,omitempty"`
+}
+
+func (x *ManagerEvent) Reset() {
+	*x = ManagerEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManagerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagerEvent) ProtoMessage() {}
+
+func (x *ManagerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagerEvent.ProtoReflect.Descriptor instead.
+func (*ManagerEvent) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ManagerEvent) GetKind() int32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *ManagerEvent) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ManagerEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ManagerEvent) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+type Loudness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Integrated float64 `protobuf:"fixed64,1,opt,name=integrated,proto3" json:"integrated,omitempty"`
+	Range      float64 `protobuf:"fixed64,2,opt,name=range,proto3" json:"range,omitempty"`
+	TruePeak   float64 `protobuf:"fixed64,3,opt,name=true_peak,json=truePeak,proto3" json:"true_peak,omitempty"`
+	Threshold  float64 `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Offset     float64 `protobuf:"fixed64,5,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *Loudness) Reset() {
+	*x = Loudness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Loudness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loudness) ProtoMessage() {}
+
+func (x *Loudness) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loudness.ProtoReflect.Descriptor instead.
+func (*Loudness) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Loudness) GetIntegrated() float64 {
+	if x != nil {
+		return x.Integrated
+	}
+	return 0
+}
+
+func (x *Loudness) GetRange() float64 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+func (x *Loudness) GetTruePeak() float64 {
+	if x != nil {
+		return x.TruePeak
+	}
+	return 0
+}
+
+func (x *Loudness) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Loudness) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 type StatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -271,7 +451,7 @@ type StatusResponse struct {
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[1]
+		mi := &file_radio_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -284,7 +464,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[1]
+	mi := &file_radio_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,7 +477,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{1}
+	return file_radio_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StatusResponse) GetUser() *User {
@@ -361,7 +541,7 @@ type SongUpdate struct {
 func (x *SongUpdate) Reset() {
 	*x = SongUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[2]
+		mi := &file_radio_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -374,7 +554,7 @@ func (x *SongUpdate) String() string {
 func (*SongUpdate) ProtoMessage() {}
 
 func (x *SongUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[2]
+	mi := &file_radio_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,7 +567,7 @@ func (x *SongUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SongUpdate.ProtoReflect.Descriptor instead.
 func (*SongUpdate) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{2}
+	return file_radio_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SongUpdate) GetSong() *Song {
@@ -420,7 +600,7 @@ type SongInfo struct {
 func (x *SongInfo) Reset() {
 	*x = SongInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[3]
+		mi := &file_radio_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -433,7 +613,7 @@ func (x *SongInfo) String() string {
 func (*SongInfo) ProtoMessage() {}
 
 func (x *SongInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[3]
+	mi := &file_radio_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,7 +626,7 @@ func (x *SongInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SongInfo.ProtoReflect.Descriptor instead.
 func (*SongInfo) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{3}
+	return file_radio_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SongInfo) GetStartTime() *timestamppb.Timestamp {
@@ -484,7 +664,7 @@ type StreamerConfig struct {
 func (x *StreamerConfig) Reset() {
 	*x = StreamerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[4]
+		mi := &file_radio_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -497,7 +677,7 @@ func (x *StreamerConfig) String() string {
 func (*StreamerConfig) ProtoMessage() {}
 
 func (x *StreamerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[4]
+	mi := &file_radio_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +690,7 @@ func (x *StreamerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamerConfig.ProtoReflect.Descriptor instead.
 func (*StreamerConfig) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{4}
+	return file_radio_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *StreamerConfig) GetRequestsEnabled() bool {
@@ -539,7 +719,7 @@ type UserUpdate struct {
 func (x *UserUpdate) Reset() {
 	*x = UserUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[5]
+		mi := &file_radio_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -552,7 +732,7 @@ func (x *UserUpdate) String() string {
 func (*UserUpdate) ProtoMessage() {}
 
 func (x *UserUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[5]
+	mi := &file_radio_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +745,7 @@ func (x *UserUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserUpdate.ProtoReflect.Descriptor instead.
 func (*UserUpdate) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{5}
+	return file_radio_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UserUpdate) GetUser() *User {
@@ -604,7 +784,7 @@ type User struct {
 func (x *User) Reset() {
 	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[6]
+		mi := &file_radio_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -617,7 +797,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[6]
+	mi := &file_radio_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +810,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{6}
+	return file_radio_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *User) GetId() int32 {
@@ -732,7 +912,7 @@ type DJ struct {
 func (x *DJ) Reset() {
 	*x = DJ{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[7]
+		mi := &file_radio_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -745,7 +925,7 @@ func (x *DJ) String() string {
 func (*DJ) ProtoMessage() {}
 
 func (x *DJ) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[7]
+	mi := &file_radio_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +938,7 @@ func (x *DJ) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DJ.ProtoReflect.Descriptor instead.
 func (*DJ) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{7}
+	return file_radio_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DJ) GetId() uint64 {
@@ -852,7 +1032,7 @@ type Theme struct {
 func (x *Theme) Reset() {
 	*x = Theme{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[8]
+		mi := &file_radio_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -865,7 +1045,7 @@ func (x *Theme) String() string {
 func (*Theme) ProtoMessage() {}
 
 func (x *Theme) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[8]
+	mi := &file_radio_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1058,7 @@ func (x *Theme) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Theme.ProtoReflect.Descriptor instead.
 func (*Theme) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{8}
+	return file_radio_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Theme) GetId() uint64 {
@@ -921,7 +1101,7 @@ type ListenerInfo struct {
 func (x *ListenerInfo) Reset() {
 	*x = ListenerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[9]
+		mi := &file_radio_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -934,7 +1114,7 @@ func (x *ListenerInfo) String() string {
 func (*ListenerInfo) ProtoMessage() {}
 
 func (x *ListenerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[9]
+	mi := &file_radio_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,7 +1127,7 @@ func (x *ListenerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListenerInfo.ProtoReflect.Descriptor instead.
 func (*ListenerInfo) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{9}
+	return file_radio_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListenerInfo) GetListeners() int64 {
@@ -970,7 +1150,7 @@ type SongAnnouncement struct {
 func (x *SongAnnouncement) Reset() {
 	*x = SongAnnouncement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[10]
+		mi := &file_radio_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -983,7 +1163,7 @@ func (x *SongAnnouncement) String() string {
 func (*SongAnnouncement) ProtoMessage() {}
 
 func (x *SongAnnouncement) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[10]
+	mi := &file_radio_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,7 +1176,7 @@ func (x *SongAnnouncement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SongAnnouncement.ProtoReflect.Descriptor instead.
 func (*SongAnnouncement) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{10}
+	return file_radio_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SongAnnouncement) GetSong() *Song {
@@ -1031,7 +1211,7 @@ type SongRequestAnnouncement struct {
 func (x *SongRequestAnnouncement) Reset() {
 	*x = SongRequestAnnouncement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[11]
+		mi := &file_radio_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1044,7 +1224,7 @@ func (x *SongRequestAnnouncement) String() string {
 func (*SongRequestAnnouncement) ProtoMessage() {}
 
 func (x *SongRequestAnnouncement) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[11]
+	mi := &file_radio_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,7 +1237,7 @@ func (x *SongRequestAnnouncement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SongRequestAnnouncement.ProtoReflect.Descriptor instead.
 func (*SongRequestAnnouncement) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{11}
+	return file_radio_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SongRequestAnnouncement) GetSong() *Song {
@@ -1078,7 +1258,7 @@ type StreamerResponse struct {
 func (x *StreamerResponse) Reset() {
 	*x = StreamerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[12]
+		mi := &file_radio_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1091,7 +1271,7 @@ func (x *StreamerResponse) String() string {
 func (*StreamerResponse) ProtoMessage() {}
 
 func (x *StreamerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[12]
+	mi := &file_radio_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,7 +1284,7 @@ func (x *StreamerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamerResponse.ProtoReflect.Descriptor instead.
 func (*StreamerResponse) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{12}
+	return file_radio_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StreamerResponse) GetError() []*Error {
@@ -1125,7 +1305,7 @@ type QueueID struct {
 func (x *QueueID) Reset() {
 	*x = QueueID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[13]
+		mi := &file_radio_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1138,7 +1318,7 @@ func (x *QueueID) String() string {
 func (*QueueID) ProtoMessage() {}
 
 func (x *QueueID) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[13]
+	mi := &file_radio_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,7 +1331,7 @@ func (x *QueueID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueID.ProtoReflect.Descriptor instead.
 func (*QueueID) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{13}
+	return file_radio_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueueID) GetID() string {
@@ -1161,40 +1341,33 @@ func (x *QueueID) GetID() string {
 	return ""
 }
 
-type QueueEntry struct {
+type QueueMove struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Song *Song `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
-	// is_user_request indicates if this was a request made by a human
-	IsUserRequest bool `protobuf:"varint,2,opt,name=is_user_request,json=isUserRequest,proto3" json:"is_user_request,omitempty"`
-	// user_identifier is the way we identify the user that added this to the
-	// queue; This can be anything that uniquely identifies a user
-	UserIdentifier string `protobuf:"bytes,3,opt,name=user_identifier,json=userIdentifier,proto3" json:"user_identifier,omitempty"`
-	// expected_start_time is the expected time this song will start playing
-	ExpectedStartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expected_start_time,json=expectedStartTime,proto3" json:"expected_start_time,omitempty"`
-	// unique id of the entry
-	QueueId *QueueID `protobuf:"bytes,5,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	QueueId *QueueID `protobuf:"bytes,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	// position is the index in the queue to move the entry to
+	Position int64 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *QueueEntry) Reset() {
-	*x = QueueEntry{}
+func (x *QueueMove) Reset() {
+	*x = QueueMove{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[14]
+		mi := &file_radio_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueueEntry) String() string {
+func (x *QueueMove) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueueEntry) ProtoMessage() {}
+func (*QueueMove) ProtoMessage() {}
 
-func (x *QueueEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[14]
+func (x *QueueMove) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,43 +1378,228 @@ func (x *QueueEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
-func (*QueueEntry) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use QueueMove.ProtoReflect.Descriptor instead.
+func (*QueueMove) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueueEntry) GetSong() *Song {
+func (x *QueueMove) GetQueueId() *QueueID {
 	if x != nil {
-		return x.Song
+		return x.QueueId
 	}
 	return nil
 }
 
-func (x *QueueEntry) GetIsUserRequest() bool {
+func (x *QueueMove) GetPosition() int64 {
 	if x != nil {
-		return x.IsUserRequest
+		return x.Position
 	}
-	return false
+	return 0
 }
 
-func (x *QueueEntry) GetUserIdentifier() string {
-	if x != nil {
-		return x.UserIdentifier
-	}
-	return ""
+type QueueInsert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Song *Song `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	// position is the index in the queue to insert the song at
+	Position int64 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *QueueEntry) GetExpectedStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpectedStartTime
+func (x *QueueInsert) Reset() {
+	*x = QueueInsert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *QueueEntry) GetQueueId() *QueueID {
-	if x != nil {
-		return x.QueueId
-	}
+func (x *QueueInsert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueInsert) ProtoMessage() {}
+
+func (x *QueueInsert) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueInsert.ProtoReflect.Descriptor instead.
+func (*QueueInsert) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueueInsert) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+func (x *QueueInsert) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type QueueEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Song *Song `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	// is_user_request indicates if this was a request made by a human
+	IsUserRequest bool `protobuf:"varint,2,opt,name=is_user_request,json=isUserRequest,proto3" json:"is_user_request,omitempty"`
+	// user_identifier is the way we identify the user that added this to the
+	// queue; This can be anything that uniquely identifies a user
+	UserIdentifier string `protobuf:"bytes,3,opt,name=user_identifier,json=userIdentifier,proto3" json:"user_identifier,omitempty"`
+	// expected_start_time is the expected time this song will start playing
+	ExpectedStartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expected_start_time,json=expectedStartTime,proto3" json:"expected_start_time,omitempty"`
+	// unique id of the entry
+	QueueId *QueueID `protobuf:"bytes,5,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+}
+
+func (x *QueueEntry) Reset() {
+	*x = QueueEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueEntry) ProtoMessage() {}
+
+func (x *QueueEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
+func (*QueueEntry) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QueueEntry) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+func (x *QueueEntry) GetIsUserRequest() bool {
+	if x != nil {
+		return x.IsUserRequest
+	}
+	return false
+}
+
+func (x *QueueEntry) GetUserIdentifier() string {
+	if x != nil {
+		return x.UserIdentifier
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetExpectedStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedStartTime
+	}
+	return nil
+}
+
+func (x *QueueEntry) GetQueueId() *QueueID {
+	if x != nil {
+		return x.QueueId
+	}
+	return nil
+}
+
+type QueueEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind is the kind of change, see radio.QueueEventKind in the Go package
+	Kind int32 `protobuf:"varint,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// entry is the entry that was changed, if any
+	Entry *QueueEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	// queue is the complete queue after the change
+	Queue []*QueueEntry `protobuf:"bytes,3,rep,name=queue,proto3" json:"queue,omitempty"`
+}
+
+func (x *QueueEvent) Reset() {
+	*x = QueueEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueEvent) ProtoMessage() {}
+
+func (x *QueueEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueEvent.ProtoReflect.Descriptor instead.
+func (*QueueEvent) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueueEvent) GetKind() int32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *QueueEvent) GetEntry() *QueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *QueueEvent) GetQueue() []*QueueEntry {
+	if x != nil {
+		return x.Queue
+	}
 	return nil
 }
 
@@ -1259,7 +1617,7 @@ type QueueInfo struct {
 func (x *QueueInfo) Reset() {
 	*x = QueueInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[15]
+		mi := &file_radio_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1272,7 +1630,7 @@ func (x *QueueInfo) String() string {
 func (*QueueInfo) ProtoMessage() {}
 
 func (x *QueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[15]
+	mi := &file_radio_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,7 +1643,7 @@ func (x *QueueInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueInfo.ProtoReflect.Descriptor instead.
 func (*QueueInfo) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{15}
+	return file_radio_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueueInfo) GetName() string {
@@ -1309,12 +1667,14 @@ type SongRequest struct {
 
 	UserIdentifier string `protobuf:"bytes,1,opt,name=user_identifier,json=userIdentifier,proto3" json:"user_identifier,omitempty"`
 	Song           *Song  `protobuf:"bytes,2,opt,name=song,proto3" json:"song,omitempty"`
+	// staff indicates the user is part of staff
+	Staff bool `protobuf:"varint,3,opt,name=staff,proto3" json:"staff,omitempty"`
 }
 
 func (x *SongRequest) Reset() {
 	*x = SongRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[16]
+		mi := &file_radio_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1327,7 +1687,7 @@ func (x *SongRequest) String() string {
 func (*SongRequest) ProtoMessage() {}
 
 func (x *SongRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[16]
+	mi := &file_radio_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,7 +1700,7 @@ func (x *SongRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SongRequest.ProtoReflect.Descriptor instead.
 func (*SongRequest) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{16}
+	return file_radio_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SongRequest) GetUserIdentifier() string {
@@ -1357,6 +1717,13 @@ func (x *SongRequest) GetSong() *Song {
 	return nil
 }
 
+func (x *SongRequest) GetStaff() bool {
+	if x != nil {
+		return x.Staff
+	}
+	return false
+}
+
 type RequestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1368,7 +1735,7 @@ type RequestResponse struct {
 func (x *RequestResponse) Reset() {
 	*x = RequestResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[17]
+		mi := &file_radio_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1381,7 +1748,7 @@ func (x *RequestResponse) String() string {
 func (*RequestResponse) ProtoMessage() {}
 
 func (x *RequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[17]
+	mi := &file_radio_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1394,7 +1761,7 @@ func (x *RequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestResponse.ProtoReflect.Descriptor instead.
 func (*RequestResponse) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{17}
+	return file_radio_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RequestResponse) GetError() []*Error {
@@ -1421,7 +1788,7 @@ type Error struct {
 func (x *Error) Reset() {
 	*x = Error{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[18]
+		mi := &file_radio_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1434,7 +1801,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[18]
+	mi := &file_radio_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,7 +1814,7 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{18}
+	return file_radio_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Error) GetKind() uint32 {
@@ -1510,7 +1877,7 @@ type TrackerRemoveClientRequest struct {
 func (x *TrackerRemoveClientRequest) Reset() {
 	*x = TrackerRemoveClientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[19]
+		mi := &file_radio_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1523,7 +1890,54 @@ func (x *TrackerRemoveClientRequest) String() string {
 func (*TrackerRemoveClientRequest) ProtoMessage() {}
 
 func (x *TrackerRemoveClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[19]
+	mi := &file_radio_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerRemoveClientRequest.ProtoReflect.Descriptor instead.
+func (*TrackerRemoveClientRequest) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TrackerRemoveClientRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Listeners struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*Listener `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *Listeners) Reset() {
+	*x = Listeners{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listeners) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listeners) ProtoMessage() {}
+
+func (x *Listeners) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,46 +1945,468 @@ func (x *TrackerRemoveClientRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listeners.ProtoReflect.Descriptor instead.
+func (*Listeners) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Listeners) GetEntries() []*Listener {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type Listener struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address   string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	UserAgent string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Start     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+}
+
+func (x *Listener) Reset() {
+	*x = Listener{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listener) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listener) ProtoMessage() {}
+
+func (x *Listener) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listener.ProtoReflect.Descriptor instead.
+func (*Listener) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Listener) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Listener) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Listener) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Listener) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+type ProxyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kind is the kind of change, see radio.ProxyEventKind in the Go package
+	Kind      int32  `protobuf:"varint,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	MountName string `protobuf:"bytes,2,opt,name=mount_name,json=mountName,proto3" json:"mount_name,omitempty"`
+	// source is the source that changed, if any
+	Source   *ProxySource `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Metadata string       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// sources is every source connected after the change
+	Sources []*ProxySource `protobuf:"bytes,5,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *ProxyEvent) Reset() {
+	*x = ProxyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyEvent) ProtoMessage() {}
+
+func (x *ProxyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyEvent.ProtoReflect.Descriptor instead.
+func (*ProxyEvent) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProxyEvent) GetKind() int32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *ProxyEvent) GetMountName() string {
+	if x != nil {
+		return x.MountName
+	}
+	return ""
+}
+
+func (x *ProxyEvent) GetSource() *ProxySource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *ProxyEvent) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *ProxyEvent) GetSources() []*ProxySource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type ProxySourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProxySourceRequest) Reset() {
+	*x = ProxySourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxySourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxySourceRequest) ProtoMessage() {}
+
+func (x *ProxySourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxySourceRequest.ProtoReflect.Descriptor instead.
+func (*ProxySourceRequest) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProxySourceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ProxyPrioritiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MountName string `protobuf:"bytes,1,opt,name=mount_name,json=mountName,proto3" json:"mount_name,omitempty"`
+	// ids of the sources in their new order
+	Order []string `protobuf:"bytes,2,rep,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *ProxyPrioritiesRequest) Reset() {
+	*x = ProxyPrioritiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyPrioritiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyPrioritiesRequest) ProtoMessage() {}
+
+func (x *ProxyPrioritiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyPrioritiesRequest.ProtoReflect.Descriptor instead.
+func (*ProxyPrioritiesRequest) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ProxyPrioritiesRequest) GetMountName() string {
+	if x != nil {
+		return x.MountName
+	}
+	return ""
+}
+
+func (x *ProxyPrioritiesRequest) GetOrder() []string {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ProxySources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*ProxySource `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ProxySources) Reset() {
+	*x = ProxySources{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxySources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxySources) ProtoMessage() {}
+
+func (x *ProxySources) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxySources.ProtoReflect.Descriptor instead.
+func (*ProxySources) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProxySources) GetEntries() []*ProxySource {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ProxySource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MountName     string                 `protobuf:"bytes,2,opt,name=mount_name,json=mountName,proto3" json:"mount_name,omitempty"`
+	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Priority      uint64                 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	Live          bool                   `protobuf:"varint,7,opt,name=live,proto3" json:"live,omitempty"`
+	Metadata      string                 `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start,proto3" json:"start,omitempty"`
+	BytesReceived uint64                 `protobuf:"varint,10,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+}
+
+func (x *ProxySource) Reset() {
+	*x = ProxySource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_radio_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxySource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxySource) ProtoMessage() {}
+
+func (x *ProxySource) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxySource.ProtoReflect.Descriptor instead.
+func (*ProxySource) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProxySource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProxySource) GetMountName() string {
+	if x != nil {
+		return x.MountName
+	}
+	return ""
+}
+
+func (x *ProxySource) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ProxySource) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ProxySource) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ProxySource) GetPriority() uint64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ProxySource) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
 }
 
-// Deprecated: Use TrackerRemoveClientRequest.ProtoReflect.Descriptor instead.
-func (*TrackerRemoveClientRequest) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{19}
+func (x *ProxySource) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
 }
 
-func (x *TrackerRemoveClientRequest) GetId() uint64 {
+func (x *ProxySource) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ProxySource) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
 	}
 	return 0
 }
 
-type Listeners struct {
+type ProxyUpstreams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*Listener `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Entries []*ProxyUpstream `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *Listeners) Reset() {
-	*x = Listeners{}
+func (x *ProxyUpstreams) Reset() {
+	*x = ProxyUpstreams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[20]
+		mi := &file_radio_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Listeners) String() string {
+func (x *ProxyUpstreams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Listeners) ProtoMessage() {}
+func (*ProxyUpstreams) ProtoMessage() {}
 
-func (x *Listeners) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[20]
+func (x *ProxyUpstreams) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1581,46 +2417,51 @@ func (x *Listeners) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Listeners.ProtoReflect.Descriptor instead.
-func (*Listeners) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ProxyUpstreams.ProtoReflect.Descriptor instead.
+func (*ProxyUpstreams) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Listeners) GetEntries() []*Listener {
+func (x *ProxyUpstreams) GetEntries() []*ProxyUpstream {
 	if x != nil {
 		return x.Entries
 	}
 	return nil
 }
 
-type Listener struct {
+type ProxyUpstream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address   string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	UserAgent string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	Start     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	MountName string `protobuf:"bytes,1,opt,name=mount_name,json=mountName,proto3" json:"mount_name,omitempty"`
+	// url of the upstream without credentials
+	Url       string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Connected bool   `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
+	// since is when the upstream last connected or disconnected
+	Since        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Error        string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	BytesSent    uint64                 `protobuf:"varint,6,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesDropped uint64                 `protobuf:"varint,7,opt,name=bytes_dropped,json=bytesDropped,proto3" json:"bytes_dropped,omitempty"`
 }
 
-func (x *Listener) Reset() {
-	*x = Listener{}
+func (x *ProxyUpstream) Reset() {
+	*x = ProxyUpstream{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_radio_proto_msgTypes[21]
+		mi := &file_radio_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Listener) String() string {
+func (x *ProxyUpstream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Listener) ProtoMessage() {}
+func (*ProxyUpstream) ProtoMessage() {}
 
-func (x *Listener) ProtoReflect() protoreflect.Message {
-	mi := &file_radio_proto_msgTypes[21]
+func (x *ProxyUpstream) ProtoReflect() protoreflect.Message {
+	mi := &file_radio_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1631,39 +2472,60 @@ func (x *Listener) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Listener.ProtoReflect.Descriptor instead.
-func (*Listener) Descriptor() ([]byte, []int) {
-	return file_radio_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ProxyUpstream.ProtoReflect.Descriptor instead.
+func (*ProxyUpstream) Descriptor() ([]byte, []int) {
+	return file_radio_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Listener) GetId() uint64 {
+func (x *ProxyUpstream) GetMountName() string {
 	if x != nil {
-		return x.Id
+		return x.MountName
 	}
-	return 0
+	return ""
 }
 
-func (x *Listener) GetAddress() string {
+func (x *ProxyUpstream) GetUrl() string {
 	if x != nil {
-		return x.Address
+		return x.Url
 	}
 	return ""
 }
 
-func (x *Listener) GetUserAgent() string {
+func (x *ProxyUpstream) GetConnected() bool {
 	if x != nil {
-		return x.UserAgent
+		return x.Connected
 	}
-	return ""
+	return false
 }
 
-func (x *Listener) GetStart() *timestamppb.Timestamp {
+func (x *ProxyUpstream) GetSince() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Start
+		return x.Since
 	}
 	return nil
 }
 
+func (x *ProxyUpstream) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProxyUpstream) GetBytesSent() uint64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *ProxyUpstream) GetBytesDropped() uint64 {
+	if x != nil {
+		return x.BytesDropped
+	}
+	return 0
+}
+
 var File_radio_proto protoreflect.FileDescriptor
 
 var file_radio_proto_rawDesc = []byte{
@@ -1676,7 +2538,7 @@ var file_radio_proto_rawDesc = []byte{
 	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x93, 0x06, 0x0a, 0x04, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x74, 0x6f, 0x22, 0xa6, 0x07, 0x0a, 0x04, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68,
 	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
 	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
@@ -1722,10 +2584,39 @@ var file_radio_proto_rawDesc = []byte{
 	0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12,
-	0x37, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x64, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
-	0x73, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xae, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x61,
+	0x2b, 0x0a, 0x08, 0x6c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65,
+	0x73, 0x73, 0x52, 0x08, 0x6c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x06,
+	0x63, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x75, 0x65, 0x49, 0x6e, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x75, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x75, 0x65, 0x4f,
+	0x75, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0c,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x22, 0x93, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x70, 0x65,
+	0x61, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x72, 0x75, 0x65, 0x50, 0x65,
+	0x61, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xae, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75,
 	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69,
 	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04,
@@ -1839,7 +2730,17 @@ var file_radio_proto_rawDesc = []byte{
 	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
 	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x22, 0x19, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0xf5, 0x01,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x52, 0x0a,
+	0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72,
+	0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44, 0x52, 0x07, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x4a, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x12, 0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e,
+	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf5, 0x01,
 	0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x04,
 	0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64,
 	0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x12, 0x26, 0x0a,
@@ -1855,128 +2756,224 @@ var file_radio_proto_rawDesc = []byte{
 	0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x71, 0x75,
 	0x65, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72,
 	0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44, 0x52, 0x07, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x0b, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x73,
-	0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69,
-	0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x22, 0x35, 0x0a, 0x0f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x22, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f,
-	0x70, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0x2c, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36,
-	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72,
-	0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x07, 0x65,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x32, 0xd3,
-	0x04, 0x0a, 0x07, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0d, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0b,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53,
-	0x6f, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x65, 0x75, 0x65, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x22, 0x4c, 0x0a, 0x09, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61,
+	0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x6d, 0x0a, 0x0b, 0x53, 0x6f, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x1f, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x66, 0x66, 0x22, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xba, 0x01,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6f,
+	0x6e, 0x67, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12,
+	0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2c, 0x0a, 0x1a, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x85, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x64,
+	0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x22, 0x24, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0xc4, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x40, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xea, 0x01, 0x0a,
+	0x0d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x53, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x32, 0x8c, 0x05, 0x0a, 0x07, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e,
+	0x67, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0d,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30,
+	0x01, 0x12, 0x31, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x14, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x37, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x47, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x0c, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x34, 0x0a, 0x0b, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x79, 0x1a, 0x13, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x32, 0xde, 0x01, 0x0a, 0x09, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53,
+	0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x0b, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x14, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x32, 0x97, 0x01, 0x0a, 0x09, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
-	0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x53, 0x6f,
-	0x6e, 0x67, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x41,
-	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x61, 0x64,
+	0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x45, 0x0a, 0x0d, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x66, 0x66, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xe4, 0x02, 0x0a, 0x08, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x04, 0x53, 0x6b, 0x69, 0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e,
+	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x61, 0x64, 0x69,
+	0x6f, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a,
+	0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x32, 0xc1, 0x03, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x0f, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53,
-	0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xab,
-	0x02, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x05, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x72,
-	0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x17, 0x2e, 0x72, 0x61, 0x64, 0x69,
-	0x6f, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x6f, 0x6e,
-	0x67, 0x12, 0x12, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
-	0x09, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15, 0x2e, 0x72, 0x61, 0x64,
-	0x69, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x72, 0x61, 0x64,
-	0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0xe5, 0x01, 0x0a,
-	0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x38, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x16,
+	0x70, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x65,
+	0x78, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x72, 0x61, 0x64,
+	0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x34, 0x0a,
+	0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x44, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x12, 0x0e, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x49, 0x44, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x33, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x32, 0x95, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65,
+	0x12, 0x10, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4d, 0x6f,
+	0x76, 0x65, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x36,
+	0x0a, 0x08, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x74, 0x12, 0x12, 0x2e, 0x72, 0x61, 0x64,
+	0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x12, 0x0e, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49,
+	0x44, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x35, 0x0a,
+	0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x11, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x30, 0x01, 0x32, 0x95, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
 	0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
 	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
@@ -1985,10 +2982,34 @@ var file_radio_proto_rawDesc = []byte{
 	0x74, 0x12, 0x21, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
 	0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x21, 0x5a, 0x1f,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52, 0x2d, 0x61, 0x2d, 0x64,
-	0x69, 0x6f, 0x2f, 0x76, 0x61, 0x6c, 0x6b, 0x79, 0x72, 0x69, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x81, 0x03, 0x0a,
+	0x05, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e,
+	0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x72, 0x61,
+	0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x4b, 0x69, 0x63, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x19, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x19,
+	0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x46, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x1d, 0x2e, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x72, 0x61,
+	0x64, 0x69, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x52,
+	0x2d, 0x61, 0x2d, 0x64, 0x69, 0x6f, 0x2f, 0x76, 0x61, 0x6c, 0x6b, 0x79, 0x72, 0x69, 0x65, 0x2f,
+	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2003,122 +3024,177 @@ func file_radio_proto_rawDescGZIP() []byte {
 	return file_radio_proto_rawDescData
 }
 
-var file_radio_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_radio_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_radio_proto_goTypes = []interface{}{
 	(*Song)(nil),                       // 0: radio.Song
-	(*StatusResponse)(nil),             // 1: radio.StatusResponse
-	(*SongUpdate)(nil),                 // 2: radio.SongUpdate
-	(*SongInfo)(nil),                   // 3: radio.SongInfo
-	(*StreamerConfig)(nil),             // 4: radio.StreamerConfig
-	(*UserUpdate)(nil),                 // 5: radio.UserUpdate
-	(*User)(nil),                       // 6: radio.User
-	(*DJ)(nil),                         // 7: radio.DJ
-	(*Theme)(nil),                      // 8: radio.Theme
-	(*ListenerInfo)(nil),               // 9: radio.ListenerInfo
-	(*SongAnnouncement)(nil),           // 10: radio.SongAnnouncement
-	(*SongRequestAnnouncement)(nil),    // 11: radio.SongRequestAnnouncement
-	(*StreamerResponse)(nil),           // 12: radio.StreamerResponse
-	(*QueueID)(nil),                    // 13: radio.QueueID
-	(*QueueEntry)(nil),                 // 14: radio.QueueEntry
-	(*QueueInfo)(nil),                  // 15: radio.QueueInfo
-	(*SongRequest)(nil),                // 16: radio.SongRequest
-	(*RequestResponse)(nil),            // 17: radio.RequestResponse
-	(*Error)(nil),                      // 18: radio.Error
-	(*TrackerRemoveClientRequest)(nil), // 19: radio.TrackerRemoveClientRequest
-	(*Listeners)(nil),                  // 20: radio.Listeners
-	(*Listener)(nil),                   // 21: radio.Listener
-	(*durationpb.Duration)(nil),        // 22: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),      // 23: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),              // 24: google.protobuf.Empty
-	(*wrapperspb.StringValue)(nil),     // 25: google.protobuf.StringValue
-	(*wrapperspb.Int64Value)(nil),      // 26: google.protobuf.Int64Value
-	(*wrapperspb.BoolValue)(nil),       // 27: google.protobuf.BoolValue
+	(*ManagerEvent)(nil),               // 1: radio.ManagerEvent
+	(*Loudness)(nil),                   // 2: radio.Loudness
+	(*StatusResponse)(nil),             // 3: radio.StatusResponse
+	(*SongUpdate)(nil),                 // 4: radio.SongUpdate
+	(*SongInfo)(nil),                   // 5: radio.SongInfo
+	(*StreamerConfig)(nil),             // 6: radio.StreamerConfig
+	(*UserUpdate)(nil),                 // 7: radio.UserUpdate
+	(*User)(nil),                       // 8: radio.User
+	(*DJ)(nil),                         // 9: radio.DJ
+	(*Theme)(nil),                      // 10: radio.Theme
+	(*ListenerInfo)(nil),               // 11: radio.ListenerInfo
+	(*SongAnnouncement)(nil),           // 12: radio.SongAnnouncement
+	(*SongRequestAnnouncement)(nil),    // 13: radio.SongRequestAnnouncement
+	(*StreamerResponse)(nil),           // 14: radio.StreamerResponse
+	(*QueueID)(nil),                    // 15: radio.QueueID
+	(*QueueMove)(nil),                  // 16: radio.QueueMove
+	(*QueueInsert)(nil),                // 17: radio.QueueInsert
+	(*QueueEntry)(nil),                 // 18: radio.QueueEntry
+	(*QueueEvent)(nil),                 // 19: radio.QueueEvent
+	(*QueueInfo)(nil),                  // 20: radio.QueueInfo
+	(*SongRequest)(nil),                // 21: radio.SongRequest
+	(*RequestResponse)(nil),            // 22: radio.RequestResponse
+	(*Error)(nil),                      // 23: radio.Error
+	(*TrackerRemoveClientRequest)(nil), // 24: radio.TrackerRemoveClientRequest
+	(*Listeners)(nil),                  // 25: radio.Listeners
+	(*Listener)(nil),                   // 26: radio.Listener
+	(*ProxyEvent)(nil),                 // 27: radio.ProxyEvent
+	(*ProxySourceRequest)(nil),         // 28: radio.ProxySourceRequest
+	(*ProxyPrioritiesRequest)(nil),     // 29: radio.ProxyPrioritiesRequest
+	(*ProxySources)(nil),               // 30: radio.ProxySources
+	(*ProxySource)(nil),                // 31: radio.ProxySource
+	(*ProxyUpstreams)(nil),             // 32: radio.ProxyUpstreams
+	(*ProxyUpstream)(nil),              // 33: radio.ProxyUpstream
+	(*durationpb.Duration)(nil),        // 34: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),      // 35: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 36: google.protobuf.Empty
+	(*wrapperspb.StringValue)(nil),     // 37: google.protobuf.StringValue
+	(*wrapperspb.Int64Value)(nil),      // 38: google.protobuf.Int64Value
+	(*wrapperspb.BoolValue)(nil),       // 39: google.protobuf.BoolValue
 }
 var file_radio_proto_depIdxs = []int32{
-	22, // 0: radio.Song.length:type_name -> google.protobuf.Duration
-	23, // 1: radio.Song.last_played:type_name -> google.protobuf.Timestamp
-	6,  // 2: radio.Song.last_played_by:type_name -> radio.User
-	23, // 3: radio.Song.last_requested:type_name -> google.protobuf.Timestamp
-	22, // 4: radio.Song.request_delay:type_name -> google.protobuf.Duration
-	23, // 5: radio.Song.sync_time:type_name -> google.protobuf.Timestamp
-	6,  // 6: radio.StatusResponse.user:type_name -> radio.User
-	0,  // 7: radio.StatusResponse.song:type_name -> radio.Song
-	3,  // 8: radio.StatusResponse.info:type_name -> radio.SongInfo
-	9,  // 9: radio.StatusResponse.listener_info:type_name -> radio.ListenerInfo
-	4,  // 10: radio.StatusResponse.streamer_config:type_name -> radio.StreamerConfig
-	0,  // 11: radio.SongUpdate.song:type_name -> radio.Song
-	3,  // 12: radio.SongUpdate.info:type_name -> radio.SongInfo
-	23, // 13: radio.SongInfo.start_time:type_name -> google.protobuf.Timestamp
-	23, // 14: radio.SongInfo.end_time:type_name -> google.protobuf.Timestamp
-	6,  // 15: radio.UserUpdate.user:type_name -> radio.User
-	23, // 16: radio.User.updated_at:type_name -> google.protobuf.Timestamp
-	23, // 17: radio.User.deleted_at:type_name -> google.protobuf.Timestamp
-	23, // 18: radio.User.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 19: radio.User.dj:type_name -> radio.DJ
-	8,  // 20: radio.DJ.theme:type_name -> radio.Theme
-	0,  // 21: radio.SongAnnouncement.song:type_name -> radio.Song
-	3,  // 22: radio.SongAnnouncement.info:type_name -> radio.SongInfo
-	9,  // 23: radio.SongAnnouncement.listener_info:type_name -> radio.ListenerInfo
-	0,  // 24: radio.SongRequestAnnouncement.song:type_name -> radio.Song
-	18, // 25: radio.StreamerResponse.error:type_name -> radio.Error
-	0,  // 26: radio.QueueEntry.song:type_name -> radio.Song
-	23, // 27: radio.QueueEntry.expected_start_time:type_name -> google.protobuf.Timestamp
-	13, // 28: radio.QueueEntry.queue_id:type_name -> radio.QueueID
-	14, // 29: radio.QueueInfo.entries:type_name -> radio.QueueEntry
-	0,  // 30: radio.SongRequest.song:type_name -> radio.Song
-	18, // 31: radio.RequestResponse.error:type_name -> radio.Error
-	22, // 32: radio.Error.delay:type_name -> google.protobuf.Duration
-	21, // 33: radio.Listeners.entries:type_name -> radio.Listener
-	23, // 34: radio.Listener.start:type_name -> google.protobuf.Timestamp
-	24, // 35: radio.Manager.CurrentStatus:input_type -> google.protobuf.Empty
-	24, // 36: radio.Manager.CurrentSong:input_type -> google.protobuf.Empty
-	2,  // 37: radio.Manager.UpdateSong:input_type -> radio.SongUpdate
-	24, // 38: radio.Manager.CurrentThread:input_type -> google.protobuf.Empty
-	25, // 39: radio.Manager.UpdateThread:input_type -> google.protobuf.StringValue
-	24, // 40: radio.Manager.CurrentUser:input_type -> google.protobuf.Empty
-	6,  // 41: radio.Manager.UpdateUser:input_type -> radio.User
-	24, // 42: radio.Manager.CurrentListenerCount:input_type -> google.protobuf.Empty
-	26, // 43: radio.Manager.UpdateListenerCount:input_type -> google.protobuf.Int64Value
-	10, // 44: radio.Announcer.AnnounceSong:input_type -> radio.SongAnnouncement
-	11, // 45: radio.Announcer.AnnounceRequest:input_type -> radio.SongRequestAnnouncement
-	24, // 46: radio.Streamer.Start:input_type -> google.protobuf.Empty
-	27, // 47: radio.Streamer.Stop:input_type -> google.protobuf.BoolValue
-	16, // 48: radio.Streamer.RequestSong:input_type -> radio.SongRequest
-	4,  // 49: radio.Streamer.SetConfig:input_type -> radio.StreamerConfig
-	24, // 50: radio.Streamer.Queue:input_type -> google.protobuf.Empty
-	14, // 51: radio.Queue.AddRequest:input_type -> radio.QueueEntry
-	24, // 52: radio.Queue.ReserveNext:input_type -> google.protobuf.Empty
-	13, // 53: radio.Queue.Remove:input_type -> radio.QueueID
-	24, // 54: radio.Queue.Entries:input_type -> google.protobuf.Empty
-	24, // 55: radio.ListenerTracker.ListClients:input_type -> google.protobuf.Empty
-	19, // 56: radio.ListenerTracker.RemoveClient:input_type -> radio.TrackerRemoveClientRequest
-	1,  // 57: radio.Manager.CurrentStatus:output_type -> radio.StatusResponse
-	2,  // 58: radio.Manager.CurrentSong:output_type -> radio.SongUpdate
-	24, // 59: radio.Manager.UpdateSong:output_type -> google.protobuf.Empty
-	25, // 60: radio.Manager.CurrentThread:output_type -> google.protobuf.StringValue
-	24, // 61: radio.Manager.UpdateThread:output_type -> google.protobuf.Empty
-	6,  // 62: radio.Manager.CurrentUser:output_type -> radio.User
-	24, // 63: radio.Manager.UpdateUser:output_type -> google.protobuf.Empty
-	26, // 64: radio.Manager.CurrentListenerCount:output_type -> google.protobuf.Int64Value
-	24, // 65: radio.Manager.UpdateListenerCount:output_type -> google.protobuf.Empty
-	24, // 66: radio.Announcer.AnnounceSong:output_type -> google.protobuf.Empty
-	24, // 67: radio.Announcer.AnnounceRequest:output_type -> google.protobuf.Empty
-	12, // 68: radio.Streamer.Start:output_type -> radio.StreamerResponse
-	12, // 69: radio.Streamer.Stop:output_type -> radio.StreamerResponse
-	17, // 70: radio.Streamer.RequestSong:output_type -> radio.RequestResponse
-	24, // 71: radio.Streamer.SetConfig:output_type -> google.protobuf.Empty
-	15, // 72: radio.Streamer.Queue:output_type -> radio.QueueInfo
-	24, // 73: radio.Queue.AddRequest:output_type -> google.protobuf.Empty
-	14, // 74: radio.Queue.ReserveNext:output_type -> radio.QueueEntry
-	27, // 75: radio.Queue.Remove:output_type -> google.protobuf.BoolValue
-	15, // 76: radio.Queue.Entries:output_type -> radio.QueueInfo
-	20, // 77: radio.ListenerTracker.ListClients:output_type -> radio.Listeners
-	24, // 78: radio.ListenerTracker.RemoveClient:output_type -> google.protobuf.Empty
-	57, // [57:79] is the sub-list for method output_type
-	35, // [35:57] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	34, // 0: radio.Song.length:type_name -> google.protobuf.Duration
+	35, // 1: radio.Song.last_played:type_name -> google.protobuf.Timestamp
+	8,  // 2: radio.Song.last_played_by:type_name -> radio.User
+	35, // 3: radio.Song.last_requested:type_name -> google.protobuf.Timestamp
+	34, // 4: radio.Song.request_delay:type_name -> google.protobuf.Duration
+	2,  // 5: radio.Song.loudness:type_name -> radio.Loudness
+	34, // 6: radio.Song.cue_in:type_name -> google.protobuf.Duration
+	34, // 7: radio.Song.cue_out:type_name -> google.protobuf.Duration
+	35, // 8: radio.Song.sync_time:type_name -> google.protobuf.Timestamp
+	8,  // 9: radio.ManagerEvent.user:type_name -> radio.User
+	35, // 10: radio.ManagerEvent.time:type_name -> google.protobuf.Timestamp
+	34, // 11: radio.ManagerEvent.delay:type_name -> google.protobuf.Duration
+	8,  // 12: radio.StatusResponse.user:type_name -> radio.User
+	0,  // 13: radio.StatusResponse.song:type_name -> radio.Song
+	5,  // 14: radio.StatusResponse.info:type_name -> radio.SongInfo
+	11, // 15: radio.StatusResponse.listener_info:type_name -> radio.ListenerInfo
+	6,  // 16: radio.StatusResponse.streamer_config:type_name -> radio.StreamerConfig
+	0,  // 17: radio.SongUpdate.song:type_name -> radio.Song
+	5,  // 18: radio.SongUpdate.info:type_name -> radio.SongInfo
+	35, // 19: radio.SongInfo.start_time:type_name -> google.protobuf.Timestamp
+	35, // 20: radio.SongInfo.end_time:type_name -> google.protobuf.Timestamp
+	8,  // 21: radio.UserUpdate.user:type_name -> radio.User
+	35, // 22: radio.User.updated_at:type_name -> google.protobuf.Timestamp
+	35, // 23: radio.User.deleted_at:type_name -> google.protobuf.Timestamp
+	35, // 24: radio.User.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 25: radio.User.dj:type_name -> radio.DJ
+	10, // 26: radio.DJ.theme:type_name -> radio.Theme
+	0,  // 27: radio.SongAnnouncement.song:type_name -> radio.Song
+	5,  // 28: radio.SongAnnouncement.info:type_name -> radio.SongInfo
+	11, // 29: radio.SongAnnouncement.listener_info:type_name -> radio.ListenerInfo
+	0,  // 30: radio.SongRequestAnnouncement.song:type_name -> radio.Song
+	23, // 31: radio.StreamerResponse.error:type_name -> radio.Error
+	15, // 32: radio.QueueMove.queue_id:type_name -> radio.QueueID
+	0,  // 33: radio.QueueInsert.song:type_name -> radio.Song
+	0,  // 34: radio.QueueEntry.song:type_name -> radio.Song
+	35, // 35: radio.QueueEntry.expected_start_time:type_name -> google.protobuf.Timestamp
+	15, // 36: radio.QueueEntry.queue_id:type_name -> radio.QueueID
+	18, // 37: radio.QueueEvent.entry:type_name -> radio.QueueEntry
+	18, // 38: radio.QueueEvent.queue:type_name -> radio.QueueEntry
+	18, // 39: radio.QueueInfo.entries:type_name -> radio.QueueEntry
+	0,  // 40: radio.SongRequest.song:type_name -> radio.Song
+	23, // 41: radio.RequestResponse.error:type_name -> radio.Error
+	34, // 42: radio.Error.delay:type_name -> google.protobuf.Duration
+	26, // 43: radio.Listeners.entries:type_name -> radio.Listener
+	35, // 44: radio.Listener.start:type_name -> google.protobuf.Timestamp
+	31, // 45: radio.ProxyEvent.source:type_name -> radio.ProxySource
+	31, // 46: radio.ProxyEvent.sources:type_name -> radio.ProxySource
+	31, // 47: radio.ProxySources.entries:type_name -> radio.ProxySource
+	8,  // 48: radio.ProxySource.user:type_name -> radio.User
+	35, // 49: radio.ProxySource.start:type_name -> google.protobuf.Timestamp
+	33, // 50: radio.ProxyUpstreams.entries:type_name -> radio.ProxyUpstream
+	35, // 51: radio.ProxyUpstream.since:type_name -> google.protobuf.Timestamp
+	36, // 52: radio.Manager.CurrentStatus:input_type -> google.protobuf.Empty
+	36, // 53: radio.Manager.CurrentSong:input_type -> google.protobuf.Empty
+	4,  // 54: radio.Manager.UpdateSong:input_type -> radio.SongUpdate
+	36, // 55: radio.Manager.CurrentThread:input_type -> google.protobuf.Empty
+	37, // 56: radio.Manager.UpdateThread:input_type -> google.protobuf.StringValue
+	36, // 57: radio.Manager.CurrentUser:input_type -> google.protobuf.Empty
+	8,  // 58: radio.Manager.UpdateUser:input_type -> radio.User
+	36, // 59: radio.Manager.CurrentListenerCount:input_type -> google.protobuf.Empty
+	38, // 60: radio.Manager.UpdateListenerCount:input_type -> google.protobuf.Int64Value
+	36, // 61: radio.Manager.Events:input_type -> google.protobuf.Empty
+	12, // 62: radio.Announcer.AnnounceSong:input_type -> radio.SongAnnouncement
+	13, // 63: radio.Announcer.AnnounceRequest:input_type -> radio.SongRequestAnnouncement
+	37, // 64: radio.Announcer.AnnounceStaff:input_type -> google.protobuf.StringValue
+	36, // 65: radio.Streamer.Start:input_type -> google.protobuf.Empty
+	39, // 66: radio.Streamer.Stop:input_type -> google.protobuf.BoolValue
+	36, // 67: radio.Streamer.Skip:input_type -> google.protobuf.Empty
+	21, // 68: radio.Streamer.RequestSong:input_type -> radio.SongRequest
+	6,  // 69: radio.Streamer.SetConfig:input_type -> radio.StreamerConfig
+	36, // 70: radio.Streamer.Queue:input_type -> google.protobuf.Empty
+	18, // 71: radio.Queue.AddRequest:input_type -> radio.QueueEntry
+	36, // 72: radio.Queue.ReserveNext:input_type -> google.protobuf.Empty
+	15, // 73: radio.Queue.Remove:input_type -> radio.QueueID
+	36, // 74: radio.Queue.Entries:input_type -> google.protobuf.Empty
+	16, // 75: radio.Queue.Move:input_type -> radio.QueueMove
+	17, // 76: radio.Queue.InsertAt:input_type -> radio.QueueInsert
+	15, // 77: radio.Queue.Promote:input_type -> radio.QueueID
+	36, // 78: radio.Queue.Events:input_type -> google.protobuf.Empty
+	36, // 79: radio.ListenerTracker.ListClients:input_type -> google.protobuf.Empty
+	24, // 80: radio.ListenerTracker.RemoveClient:input_type -> radio.TrackerRemoveClientRequest
+	36, // 81: radio.Proxy.ListSources:input_type -> google.protobuf.Empty
+	36, // 82: radio.Proxy.ListUpstreams:input_type -> google.protobuf.Empty
+	28, // 83: radio.Proxy.KickSource:input_type -> radio.ProxySourceRequest
+	28, // 84: radio.Proxy.SetLive:input_type -> radio.ProxySourceRequest
+	29, // 85: radio.Proxy.SetPriorities:input_type -> radio.ProxyPrioritiesRequest
+	36, // 86: radio.Proxy.Events:input_type -> google.protobuf.Empty
+	3,  // 87: radio.Manager.CurrentStatus:output_type -> radio.StatusResponse
+	4,  // 88: radio.Manager.CurrentSong:output_type -> radio.SongUpdate
+	36, // 89: radio.Manager.UpdateSong:output_type -> google.protobuf.Empty
+	37, // 90: radio.Manager.CurrentThread:output_type -> google.protobuf.StringValue
+	36, // 91: radio.Manager.UpdateThread:output_type -> google.protobuf.Empty
+	8,  // 92: radio.Manager.CurrentUser:output_type -> radio.User
+	36, // 93: radio.Manager.UpdateUser:output_type -> google.protobuf.Empty
+	38, // 94: radio.Manager.CurrentListenerCount:output_type -> google.protobuf.Int64Value
+	36, // 95: radio.Manager.UpdateListenerCount:output_type -> google.protobuf.Empty
+	1,  // 96: radio.Manager.Events:output_type -> radio.ManagerEvent
+	36, // 97: radio.Announcer.AnnounceSong:output_type -> google.protobuf.Empty
+	36, // 98: radio.Announcer.AnnounceRequest:output_type -> google.protobuf.Empty
+	36, // 99: radio.Announcer.AnnounceStaff:output_type -> google.protobuf.Empty
+	14, // 100: radio.Streamer.Start:output_type -> radio.StreamerResponse
+	14, // 101: radio.Streamer.Stop:output_type -> radio.StreamerResponse
+	14, // 102: radio.Streamer.Skip:output_type -> radio.StreamerResponse
+	22, // 103: radio.Streamer.RequestSong:output_type -> radio.RequestResponse
+	36, // 104: radio.Streamer.SetConfig:output_type -> google.protobuf.Empty
+	20, // 105: radio.Streamer.Queue:output_type -> radio.QueueInfo
+	36, // 106: radio.Queue.AddRequest:output_type -> google.protobuf.Empty
+	18, // 107: radio.Queue.ReserveNext:output_type -> radio.QueueEntry
+	39, // 108: radio.Queue.Remove:output_type -> google.protobuf.BoolValue
+	20, // 109: radio.Queue.Entries:output_type -> radio.QueueInfo
+	39, // 110: radio.Queue.Move:output_type -> google.protobuf.BoolValue
+	36, // 111: radio.Queue.InsertAt:output_type -> google.protobuf.Empty
+	39, // 112: radio.Queue.Promote:output_type -> google.protobuf.BoolValue
+	19, // 113: radio.Queue.Events:output_type -> radio.QueueEvent
+	25, // 114: radio.ListenerTracker.ListClients:output_type -> radio.Listeners
+	36, // 115: radio.ListenerTracker.RemoveClient:output_type -> google.protobuf.Empty
+	30, // 116: radio.Proxy.ListSources:output_type -> radio.ProxySources
+	32, // 117: radio.Proxy.ListUpstreams:output_type -> radio.ProxyUpstreams
+	36, // 118: radio.Proxy.KickSource:output_type -> google.protobuf.Empty
+	36, // 119: radio.Proxy.SetLive:output_type -> google.protobuf.Empty
+	36, // 120: radio.Proxy.SetPriorities:output_type -> google.protobuf.Empty
+	27, // 121: radio.Proxy.Events:output_type -> radio.ProxyEvent
+	87, // [87:122] is the sub-list for method output_type
+	52, // [52:87] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_radio_proto_init() }
@@ -2140,7 +3216,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
+			switch v := v.(*ManagerEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2152,7 +3228,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SongUpdate); i {
+			switch v := v.(*Loudness); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2164,7 +3240,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SongInfo); i {
+			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2176,7 +3252,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamerConfig); i {
+			switch v := v.(*SongUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2188,7 +3264,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserUpdate); i {
+			switch v := v.(*SongInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2200,7 +3276,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+			switch v := v.(*StreamerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2212,7 +3288,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DJ); i {
+			switch v := v.(*UserUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2224,7 +3300,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Theme); i {
+			switch v := v.(*User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2236,7 +3312,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListenerInfo); i {
+			switch v := v.(*DJ); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2248,7 +3324,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SongAnnouncement); i {
+			switch v := v.(*Theme); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2260,7 +3336,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SongRequestAnnouncement); i {
+			switch v := v.(*ListenerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2272,7 +3348,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamerResponse); i {
+			switch v := v.(*SongAnnouncement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2284,7 +3360,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueueID); i {
+			switch v := v.(*SongRequestAnnouncement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2296,7 +3372,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueueEntry); i {
+			switch v := v.(*StreamerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2308,7 +3384,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueueInfo); i {
+			switch v := v.(*QueueID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2320,7 +3396,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SongRequest); i {
+			switch v := v.(*QueueMove); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2332,7 +3408,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestResponse); i {
+			switch v := v.(*QueueInsert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2344,7 +3420,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Error); i {
+			switch v := v.(*QueueEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2356,7 +3432,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrackerRemoveClientRequest); i {
+			switch v := v.(*QueueEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2368,7 +3444,7 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Listeners); i {
+			switch v := v.(*QueueInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2380,6 +3456,66 @@ func file_radio_proto_init() {
 			}
 		}
 		file_radio_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SongRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerRemoveClientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Listeners); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Listener); i {
 			case 0:
 				return &v.state
@@ -2391,6 +3527,90 @@ func file_radio_proto_init() {
 				return nil
 			}
 		}
+		file_radio_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxySourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyPrioritiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxySources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxySource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyUpstreams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_radio_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyUpstream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2398,9 +3618,9 @@ func file_radio_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_radio_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   34,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   6,
 		},
 		GoTypes:           file_radio_proto_goTypes,
 		DependencyIndexes: file_radio_proto_depIdxs,
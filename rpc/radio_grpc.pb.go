@@ -30,6 +30,7 @@ const (
 	Manager_UpdateUser_FullMethodName           = "/radio.Manager/UpdateUser"
 	Manager_CurrentListenerCount_FullMethodName = "/radio.Manager/CurrentListenerCount"
 	Manager_UpdateListenerCount_FullMethodName  = "/radio.Manager/UpdateListenerCount"
+	Manager_Events_FullMethodName               = "/radio.Manager/Events"
 )
 
 // ManagerClient is the client API for Manager service.
@@ -45,6 +46,7 @@ type ManagerClient interface {
 	UpdateUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CurrentListenerCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Manager_CurrentListenerCountClient, error)
 	UpdateListenerCount(ctx context.Context, in *wrapperspb.Int64Value, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Events(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Manager_EventsClient, error)
 }
 
 type managerClient struct {
@@ -251,6 +253,38 @@ func (c *managerClient) UpdateListenerCount(ctx context.Context, in *wrapperspb.
 	return out, nil
 }
 
+func (c *managerClient) Events(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Manager_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Manager_ServiceDesc.Streams[5], Manager_Events_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managerEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Manager_EventsClient interface {
+	Recv() (*ManagerEvent, error)
+	grpc.ClientStream
+}
+
+type managerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *managerEventsClient) Recv() (*ManagerEvent, error) {
+	m := new(ManagerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ManagerServer is the server API for Manager service.
 // All implementations must embed UnimplementedManagerServer
 // for forward compatibility
@@ -264,6 +298,7 @@ type ManagerServer interface {
 	UpdateUser(context.Context, *User) (*emptypb.Empty, error)
 	CurrentListenerCount(*emptypb.Empty, Manager_CurrentListenerCountServer) error
 	UpdateListenerCount(context.Context, *wrapperspb.Int64Value) (*emptypb.Empty, error)
+	Events(*emptypb.Empty, Manager_EventsServer) error
 	mustEmbedUnimplementedManagerServer()
 }
 
@@ -298,6 +333,9 @@ func (UnimplementedManagerServer) CurrentListenerCount(*emptypb.Empty, Manager_C
 func (UnimplementedManagerServer) UpdateListenerCount(context.Context, *wrapperspb.Int64Value) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateListenerCount not implemented")
 }
+func (UnimplementedManagerServer) Events(*emptypb.Empty, Manager_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
 func (UnimplementedManagerServer) mustEmbedUnimplementedManagerServer() {}
 
 // UnsafeManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -488,6 +526,27 @@ func _Manager_UpdateListenerCount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagerServer).Events(m, &managerEventsServer{stream})
+}
+
+type Manager_EventsServer interface {
+	Send(*ManagerEvent) error
+	grpc.ServerStream
+}
+
+type managerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *managerEventsServer) Send(m *ManagerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Manager_ServiceDesc is the grpc.ServiceDesc for Manager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -538,6 +597,11 @@ var Manager_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Manager_CurrentListenerCount_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Events",
+			Handler:       _Manager_Events_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "radio.proto",
 }
@@ -545,6 +609,7 @@ var Manager_ServiceDesc = grpc.ServiceDesc{
 const (
 	Announcer_AnnounceSong_FullMethodName    = "/radio.Announcer/AnnounceSong"
 	Announcer_AnnounceRequest_FullMethodName = "/radio.Announcer/AnnounceRequest"
+	Announcer_AnnounceStaff_FullMethodName   = "/radio.Announcer/AnnounceStaff"
 )
 
 // AnnouncerClient is the client API for Announcer service.
@@ -553,6 +618,7 @@ const (
 type AnnouncerClient interface {
 	AnnounceSong(ctx context.Context, in *SongAnnouncement, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AnnounceRequest(ctx context.Context, in *SongRequestAnnouncement, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AnnounceStaff(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type announcerClient struct {
@@ -581,12 +647,22 @@ func (c *announcerClient) AnnounceRequest(ctx context.Context, in *SongRequestAn
 	return out, nil
 }
 
+func (c *announcerClient) AnnounceStaff(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Announcer_AnnounceStaff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnnouncerServer is the server API for Announcer service.
 // All implementations must embed UnimplementedAnnouncerServer
 // for forward compatibility
 type AnnouncerServer interface {
 	AnnounceSong(context.Context, *SongAnnouncement) (*emptypb.Empty, error)
 	AnnounceRequest(context.Context, *SongRequestAnnouncement) (*emptypb.Empty, error)
+	AnnounceStaff(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAnnouncerServer()
 }
 
@@ -600,6 +676,9 @@ func (UnimplementedAnnouncerServer) AnnounceSong(context.Context, *SongAnnouncem
 func (UnimplementedAnnouncerServer) AnnounceRequest(context.Context, *SongRequestAnnouncement) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnounceRequest not implemented")
 }
+func (UnimplementedAnnouncerServer) AnnounceStaff(context.Context, *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceStaff not implemented")
+}
 func (UnimplementedAnnouncerServer) mustEmbedUnimplementedAnnouncerServer() {}
 
 // UnsafeAnnouncerServer may be embedded to opt out of forward compatibility for this service.
@@ -649,6 +728,24 @@ func _Announcer_AnnounceRequest_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Announcer_AnnounceStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnouncerServer).AnnounceStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Announcer_AnnounceStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnouncerServer).AnnounceStaff(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Announcer_ServiceDesc is the grpc.ServiceDesc for Announcer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -664,6 +761,10 @@ var Announcer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnnounceRequest",
 			Handler:    _Announcer_AnnounceRequest_Handler,
 		},
+		{
+			MethodName: "AnnounceStaff",
+			Handler:    _Announcer_AnnounceStaff_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "radio.proto",
@@ -672,6 +773,7 @@ var Announcer_ServiceDesc = grpc.ServiceDesc{
 const (
 	Streamer_Start_FullMethodName       = "/radio.Streamer/Start"
 	Streamer_Stop_FullMethodName        = "/radio.Streamer/Stop"
+	Streamer_Skip_FullMethodName        = "/radio.Streamer/Skip"
 	Streamer_RequestSong_FullMethodName = "/radio.Streamer/RequestSong"
 	Streamer_SetConfig_FullMethodName   = "/radio.Streamer/SetConfig"
 	Streamer_Queue_FullMethodName       = "/radio.Streamer/Queue"
@@ -686,6 +788,8 @@ type StreamerClient interface {
 	// Stop stops the streamer, the boolean argument indicates if we should stop
 	// right away, or wait until the current song ends
 	Stop(ctx context.Context, in *wrapperspb.BoolValue, opts ...grpc.CallOption) (*StreamerResponse, error)
+	// Skip skips the song that is currently playing
+	Skip(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StreamerResponse, error)
 	// RequestSong requests a song to be played by the streamer
 	RequestSong(ctx context.Context, in *SongRequest, opts ...grpc.CallOption) (*RequestResponse, error)
 	// SetConfig changes the configuration of the streamer
@@ -720,6 +824,15 @@ func (c *streamerClient) Stop(ctx context.Context, in *wrapperspb.BoolValue, opt
 	return out, nil
 }
 
+func (c *streamerClient) Skip(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StreamerResponse, error) {
+	out := new(StreamerResponse)
+	err := c.cc.Invoke(ctx, Streamer_Skip_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *streamerClient) RequestSong(ctx context.Context, in *SongRequest, opts ...grpc.CallOption) (*RequestResponse, error) {
 	out := new(RequestResponse)
 	err := c.cc.Invoke(ctx, Streamer_RequestSong_FullMethodName, in, out, opts...)
@@ -756,6 +869,8 @@ type StreamerServer interface {
 	// Stop stops the streamer, the boolean argument indicates if we should stop
 	// right away, or wait until the current song ends
 	Stop(context.Context, *wrapperspb.BoolValue) (*StreamerResponse, error)
+	// Skip skips the song that is currently playing
+	Skip(context.Context, *emptypb.Empty) (*StreamerResponse, error)
 	// RequestSong requests a song to be played by the streamer
 	RequestSong(context.Context, *SongRequest) (*RequestResponse, error)
 	// SetConfig changes the configuration of the streamer
@@ -775,6 +890,9 @@ func (UnimplementedStreamerServer) Start(context.Context, *emptypb.Empty) (*Stre
 func (UnimplementedStreamerServer) Stop(context.Context, *wrapperspb.BoolValue) (*StreamerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
+func (UnimplementedStreamerServer) Skip(context.Context, *emptypb.Empty) (*StreamerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Skip not implemented")
+}
 func (UnimplementedStreamerServer) RequestSong(context.Context, *SongRequest) (*RequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestSong not implemented")
 }
@@ -833,6 +951,24 @@ func _Streamer_Stop_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Streamer_Skip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamerServer).Skip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Streamer_Skip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamerServer).Skip(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Streamer_RequestSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SongRequest)
 	if err := dec(in); err != nil {
@@ -902,6 +1038,10 @@ var Streamer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Stop",
 			Handler:    _Streamer_Stop_Handler,
 		},
+		{
+			MethodName: "Skip",
+			Handler:    _Streamer_Skip_Handler,
+		},
 		{
 			MethodName: "RequestSong",
 			Handler:    _Streamer_RequestSong_Handler,
@@ -924,6 +1064,10 @@ const (
 	Queue_ReserveNext_FullMethodName = "/radio.Queue/ReserveNext"
 	Queue_Remove_FullMethodName      = "/radio.Queue/Remove"
 	Queue_Entries_FullMethodName     = "/radio.Queue/Entries"
+	Queue_Move_FullMethodName        = "/radio.Queue/Move"
+	Queue_InsertAt_FullMethodName    = "/radio.Queue/InsertAt"
+	Queue_Promote_FullMethodName     = "/radio.Queue/Promote"
+	Queue_Events_FullMethodName      = "/radio.Queue/Events"
 )
 
 // QueueClient is the client API for Queue service.
@@ -934,6 +1078,10 @@ type QueueClient interface {
 	ReserveNext(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueueEntry, error)
 	Remove(ctx context.Context, in *QueueID, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	Entries(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueueInfo, error)
+	Move(ctx context.Context, in *QueueMove, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	InsertAt(ctx context.Context, in *QueueInsert, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Promote(ctx context.Context, in *QueueID, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	Events(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Queue_EventsClient, error)
 }
 
 type queueClient struct {
@@ -980,6 +1128,65 @@ func (c *queueClient) Entries(ctx context.Context, in *emptypb.Empty, opts ...gr
 	return out, nil
 }
 
+func (c *queueClient) Move(ctx context.Context, in *QueueMove, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Queue_Move_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) InsertAt(ctx context.Context, in *QueueInsert, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Queue_InsertAt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Promote(ctx context.Context, in *QueueID, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, Queue_Promote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) Events(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Queue_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Queue_ServiceDesc.Streams[0], Queue_Events_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queueEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Queue_EventsClient interface {
+	Recv() (*QueueEvent, error)
+	grpc.ClientStream
+}
+
+type queueEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queueEventsClient) Recv() (*QueueEvent, error) {
+	m := new(QueueEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // QueueServer is the server API for Queue service.
 // All implementations must embed UnimplementedQueueServer
 // for forward compatibility
@@ -988,6 +1195,10 @@ type QueueServer interface {
 	ReserveNext(context.Context, *emptypb.Empty) (*QueueEntry, error)
 	Remove(context.Context, *QueueID) (*wrapperspb.BoolValue, error)
 	Entries(context.Context, *emptypb.Empty) (*QueueInfo, error)
+	Move(context.Context, *QueueMove) (*wrapperspb.BoolValue, error)
+	InsertAt(context.Context, *QueueInsert) (*emptypb.Empty, error)
+	Promote(context.Context, *QueueID) (*wrapperspb.BoolValue, error)
+	Events(*emptypb.Empty, Queue_EventsServer) error
 	mustEmbedUnimplementedQueueServer()
 }
 
@@ -1007,6 +1218,18 @@ func (UnimplementedQueueServer) Remove(context.Context, *QueueID) (*wrapperspb.B
 func (UnimplementedQueueServer) Entries(context.Context, *emptypb.Empty) (*QueueInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Entries not implemented")
 }
+func (UnimplementedQueueServer) Move(context.Context, *QueueMove) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
+}
+func (UnimplementedQueueServer) InsertAt(context.Context, *QueueInsert) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertAt not implemented")
+}
+func (UnimplementedQueueServer) Promote(context.Context, *QueueID) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Promote not implemented")
+}
+func (UnimplementedQueueServer) Events(*emptypb.Empty, Queue_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
 func (UnimplementedQueueServer) mustEmbedUnimplementedQueueServer() {}
 
 // UnsafeQueueServer may be embedded to opt out of forward compatibility for this service.
@@ -1092,6 +1315,81 @@ func _Queue_Entries_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Queue_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueMove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_Move_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Move(ctx, req.(*QueueMove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_InsertAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueInsert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).InsertAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_InsertAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).InsertAt(ctx, req.(*QueueInsert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_Promote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).Promote(ctx, req.(*QueueID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueueServer).Events(m, &queueEventsServer{stream})
+}
+
+type Queue_EventsServer interface {
+	Send(*QueueEvent) error
+	grpc.ServerStream
+}
+
+type queueEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queueEventsServer) Send(m *QueueEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Queue_ServiceDesc is the grpc.ServiceDesc for Queue service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1115,8 +1413,26 @@ var Queue_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Entries",
 			Handler:    _Queue_Entries_Handler,
 		},
+		{
+			MethodName: "Move",
+			Handler:    _Queue_Move_Handler,
+		},
+		{
+			MethodName: "InsertAt",
+			Handler:    _Queue_InsertAt_Handler,
+		},
+		{
+			MethodName: "Promote",
+			Handler:    _Queue_Promote_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _Queue_Events_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "radio.proto",
 }
 
@@ -1246,3 +1562,306 @@ var ListenerTracker_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "radio.proto",
 }
+
+const (
+	Proxy_ListSources_FullMethodName   = "/radio.Proxy/ListSources"
+	Proxy_ListUpstreams_FullMethodName = "/radio.Proxy/ListUpstreams"
+	Proxy_KickSource_FullMethodName    = "/radio.Proxy/KickSource"
+	Proxy_SetLive_FullMethodName       = "/radio.Proxy/SetLive"
+	Proxy_SetPriorities_FullMethodName = "/radio.Proxy/SetPriorities"
+	Proxy_Events_FullMethodName        = "/radio.Proxy/Events"
+)
+
+// ProxyClient is the client API for Proxy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProxyClient interface {
+	ListSources(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProxySources, error)
+	ListUpstreams(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProxyUpstreams, error)
+	KickSource(ctx context.Context, in *ProxySourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetLive(ctx context.Context, in *ProxySourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetPriorities(ctx context.Context, in *ProxyPrioritiesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Events(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Proxy_EventsClient, error)
+}
+
+type proxyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProxyClient(cc grpc.ClientConnInterface) ProxyClient {
+	return &proxyClient{cc}
+}
+
+func (c *proxyClient) ListSources(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProxySources, error) {
+	out := new(ProxySources)
+	err := c.cc.Invoke(ctx, Proxy_ListSources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) ListUpstreams(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProxyUpstreams, error) {
+	out := new(ProxyUpstreams)
+	err := c.cc.Invoke(ctx, Proxy_ListUpstreams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) KickSource(ctx context.Context, in *ProxySourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Proxy_KickSource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) SetLive(ctx context.Context, in *ProxySourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Proxy_SetLive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) SetPriorities(ctx context.Context, in *ProxyPrioritiesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Proxy_SetPriorities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) Events(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Proxy_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Proxy_ServiceDesc.Streams[0], Proxy_Events_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &proxyEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Proxy_EventsClient interface {
+	Recv() (*ProxyEvent, error)
+	grpc.ClientStream
+}
+
+type proxyEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *proxyEventsClient) Recv() (*ProxyEvent, error) {
+	m := new(ProxyEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ProxyServer is the server API for Proxy service.
+// All implementations must embed UnimplementedProxyServer
+// for forward compatibility
+type ProxyServer interface {
+	ListSources(context.Context, *emptypb.Empty) (*ProxySources, error)
+	ListUpstreams(context.Context, *emptypb.Empty) (*ProxyUpstreams, error)
+	KickSource(context.Context, *ProxySourceRequest) (*emptypb.Empty, error)
+	SetLive(context.Context, *ProxySourceRequest) (*emptypb.Empty, error)
+	SetPriorities(context.Context, *ProxyPrioritiesRequest) (*emptypb.Empty, error)
+	Events(*emptypb.Empty, Proxy_EventsServer) error
+	mustEmbedUnimplementedProxyServer()
+}
+
+// UnimplementedProxyServer must be embedded to have forward compatible implementations.
+type UnimplementedProxyServer struct {
+}
+
+func (UnimplementedProxyServer) ListSources(context.Context, *emptypb.Empty) (*ProxySources, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSources not implemented")
+}
+func (UnimplementedProxyServer) ListUpstreams(context.Context, *emptypb.Empty) (*ProxyUpstreams, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUpstreams not implemented")
+}
+func (UnimplementedProxyServer) KickSource(context.Context, *ProxySourceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickSource not implemented")
+}
+func (UnimplementedProxyServer) SetLive(context.Context, *ProxySourceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLive not implemented")
+}
+func (UnimplementedProxyServer) SetPriorities(context.Context, *ProxyPrioritiesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriorities not implemented")
+}
+func (UnimplementedProxyServer) Events(*emptypb.Empty, Proxy_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedProxyServer) mustEmbedUnimplementedProxyServer() {}
+
+// UnsafeProxyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProxyServer will
+// result in compilation errors.
+type UnsafeProxyServer interface {
+	mustEmbedUnimplementedProxyServer()
+}
+
+func RegisterProxyServer(s grpc.ServiceRegistrar, srv ProxyServer) {
+	s.RegisterService(&Proxy_ServiceDesc, srv)
+}
+
+func _Proxy_ListSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).ListSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_ListSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).ListSources(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_ListUpstreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).ListUpstreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_ListUpstreams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).ListUpstreams(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_KickSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProxySourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).KickSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_KickSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).KickSource(ctx, req.(*ProxySourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_SetLive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProxySourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).SetLive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_SetLive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).SetLive(ctx, req.(*ProxySourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_SetPriorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProxyPrioritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).SetPriorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Proxy_SetPriorities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).SetPriorities(ctx, req.(*ProxyPrioritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProxyServer).Events(m, &proxyEventsServer{stream})
+}
+
+type Proxy_EventsServer interface {
+	Send(*ProxyEvent) error
+	grpc.ServerStream
+}
+
+type proxyEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *proxyEventsServer) Send(m *ProxyEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Proxy_ServiceDesc is the grpc.ServiceDesc for Proxy service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Proxy_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "radio.Proxy",
+	HandlerType: (*ProxyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSources",
+			Handler:    _Proxy_ListSources_Handler,
+		},
+		{
+			MethodName: "ListUpstreams",
+			Handler:    _Proxy_ListUpstreams_Handler,
+		},
+		{
+			MethodName: "KickSource",
+			Handler:    _Proxy_KickSource_Handler,
+		},
+		{
+			MethodName: "SetLive",
+			Handler:    _Proxy_SetLive_Handler,
+		},
+		{
+			MethodName: "SetPriorities",
+			Handler:    _Proxy_SetPriorities_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _Proxy_Events_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "radio.proto",
+}
@@ -45,6 +45,12 @@ func (as AnnouncerShim) AnnounceRequest(ctx context.Context, ar *SongRequestAnno
 	return new(emptypb.Empty), err
 }
 
+// AnnounceStaff implements Announcer
+func (as AnnouncerShim) AnnounceStaff(ctx context.Context, s *wrapperspb.StringValue) (*emptypb.Empty, error) {
+	err := as.announcer.AnnounceStaff(ctx, s.GetValue())
+	return new(emptypb.Empty), err
+}
+
 // NewManager returns a new shim around the service given
 func NewManager(m radio.ManagerService) ManagerServer {
 	return ManagerShim{
@@ -63,6 +69,11 @@ func (sm ManagerShim) CurrentStatus(_ *emptypb.Empty, s Manager_CurrentStatusSer
 	return streamToProtobuf(s, sm.manager.CurrentStatus, toProtoStatus)
 }
 
+// Events implements Manager
+func (sm ManagerShim) Events(_ *emptypb.Empty, s Manager_EventsServer) error {
+	return streamToProtobuf(s, sm.manager.Events, toProtoManagerEvent)
+}
+
 type pbSender[P any] interface {
 	Send(P) error
 	grpc.ServerStream
@@ -164,9 +175,20 @@ func (ss StreamerShim) Stop(ctx context.Context, force *wrapperspb.BoolValue) (*
 	return resp, err
 }
 
+// Skip implements Streamer
+func (ss StreamerShim) Skip(ctx context.Context, _ *emptypb.Empty) (*StreamerResponse, error) {
+	err := ss.streamer.Skip(ctx)
+	resp := new(StreamerResponse)
+	resp.Error, err = toProtoError(err)
+	return resp, err
+}
+
 // RequestSong implements Streamer
 func (ss StreamerShim) RequestSong(ctx context.Context, req *SongRequest) (*RequestResponse, error) {
-	err := ss.streamer.RequestSong(ctx, fromProtoSong(req.Song), req.UserIdentifier)
+	err := ss.streamer.RequestSong(ctx, fromProtoSong(req.Song), radio.Requester{
+		Identifier: req.UserIdentifier,
+		Staff:      req.Staff,
+	})
 	resp := new(RequestResponse)
 	resp.Error, err = toProtoError(err)
 	return resp, err
@@ -237,6 +259,35 @@ func (q QueueShim) Remove(ctx context.Context, id *QueueID) (*wrapperspb.BoolVal
 	return wrapperspb.Bool(ok), nil
 }
 
+// Move implements Queue
+func (q QueueShim) Move(ctx context.Context, m *QueueMove) (*wrapperspb.BoolValue, error) {
+	ok, err := q.queue.Move(ctx, fromProtoQueueID(m.QueueId), int(m.Position))
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapperspb.Bool(ok), nil
+}
+
+// InsertAt implements Queue
+func (q QueueShim) InsertAt(ctx context.Context, i *QueueInsert) (*emptypb.Empty, error) {
+	err := q.queue.InsertAt(ctx, fromProtoSong(i.Song), int(i.Position))
+	if err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// Promote implements Queue
+func (q QueueShim) Promote(ctx context.Context, id *QueueID) (*wrapperspb.BoolValue, error) {
+	ok, err := q.queue.Promote(ctx, fromProtoQueueID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapperspb.Bool(ok), nil
+}
+
 // Entries implements Queue
 func (q QueueShim) Entries(ctx context.Context, _ *emptypb.Empty) (*QueueInfo, error) {
 	entries, err := q.queue.Entries(ctx)
@@ -254,6 +305,11 @@ func (q QueueShim) Entries(ctx context.Context, _ *emptypb.Empty) (*QueueInfo, e
 	}, nil
 }
 
+// Events implements Queue
+func (q QueueShim) Events(_ *emptypb.Empty, s Queue_EventsServer) error {
+	return streamToProtobuf(s, q.queue.Events, toProtoQueueEvent)
+}
+
 func NewListenerTracker(lt radio.ListenerTrackerService) ListenerTrackerServer {
 	return ListenerTrackerShim{tracker: lt}
 }
@@ -287,3 +343,93 @@ func (lt ListenerTrackerShim) RemoveClient(ctx context.Context, req *TrackerRemo
 	}
 	return new(emptypb.Empty), nil
 }
+
+func NewProxy(p radio.ProxyService) ProxyServer {
+	return ProxyShim{proxy: p}
+}
+
+// ProxyShim implements Proxy
+type ProxyShim struct {
+	UnimplementedProxyServer
+	proxy radio.ProxyService
+}
+
+func (p ProxyShim) ListSources(ctx context.Context, _ *emptypb.Empty) (*ProxySources, error) {
+	entries, err := p.proxy.ListSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]*ProxySource, len(entries))
+	for i := range entries {
+		sources[i] = toProtoProxySource(entries[i])
+	}
+
+	return &ProxySources{
+		Entries: sources,
+	}, nil
+}
+
+func (p ProxyShim) ListUpstreams(ctx context.Context, _ *emptypb.Empty) (*ProxyUpstreams, error) {
+	entries, err := p.proxy.ListUpstreams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upstreams := make([]*ProxyUpstream, len(entries))
+	for i := range entries {
+		upstreams[i] = toProtoProxyUpstream(entries[i])
+	}
+
+	return &ProxyUpstreams{
+		Entries: upstreams,
+	}, nil
+}
+
+func (p ProxyShim) KickSource(ctx context.Context, req *ProxySourceRequest) (*emptypb.Empty, error) {
+	id, err := radio.ParseSourceID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.proxy.KickSource(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+func (p ProxyShim) SetLive(ctx context.Context, req *ProxySourceRequest) (*emptypb.Empty, error) {
+	id, err := radio.ParseSourceID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.proxy.SetLive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+func (p ProxyShim) SetPriorities(ctx context.Context, req *ProxyPrioritiesRequest) (*emptypb.Empty, error) {
+	order := make([]radio.SourceID, len(req.Order))
+	for i := range req.Order {
+		id, err := radio.ParseSourceID(req.Order[i])
+		if err != nil {
+			return nil, err
+		}
+		order[i] = id
+	}
+
+	err := p.proxy.SetPriorities(ctx, req.MountName, order)
+	if err != nil {
+		return nil, err
+	}
+	return new(emptypb.Empty), nil
+}
+
+// Events implements Proxy
+func (p ProxyShim) Events(_ *emptypb.Empty, s Proxy_EventsServer) error {
+	return streamToProtobuf(s, p.proxy.Events, toProtoProxyEvent)
+}
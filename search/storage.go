@@ -27,6 +27,8 @@ type partialStorage interface {
 	radio.SubmissionStorageService
 	radio.NewsStorageService
 	radio.ScheduleStorageService
+	radio.ProgrammingBlockStorageService
+	radio.WebhookStorageService
 }
 
 type storageService struct {
@@ -55,10 +57,14 @@ func (ss storageService) TrackTx(ctx context.Context, tx radio.StorageTx) (radio
 // that we are NOT interested in.
 type partialTrackStorage interface {
 	Get(radio.TrackID) (*radio.Song, error)
+	GetWithFavorites([]radio.TrackID) ([]radio.FavoritedSong, error)
 	All() ([]radio.Song, error)
 	Unusable() ([]radio.Song, error)
 	BeforeLastRequested(before time.Time) ([]radio.Song, error)
 	QueueCandidates() ([]radio.TrackID, error)
+	UpdateLoudness(radio.TrackID, radio.Loudness) error
+	UpdateCue(id radio.TrackID, in, out time.Duration) error
+	Unmeasured(limit int64) ([]radio.Song, error)
 }
 
 type trackStorage struct {
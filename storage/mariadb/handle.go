@@ -348,6 +348,42 @@ func (s *StorageService) ScheduleTx(ctx context.Context, tx radio.StorageTx) (ra
 	return storage, tx, nil
 }
 
+func (s *StorageService) ProgrammingBlock(ctx context.Context) radio.ProgrammingBlockStorage {
+	return ProgrammingBlockStorage{
+		handle: handle{s.db, ctx, "programming_block"},
+	}
+}
+
+func (s *StorageService) ProgrammingBlockTx(ctx context.Context, tx radio.StorageTx) (radio.ProgrammingBlockStorage, radio.StorageTx, error) {
+	ctx, db, tx, err := s.tx(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := ProgrammingBlockStorage{
+		handle: handle{db, ctx, "programming_block"},
+	}
+	return storage, tx, nil
+}
+
+func (s *StorageService) Webhook(ctx context.Context) radio.WebhookStorage {
+	return WebhookStorage{
+		handle: handle{s.db, ctx, "webhook"},
+	}
+}
+
+func (s *StorageService) WebhookTx(ctx context.Context, tx radio.StorageTx) (radio.WebhookStorage, radio.StorageTx, error) {
+	ctx, db, tx, err := s.tx(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := WebhookStorage{
+		handle: handle{db, ctx, "webhook"},
+	}
+	return storage, tx, nil
+}
+
 type extContext interface {
 	sqlx.ExecerContext
 	sqlx.QueryerContext
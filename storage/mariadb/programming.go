@@ -0,0 +1,275 @@
+package mariadb
+
+import (
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// ProgrammingBlockStorage implements radio.ProgrammingBlockStorage
+type ProgrammingBlockStorage struct {
+	handle handle
+}
+
+// programmingBlock is the database representation of radio.ProgrammingBlock,
+// the lists are stored as newline separated text
+type programmingBlock struct {
+	ID        radio.ProgrammingBlockID
+	Name      string
+	Weekday   radio.ScheduleDay
+	Start     time.Duration
+	Length    time.Duration
+	Tags      string
+	Artists   string
+	Playlist  string
+	UpdatedAt time.Time
+}
+
+func toProgrammingBlock(pb programmingBlock) radio.ProgrammingBlock {
+	var playlist []radio.TrackID
+	for _, s := range splitLines(pb.Playlist) {
+		id, err := radio.ParseTrackID(s)
+		if err != nil {
+			continue
+		}
+		playlist = append(playlist, id)
+	}
+
+	return radio.ProgrammingBlock{
+		ID:        pb.ID,
+		Name:      pb.Name,
+		Weekday:   pb.Weekday,
+		Start:     pb.Start,
+		Length:    pb.Length,
+		Tags:      splitLines(pb.Tags),
+		Artists:   splitLines(pb.Artists),
+		Playlist:  playlist,
+		UpdatedAt: pb.UpdatedAt,
+	}
+}
+
+func fromProgrammingBlock(pb radio.ProgrammingBlock) programmingBlock {
+	playlist := make([]string, len(pb.Playlist))
+	for i, id := range pb.Playlist {
+		playlist[i] = id.String()
+	}
+
+	return programmingBlock{
+		ID:        pb.ID,
+		Name:      pb.Name,
+		Weekday:   pb.Weekday,
+		Start:     pb.Start,
+		Length:    pb.Length,
+		Tags:      strings.Join(pb.Tags, "\n"),
+		Artists:   strings.Join(pb.Artists, "\n"),
+		Playlist:  strings.Join(playlist, "\n"),
+		UpdatedAt: pb.UpdatedAt,
+	}
+}
+
+// splitLines splits s into its non-empty lines
+func splitLines(s string) []string {
+	var res []string
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		res = append(res, line)
+	}
+	return res
+}
+
+// All implements radio.ProgrammingBlockStorage
+func (ps ProgrammingBlockStorage) All() ([]radio.ProgrammingBlock, error) {
+	const op errors.Op = "mariadb/ProgrammingBlockStorage.All"
+	handle, deferFn := ps.handle.span(op)
+	defer deferFn()
+
+	var query = `
+	SELECT
+		id,
+		name,
+		weekday,
+		start,
+		length,
+		tags,
+		artists,
+		playlist,
+		updated_at AS updatedat
+	FROM
+		programming_blocks
+	ORDER BY
+		weekday ASC, start ASC;
+	`
+
+	var tmp []programmingBlock
+
+	err := sqlx.Select(handle, &tmp, query)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	blocks := make([]radio.ProgrammingBlock, len(tmp))
+	for i := range tmp {
+		blocks[i] = toProgrammingBlock(tmp[i])
+	}
+	return blocks, nil
+}
+
+// Create implements radio.ProgrammingBlockStorage
+func (ps ProgrammingBlockStorage) Create(block radio.ProgrammingBlock) (radio.ProgrammingBlockID, error) {
+	const op errors.Op = "mariadb/ProgrammingBlockStorage.Create"
+	handle, deferFn := ps.handle.span(op)
+	defer deferFn()
+
+	var query = `
+	INSERT INTO
+		programming_blocks (
+			name,
+			weekday,
+			start,
+			length,
+			tags,
+			artists,
+			playlist,
+			updated_at
+		) VALUES (
+			:name,
+			:weekday,
+			:start,
+			:length,
+			:tags,
+			:artists,
+			:playlist,
+			CURRENT_TIMESTAMP()
+		);
+	`
+
+	new, err := namedExecLastInsertId(handle, query, fromProgrammingBlock(block))
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return radio.ProgrammingBlockID(new), nil
+}
+
+// Update implements radio.ProgrammingBlockStorage
+func (ps ProgrammingBlockStorage) Update(block radio.ProgrammingBlock) error {
+	const op errors.Op = "mariadb/ProgrammingBlockStorage.Update"
+	handle, deferFn := ps.handle.span(op)
+	defer deferFn()
+
+	var query = `
+	UPDATE
+		programming_blocks
+	SET
+		name=:name,
+		weekday=:weekday,
+		start=:start,
+		length=:length,
+		tags=:tags,
+		artists=:artists,
+		playlist=:playlist,
+		updated_at=CURRENT_TIMESTAMP()
+	WHERE
+		id=:id;
+	`
+
+	res, err := sqlx.NamedExec(handle, query, fromProgrammingBlock(block))
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if affected != 1 {
+		return errors.E(op, errors.BlockUnknown)
+	}
+	return nil
+}
+
+// Delete implements radio.ProgrammingBlockStorage
+func (ps ProgrammingBlockStorage) Delete(id radio.ProgrammingBlockID) error {
+	const op errors.Op = "mariadb/ProgrammingBlockStorage.Delete"
+	handle, deferFn := ps.handle.span(op)
+	defer deferFn()
+
+	var query = `DELETE FROM programming_blocks WHERE id=?;`
+
+	res, err := handle.Exec(query, id)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if affected != 1 {
+		return errors.E(op, errors.BlockUnknown)
+	}
+	return nil
+}
+
+// likeEscaper escapes the special characters of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Candidates implements radio.ProgrammingBlockStorage
+func (ps ProgrammingBlockStorage) Candidates(block radio.ProgrammingBlock) ([]radio.TrackID, error) {
+	const op errors.Op = "mariadb/ProgrammingBlockStorage.Candidates"
+
+	if len(block.Tags) == 0 && len(block.Artists) == 0 && len(block.Playlist) == 0 {
+		// no restrictions, so just use the normal candidates
+		return TrackStorage{ps.handle}.QueueCandidates()
+	}
+
+	handle, deferFn := ps.handle.span(op)
+	defer deferFn()
+
+	// tags are space separated, so pad them to be able to match whole tags
+	var conds []string
+	var args []any
+	for _, tag := range block.Tags {
+		conds = append(conds, `CONCAT(' ', tags, ' ') LIKE ?`)
+		args = append(args, "% "+likeEscaper.Replace(tag)+" %")
+	}
+	if len(block.Artists) > 0 {
+		conds = append(conds, `artist IN (?)`)
+		args = append(args, block.Artists)
+	}
+	if len(block.Playlist) > 0 {
+		conds = append(conds, `id IN (?)`)
+		args = append(args, block.Playlist)
+	}
+
+	var query = `
+		SELECT
+			id
+		FROM
+			tracks
+		WHERE
+			usable=1 AND (` + strings.Join(conds, " OR ") + `)
+		ORDER BY (
+			UNIX_TIMESTAMP(lastplayed) + 1)*(UNIX_TIMESTAMP(lastrequested) + 1)
+		ASC LIMIT 100;
+	`
+
+	query, args, err := sqlx.In(query, args...)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var candidates = []radio.TrackID{}
+	err = sqlx.Select(handle, &candidates, query, args...)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return candidates, nil
+}
@@ -50,3 +50,35 @@ func (rs RequestStorage) UpdateLastRequest(identifier string) error {
 	}
 	return nil
 }
+
+// RequestTimes implements radio.RequestStorage
+func (rs RequestStorage) RequestTimes(identifier string, since time.Time) ([]time.Time, error) {
+	const op errors.Op = "mariadb/RequestStorage.RequestTimes"
+	handle, deferFn := rs.handle.span(op)
+	defer deferFn()
+
+	query := "SELECT time FROM requesttime WHERE ip=? AND time>=? ORDER BY time DESC;"
+
+	var times = []time.Time{}
+	err := sqlx.Select(handle, &times, query, identifier, since)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return times, nil
+}
+
+// AllRequestTimes implements radio.RequestStorage
+func (rs RequestStorage) AllRequestTimes(since time.Time) ([]time.Time, error) {
+	const op errors.Op = "mariadb/RequestStorage.AllRequestTimes"
+	handle, deferFn := rs.handle.span(op)
+	defer deferFn()
+
+	query := "SELECT time FROM requesttime WHERE time>=? ORDER BY time DESC;"
+
+	var times = []time.Time{}
+	err := sqlx.Select(handle, &times, query, since)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return times, nil
+}
@@ -15,6 +15,8 @@ SELECT
 	schedule.id AS id,
 	schedule.weekday AS weekday,
 	schedule.text AS text,
+	schedule.start AS start,
+	schedule.length AS length,
 	schedule.updated_at AS updated_at,
 	schedule.notification AS notification,
 
@@ -121,6 +123,8 @@ func (ss ScheduleStorage) Update(entry radio.ScheduleEntry) error {
 			weekday,
 			text,
 			owner,
+			start,
+			length,
 			updated_by,
 			updated_at,
 			notification
@@ -128,6 +132,8 @@ func (ss ScheduleStorage) Update(entry radio.ScheduleEntry) error {
 			:weekday,
 			:text,
 			IF(:owner.id, :owner.id, NULL),
+			:start,
+			:length,
 			:updatedby.id,
 			CURRENT_TIMESTAMP(),
 			:notification
@@ -157,6 +163,8 @@ func (ss ScheduleStorage) History(day radio.ScheduleDay, limit, offset int64) ([
 		schedule.id AS id,
 		schedule.weekday AS weekday,
 		schedule.text AS text,
+		schedule.start AS start,
+		schedule.length AS length,
 		schedule.updated_at AS updated_at,
 		schedule.notification AS notification,
 
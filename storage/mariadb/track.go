@@ -22,6 +22,8 @@ func expand(query string) string {
 	query = strings.ReplaceAll(query, "{songColumns}", songColumns)
 	query = strings.ReplaceAll(query, "{maybeSongColumns}", maybeSongColumns)
 	query = strings.ReplaceAll(query, "{lastplayedSelect}", lastplayedSelect)
+	query = strings.ReplaceAll(query, "{retentionDJColumns}", retentionDJColumns)
+	query = strings.ReplaceAll(query, "{retentionColumns}", retentionColumns)
 	if orig == query {
 		panic("expand called but nothing was expanded")
 	}
@@ -41,7 +43,14 @@ const trackColumns = `
 	IF(tracks.usable, TRUE, FALSE) AS usable,
 	IF(tracks.need_reupload, TRUE, FALSE) AS needreplacement,
 	tracks.lastrequested,
-	tracks.requestcount
+	tracks.requestcount,
+	IFNULL(tracks.loudness_i, 0) AS 'loudness.integrated',
+	IFNULL(tracks.loudness_lra, 0) AS 'loudness.range',
+	IFNULL(tracks.loudness_tp, 0) AS 'loudness.truepeak',
+	IFNULL(tracks.loudness_thresh, 0) AS 'loudness.threshold',
+	IFNULL(tracks.loudness_offset, 0) AS 'loudness.offset',
+	IFNULL(tracks.cue_in, 0) AS cuein,
+	IFNULL(tracks.cue_out, 0) AS cueout
 `
 
 const maybeTrackColumns = `
@@ -57,7 +66,14 @@ const maybeTrackColumns = `
 	IF(tracks.usable, TRUE, FALSE) AS usable,
 	IF(tracks.need_reupload, TRUE, FALSE) AS needreplacement,
 	IFNULL(tracks.lastrequested, TIMESTAMP('0000-00-00 00:00:00')) AS lastrequested,
-	IFNULL(tracks.requestcount, 0) AS requestcount
+	IFNULL(tracks.requestcount, 0) AS requestcount,
+	IFNULL(tracks.loudness_i, 0) AS 'loudness.integrated',
+	IFNULL(tracks.loudness_lra, 0) AS 'loudness.range',
+	IFNULL(tracks.loudness_tp, 0) AS 'loudness.truepeak',
+	IFNULL(tracks.loudness_thresh, 0) AS 'loudness.threshold',
+	IFNULL(tracks.loudness_offset, 0) AS 'loudness.offset',
+	IFNULL(tracks.cue_in, 0) AS cuein,
+	IFNULL(tracks.cue_out, 0) AS cueout
 `
 
 const songColumns = `
@@ -272,20 +288,169 @@ func (ss SongStorage) PlayedCount(song radio.Song) (int64, error) {
 }
 
 // AddPlay implements radio.SongStorage
-func (ss SongStorage) AddPlay(song radio.Song, user radio.User, ldiff *radio.Listeners) error {
+func (ss SongStorage) AddPlay(song radio.Song, user radio.User, ldiff *radio.Listeners, curve radio.ListenerCurve) error {
 	const op errors.Op = "mariadb/SongStorage.AddPlay"
 	handle, deferFn := ss.handle.span(op)
 	defer deferFn()
 
-	var query = `INSERT INTO eplay (isong, djs_id, ldiff) VALUES (?, ?, ?);`
+	var query = `INSERT INTO eplay (isong, djs_id, ldiff, lcurve, lstart, llost) VALUES (?, ?, ?, ?, ?, ?);`
 
-	_, err := handle.Exec(query, song.ID, user.DJ.ID, ldiff)
+	// the start and lost columns are derived from the curve so that retention
+	// can be calculated by the database
+	var lstart, llost *radio.Listeners
+	if len(curve) > 0 {
+		start, lost := curve.Start(), curve.Lost()
+		lstart, llost = &start, &lost
+	}
+
+	_, err := handle.Exec(query, song.ID, user.DJ.ID, ldiff, curve, lstart, llost)
 	if err != nil {
 		return errors.E(op, err)
 	}
 	return nil
 }
 
+const retentionDJColumns = `
+	IFNULL(djs.id, 0) AS 'dj.id',
+	IFNULL(djs.regex, '') AS 'dj.regex',
+	IFNULL(djs.djname, '') AS 'dj.name',
+	IFNULL(djs.djtext, '') AS 'dj.text',
+	IFNULL(djs.djimage, '') AS 'dj.image',
+	IFNULL(djs.visible, 0) AS 'dj.visible',
+	IFNULL(djs.priority, 0) AS 'dj.priority',
+	IFNULL(djs.role, '') AS 'dj.role',
+	IFNULL(djs.css, '') AS 'dj.css',
+	IFNULL(djs.djcolor, '') AS 'dj.color'
+`
+
+const retentionColumns = `
+	COUNT(*) AS plays,
+	IFNULL(AVG(eplay.lstart), 0) AS start,
+	IFNULL(AVG(eplay.llost), 0) AS lost
+`
+
+var songListenerCurvesQuery = expand(`
+SELECT
+	eplay.dt AS playedat,
+	{retentionDJColumns},
+	eplay.lcurve AS curve
+FROM
+	eplay
+LEFT JOIN
+	djs ON eplay.djs_id = djs.id
+WHERE
+	eplay.isong=? AND eplay.lcurve IS NOT NULL
+ORDER BY
+	eplay.dt DESC, eplay.id DESC
+LIMIT ?;
+`)
+
+// ListenerCurves implements radio.SongStorage
+func (ss SongStorage) ListenerCurves(song radio.Song, limit int64) ([]radio.PlayCurve, error) {
+	const op errors.Op = "mariadb/SongStorage.ListenerCurves"
+	handle, deferFn := ss.handle.span(op)
+	defer deferFn()
+
+	var curves = make([]radio.PlayCurve, 0, limit)
+
+	err := sqlx.Select(handle, &curves, songListenerCurvesQuery, song.ID, limit)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return curves, nil
+}
+
+var songRetentionQuery = expand(`
+SELECT
+	{retentionColumns}
+FROM
+	eplay
+WHERE
+	eplay.isong=? AND eplay.lcurve IS NOT NULL;
+`)
+
+// Retention implements radio.SongStorage
+func (ss SongStorage) Retention(song radio.Song) (radio.ListenerRetention, error) {
+	const op errors.Op = "mariadb/SongStorage.Retention"
+	handle, deferFn := ss.handle.span(op)
+	defer deferFn()
+
+	var retention radio.ListenerRetention
+
+	err := sqlx.Get(handle, &retention, songRetentionQuery, song.ID)
+	if err != nil {
+		return retention, errors.E(op, err)
+	}
+	return retention, nil
+}
+
+var songRetentionBySongQuery = expand(`
+SELECT
+	esong.id AS 'song.id',
+	esong.meta AS 'song.metadata',
+	esong.hash AS 'song.hash',
+	esong.hash_link AS 'song.hashlink',
+	to_go_duration(esong.len) AS 'song.length',
+	{retentionColumns}
+FROM
+	eplay
+JOIN
+	esong ON esong.id = eplay.isong
+WHERE
+	eplay.dt >= ? AND eplay.lcurve IS NOT NULL
+GROUP BY
+	esong.id
+ORDER BY
+	SUM(eplay.llost) / GREATEST(SUM(eplay.lstart), 1) DESC, plays DESC
+LIMIT ?;
+`)
+
+// RetentionBySong implements radio.SongStorage
+func (ss SongStorage) RetentionBySong(since time.Time, limit int64) ([]radio.SongRetention, error) {
+	const op errors.Op = "mariadb/SongStorage.RetentionBySong"
+	handle, deferFn := ss.handle.span(op)
+	defer deferFn()
+
+	var retention = make([]radio.SongRetention, 0, limit)
+
+	err := sqlx.Select(handle, &retention, songRetentionBySongQuery, since, limit)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return retention, nil
+}
+
+var songRetentionByDJQuery = expand(`
+SELECT
+	{retentionDJColumns},
+	{retentionColumns}
+FROM
+	eplay
+JOIN
+	djs ON eplay.djs_id = djs.id
+WHERE
+	eplay.dt >= ? AND eplay.lcurve IS NOT NULL
+GROUP BY
+	djs.id
+ORDER BY
+	SUM(eplay.llost) / GREATEST(SUM(eplay.lstart), 1) DESC, plays DESC;
+`)
+
+// RetentionByDJ implements radio.SongStorage
+func (ss SongStorage) RetentionByDJ(since time.Time) ([]radio.DJRetention, error) {
+	const op errors.Op = "mariadb/SongStorage.RetentionByDJ"
+	handle, deferFn := ss.handle.span(op)
+	defer deferFn()
+
+	var retention []radio.DJRetention
+
+	err := sqlx.Select(handle, &retention, songRetentionByDJQuery, since)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return retention, nil
+}
+
 // FavoriteCount implements radio.SongStorage
 func (ss SongStorage) FavoriteCount(song radio.Song) (int64, error) {
 	const op errors.Op = "mariadb/SongStorage.FavoriteCount"
@@ -534,6 +699,45 @@ func (ts TrackStorage) Get(id radio.TrackID) (*radio.Song, error) {
 	return &song, nil
 }
 
+var trackGetWithFavoritesQuery = expand(`
+SELECT
+	{trackColumns},
+	{maybeSongColumns},
+	{lastplayedSelect},
+	NOW() AS synctime,
+	(SELECT count(*) FROM efave WHERE efave.isong=esong.id) AS favorites
+FROM
+	tracks
+LEFT JOIN
+	esong ON tracks.hash = esong.hash
+WHERE
+	tracks.id IN (?);
+`)
+
+// GetWithFavorites implements radio.TrackStorage
+func (ts TrackStorage) GetWithFavorites(ids []radio.TrackID) ([]radio.FavoritedSong, error) {
+	const op errors.Op = "mariadb/TrackStorage.GetWithFavorites"
+	handle, deferFn := ts.handle.span(op)
+	defer deferFn()
+
+	var songs = []radio.FavoritedSong{}
+	if len(ids) == 0 {
+		return songs, nil
+	}
+
+	query, args, err := sqlx.In(trackGetWithFavoritesQuery, ids)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	err = sqlx.Select(handle, &songs, query, args...)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return songs, nil
+}
+
 var trackAllQuery = expand(`
 SELECT
 	{trackColumns},
@@ -823,6 +1027,110 @@ func (ts TrackStorage) UpdateUsable(song radio.Song, state radio.TrackState) err
 	return nil
 }
 
+// UpdateLoudness implements radio.TrackStorage
+func (ts TrackStorage) UpdateLoudness(id radio.TrackID, l radio.Loudness) error {
+	const op errors.Op = "mariadb/TrackStorage.UpdateLoudness"
+	handle, deferFn := ts.handle.span(op)
+	defer deferFn()
+
+	var query = `
+	UPDATE
+		tracks
+	SET
+		loudness_i=?,
+		loudness_lra=?,
+		loudness_tp=?,
+		loudness_thresh=?,
+		loudness_offset=?
+	WHERE
+		id=?;
+	`
+
+	var args = []any{l.Integrated, l.Range, l.TruePeak, l.Threshold, l.Offset, id}
+	if l.IsZero() {
+		args = []any{nil, nil, nil, nil, nil, id}
+	}
+
+	_, err := handle.Exec(query, args...)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+var trackUnmeasuredQuery = expand(`
+SELECT
+	{maybeSongColumns},
+	{trackColumns},
+	{lastplayedSelect},
+	NOW() as synctime
+FROM
+	tracks
+LEFT JOIN
+	esong ON tracks.hash = esong.hash
+WHERE
+	tracks.usable=1 AND tracks.loudness_i IS NULL
+LIMIT ?;
+`)
+
+// Unmeasured implements radio.TrackStorage
+func (ts TrackStorage) Unmeasured(limit int64) ([]radio.Song, error) {
+	const op errors.Op = "mariadb/TrackStorage.Unmeasured"
+	handle, deferFn := ts.handle.span(op)
+	defer deferFn()
+
+	var songs = []radio.Song{}
+
+	err := sqlx.Select(handle, &songs, trackUnmeasuredQuery, limit)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return songs, nil
+}
+
+// UpdateCue implements radio.TrackStorage
+func (ts TrackStorage) UpdateCue(id radio.TrackID, in, out time.Duration) error {
+	const op errors.Op = "mariadb/TrackStorage.UpdateCue"
+	handle, deferFn := ts.handle.span(op)
+	defer deferFn()
+
+	var query = `UPDATE tracks SET cue_in=?, cue_out=? WHERE id=?;`
+
+	var args = []any{in, out, id}
+	if in == 0 && out == 0 {
+		args = []any{nil, nil, id}
+	}
+
+	_, err := handle.Exec(query, args...)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if out == 0 {
+		// no cue-out means we don't know the playable length
+		return nil
+	}
+
+	// update the song length to reflect the part that is actually played
+	query = `
+	UPDATE
+		esong
+	JOIN
+		tracks ON tracks.hash = esong.hash
+	SET
+		esong.len=?
+	WHERE
+		tracks.id=?;
+	`
+
+	_, err = handle.Exec(query, int((out-in)/time.Second), id)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 // UpdateRequestInfo updates the time the track given was last requested
 // and increases the time between requests for the song.
 //
@@ -492,3 +492,134 @@ func (us UserStorage) RecordListeners(listeners radio.Listeners, user radio.User
 	}
 	return nil
 }
+
+// StreamKeys implements radio.UserStorage
+func (us UserStorage) StreamKeys(id radio.UserID) ([]radio.StreamKey, error) {
+	const op errors.Op = "mariadb/UserStorage.StreamKeys"
+	handle, deferFn := us.handle.span(op)
+	defer deferFn()
+
+	var query = `
+	SELECT
+		id,
+		user_id AS userid,
+		name,
+		hash,
+		mount,
+		expires_at AS expiresat,
+		created_at
+	FROM
+		stream_keys
+	WHERE
+		user_id=?
+	ORDER BY
+		created_at ASC, id ASC;
+	`
+
+	var keys = []radio.StreamKey{}
+
+	err := sqlx.Select(handle, &keys, query, id)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return keys, nil
+}
+
+// CreateStreamKey implements radio.UserStorage
+func (us UserStorage) CreateStreamKey(key radio.StreamKey) (radio.StreamKeyID, error) {
+	const op errors.Op = "mariadb/UserStorage.CreateStreamKey"
+	handle, deferFn := us.handle.span(op)
+	defer deferFn()
+
+	var query = `
+	INSERT INTO
+		stream_keys (
+			user_id,
+			name,
+			hash,
+			mount,
+			expires_at
+		) VALUES (
+			:userid,
+			:name,
+			:hash,
+			:mount,
+			:expiresat
+		);
+	`
+
+	new, err := namedExecLastInsertId(handle, query, key)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return radio.StreamKeyID(new), nil
+}
+
+// RevokeStreamKey implements radio.UserStorage
+func (us UserStorage) RevokeStreamKey(user radio.UserID, id radio.StreamKeyID) error {
+	const op errors.Op = "mariadb/UserStorage.RevokeStreamKey"
+	handle, deferFn := us.handle.span(op)
+	defer deferFn()
+
+	var query = `DELETE FROM stream_keys WHERE id=? AND user_id=?;`
+
+	res, err := handle.Exec(query, id, user)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	if affected != 1 {
+		return errors.E(op, errors.StreamKeyUnknown)
+	}
+	return nil
+}
+
+// GetByStreamKey implements radio.UserStorage
+func (us UserStorage) GetByStreamKey(hash string) (*radio.User, *radio.StreamKey, error) {
+	const op errors.Op = "mariadb/UserStorage.GetByStreamKey"
+	handle, deferFn := us.handle.span(op)
+	defer deferFn()
+
+	var query = `
+	SELECT
+		id,
+		user_id AS userid,
+		name,
+		hash,
+		mount,
+		expires_at AS expiresat,
+		created_at
+	FROM
+		stream_keys
+	WHERE
+		hash=?;
+	`
+
+	var key radio.StreamKey
+
+	err := sqlx.Get(handle, &key, query, hash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, errors.E(op, errors.StreamKeyUnknown)
+		}
+		return nil, nil, errors.E(op, err)
+	}
+
+	query = fmt.Sprintf(getUserQuery, "users.id=?")
+
+	var user radio.User
+
+	err = sqlx.Get(handle, &user, query, key.UserID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, errors.E(op, errors.UserUnknown)
+		}
+		return nil, nil, errors.E(op, err)
+	}
+	return &user, &key, nil
+}
@@ -0,0 +1,87 @@
+package mariadb
+
+import (
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+// WebhookStorage implements radio.WebhookStorage
+type WebhookStorage struct {
+	handle handle
+}
+
+// AddDelivery implements radio.WebhookStorage
+func (ws WebhookStorage) AddDelivery(delivery radio.WebhookDelivery) (radio.WebhookDeliveryID, error) {
+	const op errors.Op = "mariadb/WebhookStorage.AddDelivery"
+	handle, deferFn := ws.handle.span(op)
+	defer deferFn()
+
+	var query = `
+	INSERT INTO
+		webhook_deliveries (
+			event,
+			url,
+			payload,
+			status_code,
+			error,
+			attempts,
+			created_at
+		) VALUES (
+			:event,
+			:url,
+			:payload,
+			:statuscode,
+			:error,
+			:attempts,
+			:created_at
+		);
+	`
+
+	new, err := namedExecLastInsertId(handle, query, delivery)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return radio.WebhookDeliveryID(new), nil
+}
+
+// Deliveries implements radio.WebhookStorage
+func (ws WebhookStorage) Deliveries(limit, offset int64) (radio.WebhookDeliveryList, error) {
+	const op errors.Op = "mariadb/WebhookStorage.Deliveries"
+	handle, deferFn := ws.handle.span(op)
+	defer deferFn()
+
+	var query = `
+	SELECT
+		id,
+		event,
+		url,
+		payload,
+		status_code AS statuscode,
+		error,
+		attempts,
+		created_at
+	FROM
+		webhook_deliveries
+	ORDER BY
+		created_at DESC, id DESC
+	LIMIT ? OFFSET ?;
+	`
+
+	var deliveries = radio.WebhookDeliveryList{
+		Entries: make([]radio.WebhookDelivery, 0, limit),
+	}
+
+	err := sqlx.Select(handle, &deliveries.Entries, query, limit, offset)
+	if err != nil {
+		return radio.WebhookDeliveryList{}, errors.E(op, err)
+	}
+
+	query = `SELECT COUNT(*) AS total FROM webhook_deliveries;`
+
+	err = sqlx.Get(handle, &deliveries.Total, query)
+	if err != nil {
+		return radio.WebhookDeliveryList{}, errors.E(op, err)
+	}
+	return deliveries, nil
+}
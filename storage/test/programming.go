@@ -0,0 +1,61 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestProgrammingBlock(t *testing.T) {
+	s := suite.Storage(t)
+	ps := s.ProgrammingBlock(suite.ctx)
+
+	block := radio.ProgrammingBlock{
+		Name:     "Vocaloid hour",
+		Weekday:  radio.Friday,
+		Start:    time.Hour * 20,
+		Length:   time.Hour,
+		Tags:     []string{"vocaloid"},
+		Artists:  []string{"Hatsune Miku"},
+		Playlist: []radio.TrackID{1, 2, 3},
+	}
+
+	id, err := ps.Create(block)
+	require.NoError(t, err)
+	block.ID = id
+
+	all, err := ps.All()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, block.Name, all[0].Name)
+	assert.Equal(t, block.Weekday, all[0].Weekday)
+	assert.Equal(t, block.Start, all[0].Start)
+	assert.Equal(t, block.Length, all[0].Length)
+	assert.Equal(t, block.Tags, all[0].Tags)
+	assert.Equal(t, block.Artists, all[0].Artists)
+	assert.Equal(t, block.Playlist, all[0].Playlist)
+
+	block.Name = "OST sunday"
+	block.Weekday = radio.Sunday
+	block.Tags = []string{"ost", "soundtrack"}
+	require.NoError(t, ps.Update(block))
+
+	all, err = ps.All()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, block.Name, all[0].Name)
+	assert.Equal(t, block.Tags, all[0].Tags)
+
+	_, err = ps.Candidates(block)
+	require.NoError(t, err)
+
+	require.NoError(t, ps.Delete(block.ID))
+	assert.Error(t, ps.Delete(block.ID), "deleting twice should error")
+
+	all, err = ps.All()
+	require.NoError(t, err)
+	assert.Len(t, all, 0)
+}
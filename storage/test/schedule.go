@@ -49,6 +49,8 @@ func (suite *Suite) TestScheduleUpdate(t *testing.T) {
 		Weekday:      radio.Friday,
 		Text:         "And this is an updated Friday",
 		Owner:        &user,
+		Start:        time.Hour * 20,
+		Length:       time.Hour * 2,
 		UpdatedBy:    user,
 		Notification: true,
 	}
@@ -75,6 +77,8 @@ func (suite *Suite) TestScheduleUpdate(t *testing.T) {
 
 		assert.Equal(t, s.Text, got.Text)
 		assert.Equal(t, s.Notification, got.Notification)
+		assert.Equal(t, s.Start, got.Start)
+		assert.Equal(t, s.Length, got.Length)
 		assert.Equal(t, s.UpdatedBy.ID, got.UpdatedBy.ID)
 		if s.Owner != nil && assert.NotNil(t, got.Owner) {
 			assert.Equal(t, s.Owner.ID, got.Owner.ID)
@@ -73,7 +73,7 @@ func (suite *Suite) TestSongLastPlayed(t *testing.T) {
 
 	// now have them all play
 	for i, song := range songs {
-		err := ss.AddPlay(song, user, nil)
+		err := ss.AddPlay(song, user, nil, nil)
 		require.NoError(t, err)
 
 		if i == 15 || i == 40 { // Artificially wait a second in the middle somewhere
@@ -168,3 +168,123 @@ func (suite *Suite) TestTrackUpdateMetadata(t *testing.T) {
 	assert.Equal(t, updatedSong.Album, updated.Album)
 	assert.Equal(t, updatedSong.Title, updated.Title)
 }
+
+func (suite *Suite) TestTrackLoudness(t *testing.T) {
+	ts := suite.Storage(t).Track(suite.ctx)
+
+	song := radio.Song{
+		DatabaseTrack: &radio.DatabaseTrack{
+			Artist: "loudness artist",
+			Title:  "loudness title",
+		},
+	}
+	song.Hydrate()
+
+	id, err := ts.Insert(song)
+	require.NoError(t, err)
+
+	// a new track has no analysis yet
+	got, err := ts.Get(id)
+	require.NoError(t, err)
+	assert.True(t, got.Loudness.IsZero())
+
+	loudness := radio.Loudness{
+		Integrated: -18.5,
+		Range:      6.25,
+		TruePeak:   -0.75,
+		Threshold:  -28.5,
+		Offset:     0.5,
+	}
+	require.NoError(t, ts.UpdateLoudness(id, loudness))
+
+	got, err = ts.Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, loudness, got.Loudness)
+
+	// storing a zero analysis clears it
+	require.NoError(t, ts.UpdateLoudness(id, radio.Loudness{}))
+
+	got, err = ts.Get(id)
+	require.NoError(t, err)
+	assert.True(t, got.Loudness.IsZero())
+}
+
+func (suite *Suite) TestTrackGetWithFavorites(t *testing.T) {
+	ts := suite.Storage(t).Track(suite.ctx)
+
+	var ids []radio.TrackID
+	for i := range 3 {
+		song := radio.Song{
+			DatabaseTrack: &radio.DatabaseTrack{
+				Artist: "favorites artist",
+				Title:  "favorites title " + strconv.Itoa(i),
+			},
+		}
+		song.Hydrate()
+
+		id, err := ts.Insert(song)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	// unknown ids should be left out
+	songs, err := ts.GetWithFavorites(append(ids, ids[2]+1000))
+	require.NoError(t, err)
+	require.Len(t, songs, len(ids))
+
+	for _, song := range songs {
+		expected, err := ts.Get(song.TrackID)
+		require.NoError(t, err)
+		assert.True(t, expected.EqualTo(song.Song))
+		assert.Zero(t, song.Favorites)
+	}
+
+	songs, err = ts.GetWithFavorites(nil)
+	require.NoError(t, err)
+	assert.Empty(t, songs)
+}
+
+func (suite *Suite) TestSongListenerCurve(t *testing.T) {
+	ss := suite.Storage(t).Song(suite.ctx)
+
+	song := radio.Song{
+		Metadata: "test-song-listener-curve",
+	}
+	song.Hydrate()
+
+	new, err := ss.Create(song)
+	require.NoError(t, err)
+	require.NotNil(t, new)
+	song = *new
+
+	curve := radio.ListenerCurve{
+		{Offset: 0, Listeners: 100},
+		{Offset: time.Second * 30, Listeners: 90},
+		{Offset: time.Second * 60, Listeners: 95},
+		{Offset: time.Second * 90, Listeners: 80},
+	}
+
+	since := time.Now().Add(-time.Minute)
+	// one play with a curve and one without, the latter should be ignored
+	require.NoError(t, ss.AddPlay(song, radio.User{}, nil, curve))
+	require.NoError(t, ss.AddPlay(song, radio.User{}, nil, nil))
+
+	curves, err := ss.ListenerCurves(song, 10)
+	require.NoError(t, err)
+	require.Len(t, curves, 1)
+	assert.Equal(t, curve, curves[0].Curve)
+
+	retention, err := ss.Retention(song)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, retention.Plays)
+	assert.EqualValues(t, 100, retention.Start)
+	assert.EqualValues(t, 25, retention.Lost)
+
+	bySong, err := ss.RetentionBySong(since, 100)
+	require.NoError(t, err)
+	idx := slices.IndexFunc(bySong, func(sr radio.SongRetention) bool {
+		return sr.Song.ID == song.ID
+	})
+	require.NotEqual(t, -1, idx, "song should be in retention list")
+	assert.EqualValues(t, 25, bySong[idx].Lost)
+}
@@ -281,3 +281,58 @@ func (suite *Suite) TestUserLookupName(t *testing.T) {
 		compareUser(t, true, user, *other)
 	}
 }
+
+func (suite *Suite) TestUserStreamKeys(t *testing.T) {
+	us := suite.Storage(t).User(suite.ctx)
+
+	uid, err := us.Create(testUser)
+	require.NoError(t, err, "expected no error")
+	require.NotZero(t, uid, "expected new user id back")
+
+	keys, err := us.StreamKeys(uid)
+	require.NoError(t, err, "expected no error")
+	require.Empty(t, keys, "expected no keys for a new user")
+
+	_, hash, err := radio.GenerateStreamKey()
+	require.NoError(t, err, "expected no error")
+
+	expires := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	key := radio.StreamKey{
+		UserID:    uid,
+		Name:      "laptop",
+		Hash:      hash,
+		Mount:     "/main.mp3",
+		ExpiresAt: &expires,
+	}
+	key.ID, err = us.CreateStreamKey(key)
+	require.NoError(t, err, "expected no error")
+	require.NotZero(t, key.ID, "expected new key id back")
+
+	keys, err = us.StreamKeys(uid)
+	require.NoError(t, err, "expected no error")
+	require.Len(t, keys, 1)
+	assert.Equal(t, key.ID, keys[0].ID)
+	assert.Equal(t, key.Name, keys[0].Name)
+	assert.Equal(t, key.Hash, keys[0].Hash)
+	assert.Equal(t, key.Mount, keys[0].Mount)
+	if assert.NotNil(t, keys[0].ExpiresAt) {
+		assert.True(t, expires.Equal(*keys[0].ExpiresAt))
+	}
+
+	user, other, err := us.GetByStreamKey(hash)
+	require.NoError(t, err, "expected no error")
+	require.NotNil(t, user, "expected user back")
+	require.NotNil(t, other, "expected key back")
+	assert.Equal(t, uid, user.ID)
+	assert.Equal(t, key.ID, other.ID)
+
+	// revoking a key of another user shouldn't work
+	err = us.RevokeStreamKey(uid+1, key.ID)
+	require.Error(t, err, "expected error when revoking someone elses key")
+
+	err = us.RevokeStreamKey(uid, key.ID)
+	require.NoError(t, err, "expected no error")
+
+	_, _, err = us.GetByStreamKey(hash)
+	require.Error(t, err, "expected error for revoked key")
+}
@@ -0,0 +1,62 @@
+package storagetest
+
+import (
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (suite *Suite) TestWebhookDeliveries(t *testing.T) {
+	s := suite.Storage(t)
+	ws := s.Webhook(suite.ctx)
+
+	now := time.Now().Truncate(time.Second)
+	failed := radio.WebhookDelivery{
+		Event:      "song",
+		URL:        "https://example.com/hook",
+		Payload:    `{"event":"song"}`,
+		StatusCode: 500,
+		Error:      "unexpected status code",
+		Attempts:   3,
+		CreatedAt:  now.Add(-time.Minute),
+	}
+	success := radio.WebhookDelivery{
+		Event:      "dj",
+		URL:        "https://example.com/hook",
+		Payload:    `{"event":"dj"}`,
+		StatusCode: 200,
+		Attempts:   1,
+		CreatedAt:  now,
+	}
+
+	for _, delivery := range []radio.WebhookDelivery{failed, success} {
+		_, err := ws.AddDelivery(delivery)
+		require.NoError(t, err)
+	}
+
+	deliveries, err := ws.Deliveries(10, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, deliveries.Total)
+	require.Len(t, deliveries.Entries, 2)
+
+	// newest should be first
+	got := deliveries.Entries[0]
+	assert.Equal(t, success.Event, got.Event)
+	assert.Equal(t, success.URL, got.URL)
+	assert.Equal(t, success.Payload, got.Payload)
+	assert.Equal(t, success.StatusCode, got.StatusCode)
+	assert.Equal(t, success.Attempts, got.Attempts)
+	assert.True(t, got.Success())
+
+	got = deliveries.Entries[1]
+	assert.Equal(t, failed.Error, got.Error)
+	assert.False(t, got.Success())
+
+	deliveries, err = ws.Deliveries(1, 1)
+	require.NoError(t, err)
+	require.Len(t, deliveries.Entries, 1)
+	assert.Equal(t, failed.Event, deliveries.Entries[0].Event)
+}
@@ -3,11 +3,11 @@ package streamer
 import (
 	"context"
 	"sync"
-	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/policy"
 	"github.com/R-a-dio/valkyrie/rpc"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
@@ -24,6 +24,7 @@ func NewGRPCServer(ctx context.Context, cfg config.Config, storage radio.Storage
 		announce: announce,
 		queue:    queue,
 		streamer: streamer,
+		policy:   policy.NewRequestPolicy(cfg),
 	}
 
 	gs := rpc.NewGrpcServer(ctx)
@@ -40,6 +41,7 @@ type streamerService struct {
 	announce     radio.AnnounceService
 	queue        radio.QueueService
 	streamer     *Streamer
+	policy       policy.RequestPolicy
 	requestMutex sync.Mutex
 }
 
@@ -67,6 +69,17 @@ func (s *streamerService) Stop(ctx context.Context, force bool) error {
 	return nil
 }
 
+// Skip implements radio.StreamerService
+func (s *streamerService) Skip(ctx context.Context) error {
+	const op errors.Op = "streamer/streamerService.Skip"
+
+	err := s.streamer.Skip(ctx)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 // Queue implements radio.StreamerService
 func (s *streamerService) Queue(ctx context.Context) ([]radio.QueueEntry, error) {
 	const op errors.Op = "streamer/streamerService.Queue"
@@ -83,13 +96,14 @@ func (s *streamerService) Queue(ctx context.Context) ([]radio.QueueEntry, error)
 // We do not do authentication or authorization checks, this is left to the client. Request can be
 // either a GET or POST with parameters `track` and `identifier`, where `track` is the track number
 // to be requested, and `identifier` the unique identification used for the user (IP Address, hostname, etc)
-func (s *streamerService) RequestSong(ctx context.Context, song radio.Song, identifier string) error {
+func (s *streamerService) RequestSong(ctx context.Context, song radio.Song, requester radio.Requester) error {
 	const op errors.Op = "streamer/streamerService.RequestSong"
 
 	if !s.Conf().Streamer.RequestsEnabled {
 		return errors.E(op, errors.StreamerNoRequests)
 	}
 
+	identifier := requester.Identifier
 	if identifier == "" {
 		return errors.E(op, errors.InvalidArgument, errors.Info("identifier"))
 	}
@@ -122,27 +136,28 @@ func (s *streamerService) RequestSong(ctx context.Context, song radio.Song, iden
 		}
 		song = *songRefresh
 	}
-	// find the last time this user requested a song
-	userLastRequest, err := rs.LastRequest(identifier)
-	if err != nil {
-		return errors.E(op, err)
+	// check if the track can be decoded by the streamer
+	if !song.Usable {
+		return errors.E(op, errors.SongUnusable, song)
 	}
 
-	// check if the user is allowed to request
-	waitTime, ok := radio.CalculateCooldown(time.Duration(s.Conf().UserRequestDelay), userLastRequest)
-	if !ok {
-		return errors.E(op, errors.UserCooldown, errors.Delay(waitTime), song)
+	queue, err := s.queue.Entries(ctx)
+	if err != nil {
+		return errors.E(op, err)
 	}
 
-	// check if the track can be decoded by the streamer
-	if !song.Usable {
-		return errors.E(op, errors.SongUnusable, song)
+	var queued int
+	for _, entry := range queue {
+		if entry.IsUserRequest {
+			queued++
+		}
 	}
 
-	// check if the track wasn't recently played or requested
-	if !song.Requestable() {
-		d := song.UntilRequestable()
-		return errors.E(op, errors.SongCooldown, errors.Delay(d), song)
+	// check if the user is allowed to request and the track wasn't recently
+	// played or requested
+	err = s.policy.Check(rs, song, requester, queued)
+	if err != nil {
+		return errors.E(op, err)
 	}
 
 	// update the database to represent the request
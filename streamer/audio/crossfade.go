@@ -0,0 +1,138 @@
+package audio
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// FadeCurve returns the gain to apply to audio that is fading in at position
+// x, x goes from 0 (start of the fade) to 1 (end of the fade). The gain for
+// audio fading out is the same curve mirrored, curve(1-x)
+type FadeCurve func(x float64) float64
+
+// LinearCurve is a FadeCurve that changes the gain at a constant rate
+func LinearCurve(x float64) float64 {
+	return x
+}
+
+// EqualPowerCurve is a FadeCurve that keeps the combined power of both
+// tracks constant during the fade, this avoids the dip in loudness you get
+// halfway through a linear fade
+func EqualPowerCurve(x float64) float64 {
+	return math.Sin(x * math.Pi / 2)
+}
+
+// LogCurve is a FadeCurve that rises quickly and flattens out towards the
+// end, this matches how we perceive loudness more closely than LinearCurve
+func LogCurve(x float64) float64 {
+	return math.Log10(1 + 9*x)
+}
+
+// ParseFadeCurve returns the FadeCurve with the name given, an empty name
+// returns the default EqualPowerCurve
+func ParseFadeCurve(name string) (FadeCurve, error) {
+	switch name {
+	case "", "equalpower", "sine":
+		return EqualPowerCurve, nil
+	case "linear":
+		return LinearCurve, nil
+	case "log", "logarithmic":
+		return LogCurve, nil
+	}
+	return nil, fmt.Errorf("unknown fade curve: %q", name)
+}
+
+// Bytes returns the amount of bytes required to hold dur of audio in this
+// format, the result is always aligned to a full frame of samples
+func (af AudioFormat) Bytes(dur time.Duration) int {
+	frame := af.BytesPerSample * af.ChannelCount
+	frames := int(dur * time.Duration(af.SampleRate) / time.Second)
+	return frames * frame
+}
+
+// Duration returns the playback duration of n bytes of audio in this format
+func (af AudioFormat) Duration(n int64) time.Duration {
+	return time.Duration(n) * time.Second /
+		time.Duration(af.BytesPerSample*af.ChannelCount*af.SampleRate)
+}
+
+// Crossfade mixes the end of a track (out) with the start of the next track
+// (in) and returns the mixed audio. Both out and in should be signed 16-bit
+// little-endian interleaved PCM. The fade is spread over the longest of
+// the two, missing audio in the shorter one is treated as silence.
+func Crossfade(out, in []byte, curve FadeCurve) []byte {
+	if curve == nil {
+		curve = EqualPowerCurve
+	}
+
+	length := len(out)
+	if len(in) > length {
+		length = len(in)
+	}
+	length -= length % 2
+
+	mixed := make([]byte, length)
+	samples := length / 2
+	for i := 0; i < samples; i++ {
+		x := float64(i) / float64(samples)
+
+		var v float64
+		if i*2+1 < len(out) {
+			v += float64(int16(binary.LittleEndian.Uint16(out[i*2:]))) * curve(1-x)
+		}
+		if i*2+1 < len(in) {
+			v += float64(int16(binary.LittleEndian.Uint16(in[i*2:]))) * curve(x)
+		}
+
+		binary.LittleEndian.PutUint16(mixed[i*2:], uint16(clampInt16(v)))
+	}
+	return mixed
+}
+
+// TrimLeadingSilence returns p without any leading samples that are at or
+// below threshold, p should be signed 16-bit little-endian interleaved PCM
+// with frameSize bytes per frame
+func TrimLeadingSilence(p []byte, frameSize int, threshold int16) []byte {
+	var i int
+	for ; i+frameSize <= len(p); i += frameSize {
+		if !isSilentFrame(p[i:i+frameSize], threshold) {
+			break
+		}
+	}
+	return p[i:]
+}
+
+// TrimTrailingSilence returns p without any trailing samples that are at or
+// below threshold, p should be signed 16-bit little-endian interleaved PCM
+// with frameSize bytes per frame
+func TrimTrailingSilence(p []byte, frameSize int, threshold int16) []byte {
+	i := len(p) - len(p)%frameSize
+	for ; i-frameSize >= 0; i -= frameSize {
+		if !isSilentFrame(p[i-frameSize:i], threshold) {
+			break
+		}
+	}
+	return p[:i]
+}
+
+func isSilentFrame(frame []byte, threshold int16) bool {
+	for i := 0; i+1 < len(frame); i += 2 {
+		v := int16(binary.LittleEndian.Uint16(frame[i:]))
+		if v > threshold || v < -threshold {
+			return false
+		}
+	}
+	return true
+}
+
+func clampInt16(v float64) int16 {
+	if v > math.MaxInt16 {
+		return math.MaxInt16
+	}
+	if v < math.MinInt16 {
+		return math.MinInt16
+	}
+	return int16(math.Round(v))
+}
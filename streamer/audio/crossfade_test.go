@@ -0,0 +1,64 @@
+package audio
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+func pcmSamples(samples ...int16) []byte {
+	p := make([]byte, len(samples)*2)
+	for i, v := range samples {
+		binary.LittleEndian.PutUint16(p[i*2:], uint16(v))
+	}
+	return p
+}
+
+func TestCrossfade(t *testing.T) {
+	out := pcmSamples(1000, 1000, 1000, 1000)
+	in := pcmSamples(2000, 2000, 2000, 2000)
+
+	mixed := Crossfade(out, in, LinearCurve)
+	if len(mixed) != len(out) {
+		t.Fatalf("unexpected length: %d != %d", len(mixed), len(out))
+	}
+
+	expected := []int16{1000, 1250, 1500, 1750}
+	for i, e := range expected {
+		v := int16(binary.LittleEndian.Uint16(mixed[i*2:]))
+		if v != e {
+			t.Errorf("sample %d: %d != %d", i, v, e)
+		}
+	}
+
+	// a shorter start of the next track should be treated as silence
+	mixed = Crossfade(out, in[:2], LinearCurve)
+	if len(mixed) != len(out) {
+		t.Fatalf("unexpected length with short input: %d != %d", len(mixed), len(out))
+	}
+}
+
+func TestTrimSilence(t *testing.T) {
+	p := pcmSamples(0, 1, 0, 0, 500, -500, 0, 2, 0, 0)
+
+	lead := TrimLeadingSilence(p, 4, 8)
+	if len(lead) != len(p)-8 {
+		t.Errorf("leading: unexpected length %d", len(lead))
+	}
+
+	trail := TrimTrailingSilence(p, 4, 8)
+	if len(trail) != len(p)-8 {
+		t.Errorf("trailing: unexpected length %d", len(trail))
+	}
+}
+
+func TestAudioFormatBytes(t *testing.T) {
+	af := AudioFormat{ChannelCount: 2, BytesPerSample: 2, SampleRate: 44100}
+
+	if n := af.Bytes(time.Second); n != 44100*4 {
+		t.Errorf("one second: %d != %d", n, 44100*4)
+	}
+	if n := af.Bytes(time.Millisecond); n%4 != 0 {
+		t.Errorf("not frame aligned: %d", n)
+	}
+}
@@ -0,0 +1,123 @@
+package audio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+)
+
+// Codec describes an output format we can encode to with EncodeStream
+type Codec struct {
+	// Name is the name used in configuration
+	Name string
+	// ContentType is the mime type of the encoded stream
+	ContentType string
+	// Ogg is set for codecs that use an Ogg container, icecast doesn't accept
+	// metadata updates for these so the metadata has to be in the stream
+	Ogg bool
+	// args are the ffmpeg output arguments
+	args []string
+}
+
+var codecs = map[string]Codec{
+	"mp3": {
+		Name:        "mp3",
+		ContentType: "audio/mpeg",
+		args:        []string{"-c:a", "libmp3lame", "-f", "mp3"},
+	},
+	"opus": {
+		Name:        "opus",
+		ContentType: "audio/ogg",
+		Ogg:         true,
+		args:        []string{"-c:a", "libopus", "-ar", "48000", "-f", "ogg"},
+	},
+	"vorbis": {
+		Name:        "vorbis",
+		ContentType: "audio/ogg",
+		Ogg:         true,
+		args:        []string{"-c:a", "libvorbis", "-f", "ogg"},
+	},
+	"aac": {
+		Name:        "aac",
+		ContentType: "audio/aac",
+		args:        []string{"-c:a", "aac", "-f", "adts"},
+	},
+}
+
+// ParseCodec returns the Codec with the name given
+func ParseCodec(name string) (Codec, error) {
+	c, ok := codecs[name]
+	if !ok {
+		return Codec{}, fmt.Errorf("unknown codec: %q", name)
+	}
+	return c, nil
+}
+
+// EncodeStream is a long running encoder process, PCM audio written to it is
+// encoded and can be read back in the format of the codec used
+type EncodeStream struct {
+	cmd    *exec.Cmd
+	stderr *bytes.Buffer
+
+	io.WriteCloser
+	io.ReadCloser
+}
+
+// NewEncodeStream starts an ffmpeg process that encodes signed 16-bit
+// little-endian PCM in the format given to codec at bitrate (in kbps). If title
+// isn't empty it is stored in the metadata of the stream, for Ogg this is the
+// comment header. The process exits when ctx is canceled or Close is called
+func NewEncodeStream(ctx context.Context, af AudioFormat, codec Codec, bitrate int, title string) (*EncodeStream, error) {
+	args := []string{
+		"-hide_banner",
+		"-loglevel", "error",
+		"-f", "s16le",
+		"-ac", strconv.Itoa(af.ChannelCount),
+		"-ar", strconv.Itoa(af.SampleRate),
+		"-i", "-",
+		"-b:a", strconv.Itoa(bitrate) + "k",
+	}
+	args = append(args, codec.args...)
+	if title != "" {
+		args = append(args, "-metadata", "title="+title)
+	}
+	args = append(args, "-")
+
+	var es = &EncodeStream{
+		cmd:    exec.CommandContext(ctx, "ffmpeg", args...),
+		stderr: new(bytes.Buffer),
+	}
+	es.cmd.Stderr = es.stderr
+
+	var err error
+	es.WriteCloser, err = es.cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	es.ReadCloser, err = es.cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = es.cmd.Start(); err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
+// Close closes the input of the encoder and waits for it to exit, any output
+// not yet read is discarded
+func (es *EncodeStream) Close() error {
+	_ = es.WriteCloser.Close()
+	// drain the output so the process doesn't block on us
+	_, _ = io.Copy(io.Discard, es.ReadCloser)
+
+	err := es.cmd.Wait()
+	if err != nil {
+		return fmt.Errorf("encode error: %w: %s", err, es.stderr.String())
+	}
+	return nil
+}
@@ -2,18 +2,51 @@ package audio
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"os/exec"
+	"strconv"
+
+	radio "github.com/R-a-dio/valkyrie"
+)
+
+const (
+	// target loudness in LUFs (Loudness Units Full Scale)
+	loudnormI = -14
+	// true peak
+	loudnormTP = 0
+	// loudness range, this describes the overall loduness range,
+	// from the softest part to the loudest part.
+	loudnormLRA = 11
 )
 
+var loudnormSettings = fmt.Sprintf("I=%d:TP=%d:LRA=%d", loudnormI, loudnormTP, loudnormLRA)
+
+// ErrInvalidLoudness is returned by AnalyzeLoudness if the analysis returned
+// values that can't be used for normalization, this happens for example with
+// tracks that are pure silence
+var ErrInvalidLoudness = errors.New("invalid loudness analysis")
+
+// DecodeFileGain decodes the audio filepath given and returns a PCMBuffer with
+// the audio normalized, this first runs an analysis pass over the file
 func DecodeFileGain(path string) (*PCMBuffer, error) {
-	cmd, buf, err := newFFmpegWithReplaygain(path)
+	loudness, err := AnalyzeLoudness(context.Background(), path)
 	if err != nil {
 		return nil, err
 	}
 
-	err = cmd.Start()
+	return DecodeFileLoudness(path, loudness, Cue{})
+}
+
+// DecodeFileLoudness is like DecodeFileGain but uses the loudness analysis given
+// instead of running one itself, only the part of the file within cue is decoded
+func DecodeFileLoudness(path string, loudness radio.Loudness, cue Cue) (*PCMBuffer, error) {
+	cmd, buf := newFFmpegWithLoudness(path, loudness, cue)
+
+	err := cmd.Start()
 	if err != nil {
 		return nil, err
 	}
@@ -35,61 +68,67 @@ func DecodeFileGain(path string) (*PCMBuffer, error) {
 	return buf, nil
 }
 
-func newFFmpegWithReplaygain(filename string) (*exec.Cmd, *PCMBuffer, error) {
-	const (
-		// target loudness in LUFs (Loudness Units Full Scale)
-		I = -14
-		// true peak
-		TP = 0
-		// loudness range, this describes the overall loduness range,
-		// from the softest part to the loudest part.
-		LRA = 11
-	)
-	var settings = fmt.Sprintf("I=%d:TP=%d:LRA=%d", I, TP, LRA)
-	// analyze track first
+// AnalyzeLoudness runs the analysis pass of the ffmpeg loudnorm filter over
+// the file given and returns the measured values
+func AnalyzeLoudness(ctx context.Context, filename string) (radio.Loudness, error) {
 	args := []string{
 		"-hide_banner",
 		"-i", filename,
 		"-map", "0:a:0",
-		"-af", "loudnorm=print_format=json:" + settings,
+		"-af", "loudnorm=print_format=json:" + loudnormSettings,
 		"-f", "null",
 		"-",
 	}
 
 	output := new(bytes.Buffer)
-	cmd := exec.Command("ffmpeg", args...)
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
 	cmd.Stderr = output
 	cmd.Stdout = new(bytes.Buffer) // we throw this away, but supply a buffer to be sure
 
 	err := cmd.Run()
 	if err != nil {
-		return nil, nil, err
+		return radio.Loudness{}, &DecodeError{
+			Err:       err,
+			ExtraInfo: output.String(),
+		}
 	}
 
 	b := output.Bytes()
 	last := bytes.LastIndex(b, []byte("{"))
-	b = b[last-1:]
-
-	// TODO: log results of the replaygain calculation maybe
+	if last < 0 {
+		return radio.Loudness{}, ErrInvalidLoudness
+	}
+	b = b[last:]
 
-	// do things with output
 	var info = new(replaygainInfo)
 
 	err = json.Unmarshal(b, info)
 	if err != nil {
-		return nil, nil, err
+		return radio.Loudness{}, err
 	}
 
-	// prepare arguments for second pass
-	var replayinfo = "loudnorm=linear=true:" + settings
+	return info.Loudness()
+}
+
+// newFFmpegWithLoudness prepares a new ffmpeg process for decoding the filename
+// given with linear normalization using the loudness given
+func newFFmpegWithLoudness(filename string, l radio.Loudness, cue Cue) (*exec.Cmd, *PCMBuffer) {
+	f := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', 2, 64)
+	}
+
+	var replayinfo = "loudnorm=linear=true:" + loudnormSettings
 	replayinfo += fmt.Sprintf(":measured_I=%s:measured_LRA=%s:measured_TP=%s",
-		info.InputI, info.InputLra, info.InputTp)
+		f(l.Integrated), f(l.Range), f(l.TruePeak))
 	replayinfo += fmt.Sprintf(":measured_thresh=%s:offset=%s",
-		info.InputThresh, info.TargetOffset)
+		f(l.Threshold), f(l.Offset))
 
-	args = []string{
+	args := []string{
 		"-hide_banner",
 		"-loglevel", "error",
+	}
+	args = append(args, cue.args()...)
+	args = append(args,
 		"-i", filename,
 		"-af", replayinfo,
 		"-f", "s16le",
@@ -97,15 +136,15 @@ func newFFmpegWithReplaygain(filename string) (*exec.Cmd, *PCMBuffer, error) {
 		"-ar", "44100",
 		"-acodec", "pcm_s16le",
 		"-",
-	}
+	)
 
 	// prepare the os/exec command and give us access to output pipes
-	cmd = exec.Command("ffmpeg", args...)
+	cmd := exec.Command("ffmpeg", args...)
 	cmd.Stdout = NewPCMBuffer(AudioFormat{2, 2, 44100})
 	// stderr is only used when an error is reported by exec.Cmd
 	cmd.Stderr = new(bytes.Buffer)
 
-	return cmd, cmd.Stdout.(*PCMBuffer), nil
+	return cmd, cmd.Stdout.(*PCMBuffer)
 }
 
 type replaygainInfo struct {
@@ -120,3 +159,31 @@ type replaygainInfo struct {
 	NormalizationType string `json:"normalization_type"`
 	TargetOffset      string `json:"target_offset"`
 }
+
+// Loudness returns the measured input values as a radio.Loudness
+func (ri replaygainInfo) Loudness() (radio.Loudness, error) {
+	var l radio.Loudness
+	var err error
+
+	for _, v := range []struct {
+		dst *float64
+		src string
+	}{
+		{&l.Integrated, ri.InputI},
+		{&l.Range, ri.InputLra},
+		{&l.TruePeak, ri.InputTp},
+		{&l.Threshold, ri.InputThresh},
+		{&l.Offset, ri.TargetOffset},
+	} {
+		*v.dst, err = strconv.ParseFloat(v.src, 64)
+		if err != nil {
+			return radio.Loudness{}, err
+		}
+		// silence results in infinite values, we can't do anything with those
+		if math.IsInf(*v.dst, 0) || math.IsNaN(*v.dst) {
+			return radio.Loudness{}, ErrInvalidLoudness
+		}
+	}
+
+	return l, nil
+}
@@ -0,0 +1,165 @@
+package audio
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ThresholdFromDB converts a level in dBFS to a signed 16-bit sample value
+func ThresholdFromDB(db float64) int16 {
+	return clampInt16(math.MaxInt16 * math.Pow(10, db/20))
+}
+
+// Cue is the part of a file that should be played, a zero Out means the file
+// is played until the end
+type Cue struct {
+	In  time.Duration
+	Out time.Duration
+}
+
+// args returns the ffmpeg input arguments for the cue
+func (c Cue) args() []string {
+	var args []string
+	if c.In > 0 {
+		args = append(args, "-ss", formatSeconds(c.In))
+	}
+	if c.Out > 0 {
+		args = append(args, "-to", formatSeconds(c.Out))
+	}
+	return args
+}
+
+// SilenceTrimmer is an io.Reader that removes silence from signed 16-bit
+// little-endian PCM. Silence at the start and at the end of the stream is
+// removed, silence is only acted on if it lasts at least the minimum duration
+// given. Silence in the middle of the stream is kept as-is
+type SilenceTrimmer struct {
+	r         io.Reader
+	af        AudioFormat
+	frameSize int
+	threshold int16
+	min       int64
+
+	buf     []byte
+	partial []byte
+	// held is the silence we're holding back until we know if it's long
+	// enough to act on, or if it runs to the end of the stream
+	held []byte
+	out  []byte
+	next []byte
+
+	started bool
+	// pos is the amount of bytes we've processed
+	pos int64
+	// run is the length of the current silence in bytes
+	run    int64
+	cueIn  int64
+	cueOut int64
+	err    error
+}
+
+// NewSilenceTrimmer returns a SilenceTrimmer reading from r, samples at or
+// below threshold are considered silence
+func NewSilenceTrimmer(r io.Reader, af AudioFormat, threshold int16, min time.Duration) *SilenceTrimmer {
+	return &SilenceTrimmer{
+		r:         r,
+		af:        af,
+		frameSize: af.BytesPerSample * af.ChannelCount,
+		threshold: threshold,
+		min:       int64(af.Bytes(min)),
+		buf:       make([]byte, 1024*64),
+	}
+}
+
+func (st *SilenceTrimmer) Read(p []byte) (int, error) {
+	for len(st.out) == 0 {
+		if st.err != nil {
+			return 0, st.err
+		}
+
+		st.out = st.next[:0]
+		n, err := st.r.Read(st.buf)
+		st.process(st.buf[:n])
+		if st.err == nil && err != nil {
+			if err == io.EOF {
+				st.finish()
+			}
+			st.err = err
+		}
+		st.next = st.out
+	}
+
+	n := copy(p, st.out)
+	st.out = st.out[n:]
+	return n, nil
+}
+
+// process handles the data read from the underlying reader
+func (st *SilenceTrimmer) process(data []byte) {
+	data = append(st.partial, data...)
+
+	for len(data) >= st.frameSize {
+		frame := data[:st.frameSize]
+		data = data[st.frameSize:]
+		st.pos += int64(st.frameSize)
+
+		if isSilentFrame(frame, st.threshold) {
+			st.run += int64(st.frameSize)
+			if !st.started && st.run >= st.min {
+				// still at the start, so skip it
+				st.held = st.held[:0]
+				continue
+			}
+			// we only know what to do with silence after the start once we
+			// see either more audio or the end of the stream
+			st.held = append(st.held, frame...)
+			continue
+		}
+
+		if !st.started && st.run >= st.min {
+			st.cueIn = st.pos - int64(st.frameSize)
+		}
+		st.started = true
+		st.run = 0
+		st.out = append(st.out, st.held...)
+		st.out = append(st.out, frame...)
+		st.held = st.held[:0]
+	}
+
+	st.partial = append(st.partial[:0], data...)
+}
+
+// finish is called when the underlying reader is done
+func (st *SilenceTrimmer) finish() {
+	if st.started && st.run >= st.min {
+		// the silence runs to the end, so this is where the track ends
+		st.cueOut = st.pos - st.run
+		st.held, st.partial = nil, nil
+		return
+	}
+
+	// the silence at the end was too short to act on, so keep it
+	st.out = append(st.out, st.held...)
+	st.out = append(st.out, st.partial...)
+	st.held, st.partial = nil, nil
+	st.cueOut = st.pos
+}
+
+// Cue returns the cue points found, ok is false if the stream wasn't read
+// to the end or contained nothing but silence
+func (st *SilenceTrimmer) Cue() (cue Cue, ok bool) {
+	if st.err != io.EOF || !st.started {
+		return Cue{}, false
+	}
+	return Cue{
+		In:  st.af.Duration(st.cueIn),
+		Out: st.af.Duration(st.cueOut),
+	}, true
+}
+
+// formatSeconds formats d as seconds for use in ffmpeg arguments
+func formatSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
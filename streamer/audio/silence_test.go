@@ -0,0 +1,116 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+)
+
+// pcmTone returns dur of stereo s16le audio with every sample set to v
+func pcmTone(af AudioFormat, dur time.Duration, v int16) []byte {
+	p := make([]byte, af.Bytes(dur))
+	for i := 0; i+1 < len(p); i += 2 {
+		binary.LittleEndian.PutUint16(p[i:], uint16(v))
+	}
+	return p
+}
+
+func TestSilenceTrimmer(t *testing.T) {
+	af := AudioFormat{2, 2, 44100}
+	min := time.Second * 2
+
+	sound := pcmTone(af, time.Second*3, 5000)
+	short := pcmTone(af, time.Second, 0)
+	long := pcmTone(af, time.Second*4, 0)
+
+	join := func(parts ...[]byte) []byte {
+		return bytes.Join(parts, nil)
+	}
+
+	cases := []struct {
+		name     string
+		in       []byte
+		expected []byte
+		cue      Cue
+	}{
+		{
+			name:     "no silence",
+			in:       sound,
+			expected: sound,
+			cue:      Cue{Out: time.Second * 3},
+		},
+		{
+			name:     "short silence is kept",
+			in:       join(short, sound, short, sound, short),
+			expected: join(short, sound, short, sound, short),
+			cue:      Cue{Out: time.Second * 9},
+		},
+		{
+			name:     "long leading and trailing silence",
+			in:       join(long, sound, long),
+			expected: sound,
+			cue:      Cue{In: time.Second * 4, Out: time.Second * 7},
+		},
+		{
+			name:     "long silence in the middle is kept",
+			in:       join(sound, long, sound),
+			expected: join(sound, long, sound),
+			cue:      Cue{Out: time.Second * 10},
+		},
+		{
+			name:     "long silence in the middle and at the end",
+			in:       join(sound, long, sound, long),
+			expected: join(sound, long, sound),
+			cue:      Cue{Out: time.Second * 10},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			st := NewSilenceTrimmer(bytes.NewReader(c.in), af, 8, min)
+
+			out, err := io.ReadAll(st)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(out, c.expected) {
+				t.Errorf("output length %d, expected %d", len(out), len(c.expected))
+			}
+
+			cue, ok := st.Cue()
+			if !ok {
+				t.Fatal("expected cue points")
+			}
+			if cue != c.cue {
+				t.Errorf("cue %+v, expected %+v", cue, c.cue)
+			}
+		})
+	}
+}
+
+func TestSilenceTrimmerOnlySilence(t *testing.T) {
+	af := AudioFormat{2, 2, 44100}
+
+	st := NewSilenceTrimmer(bytes.NewReader(pcmTone(af, time.Second*5, 0)), af, 8, time.Second)
+	out, err := io.ReadAll(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != 0 {
+		t.Errorf("expected no output, got %d bytes", len(out))
+	}
+	if _, ok := st.Cue(); ok {
+		t.Error("expected no cue points for a silent track")
+	}
+}
+
+func TestThresholdFromDB(t *testing.T) {
+	if v := ThresholdFromDB(0); v != 32767 {
+		t.Errorf("0 dBFS: %d != 32767", v)
+	}
+	if v := ThresholdFromDB(-60); v != 33 {
+		t.Errorf("-60 dBFS: %d != 33", v)
+	}
+}
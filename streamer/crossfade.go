@@ -0,0 +1,168 @@
+package streamer
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+)
+
+// gaplessWindow is the amount of audio looked at for silence at the end and
+// start of a track when using gapless transitions
+const gaplessWindow = time.Second * 5
+
+// transition is the configuration used to go from one track to the next
+type transition struct {
+	// size is the amount of PCM bytes involved in the transition
+	size      int
+	frameSize int
+	curve     audio.FadeCurve
+	gapless   bool
+	// threshold is the sample value at or below which we consider audio to
+	// be silence when using gapless transitions
+	threshold int16
+	noFade    []string
+}
+
+// newTransition returns the transition as configured currently
+func (s *Streamer) newTransition() transition {
+	cfg := s.Conf().Streamer.Crossfade
+
+	curve, err := audio.ParseFadeCurve(cfg.Curve)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("invalid crossfade curve, using default")
+		curve = audio.EqualPowerCurve
+	}
+
+	t := transition{
+		size:      s.AudioFormat.Bytes(time.Duration(cfg.Length)),
+		frameSize: s.AudioFormat.BytesPerSample * s.AudioFormat.ChannelCount,
+		curve:     curve,
+		gapless:   cfg.Gapless,
+		threshold: audio.ThresholdFromDB(s.Conf().Streamer.Silence.Threshold),
+		noFade:    cfg.NoFadeTags,
+	}
+	if t.gapless {
+		t.size = s.AudioFormat.Bytes(gaplessWindow)
+	}
+	return t
+}
+
+// enabled returns true if there is any kind of transition to do
+func (t transition) enabled() bool {
+	return t.size > 0
+}
+
+// skip returns true if the track given should not transition at all, this is
+// the case for tracks that have one of the no-fade tags
+func (t transition) skip(track streamerTrack) bool {
+	if track.track.DatabaseTrack == nil {
+		return false
+	}
+
+	for _, tag := range strings.Fields(track.track.Tags) {
+		for _, noFade := range t.noFade {
+			if strings.EqualFold(tag, noFade) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// head reads the start of the next track from r, it returns the audio that
+// should be mixed into the previous track, the reader to use for the rest
+// of the next track and the amount of bytes of the next track that won't be
+// played by itself anymore
+func (t transition) head(next streamerTrack, r io.Reader) ([]byte, io.Reader, int) {
+	if t.skip(next) {
+		return nil, r, 0
+	}
+
+	head := make([]byte, t.size)
+	// a read error here will show up again when the rest of the track is
+	// read, so we just go with whatever we got
+	n, _ := io.ReadFull(r, head)
+	head = head[:n]
+
+	if t.gapless {
+		trimmed := audio.TrimLeadingSilence(head, t.frameSize, t.threshold)
+		return nil, io.MultiReader(bytes.NewReader(trimmed), r), n - len(trimmed)
+	}
+	return head, r, n
+}
+
+// reader returns an io.Reader that holds back the end of r and mixes it with
+// the start of the next track once r returns io.EOF. The start of the next
+// track is retrieved by calling next
+func (t transition) reader(r io.Reader, next func() ([]byte, error)) io.Reader {
+	return &transitionReader{
+		transition: t,
+		r:          r,
+		next:       next,
+		buf:        make([]byte, bufferPCMSize),
+	}
+}
+
+// transitionReader is the io.Reader returned by transition.reader
+type transitionReader struct {
+	transition
+
+	r    io.Reader
+	next func() ([]byte, error)
+	buf  []byte
+	// hold is the data we've held back from r
+	hold []byte
+	// out is the data ready to be returned by Read
+	out []byte
+	eof bool
+}
+
+func (tr *transitionReader) Read(p []byte) (n int, err error) {
+	for len(tr.out) == 0 {
+		if tr.eof {
+			return 0, io.EOF
+		}
+
+		n, err = tr.r.Read(tr.buf)
+		tr.hold = append(tr.hold, tr.buf[:n]...)
+		if err == io.EOF {
+			tr.eof = true
+			if tr.out, err = tr.finish(); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if over := len(tr.hold) - tr.size; over > 0 {
+			tr.out = append(tr.out[:0], tr.hold[:over]...)
+			tr.hold = append(tr.hold[:0], tr.hold[over:]...)
+		}
+	}
+
+	n = copy(p, tr.out)
+	tr.out = tr.out[n:]
+	return n, nil
+}
+
+// finish does the actual transition of the data held back and the start of
+// the next track
+func (tr *transitionReader) finish() ([]byte, error) {
+	head, err := tr.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.gapless {
+		return audio.TrimTrailingSilence(tr.hold, tr.frameSize, tr.threshold), nil
+	}
+	if head == nil {
+		return tr.hold, nil
+	}
+	return audio.Crossfade(tr.hold, head, tr.curve), nil
+}
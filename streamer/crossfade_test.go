@@ -0,0 +1,152 @@
+package streamer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPCM returns frames of stereo PCM with every sample set to v
+func testPCM(frames int, v int16) []byte {
+	p := make([]byte, frames*4)
+	for i := 0; i < len(p); i += 2 {
+		binary.LittleEndian.PutUint16(p[i:], uint16(v))
+	}
+	return p
+}
+
+func testTransition(size int, gapless bool) transition {
+	return transition{
+		size:      size,
+		frameSize: 4,
+		curve:     audio.LinearCurve,
+		gapless:   gapless,
+		threshold: audio.ThresholdFromDB(-60),
+	}
+}
+
+func TestTransitionCrossfade(t *testing.T) {
+	fade := testTransition(400, false)
+
+	a := testPCM(1000, 1000)
+	b := testPCM(1000, 2000)
+
+	var rest io.Reader
+	var consumed int
+	r := fade.reader(bytes.NewReader(a), func() ([]byte, error) {
+		var head []byte
+		head, rest, consumed = fade.head(streamerTrack{}, bytes.NewReader(b))
+		return head, nil
+	})
+
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	// the end of a is mixed with the start of b
+	assert.Len(t, out, len(a))
+	assert.Equal(t, a[:len(a)-400], out[:len(a)-400])
+	assert.NotEqual(t, a[len(a)-400:], out[len(a)-400:])
+
+	require.NotNil(t, rest)
+	remaining, err := io.ReadAll(rest)
+	require.NoError(t, err)
+	assert.Equal(t, 400, consumed)
+	assert.Equal(t, b[400:], remaining)
+}
+
+func TestTransitionGapless(t *testing.T) {
+	fade := testTransition(800, true)
+
+	a := append(testPCM(500, 1000), testPCM(100, 0)...)
+	b := append(testPCM(50, 0), testPCM(500, 2000)...)
+
+	var rest io.Reader
+	var consumed int
+	r := fade.reader(bytes.NewReader(a), func() ([]byte, error) {
+		var head []byte
+		head, rest, consumed = fade.head(streamerTrack{}, bytes.NewReader(b))
+		return head, nil
+	})
+
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	// the trailing silence of a is gone
+	assert.Equal(t, a[:500*4], out)
+
+	require.NotNil(t, rest)
+	remaining, err := io.ReadAll(rest)
+	require.NoError(t, err)
+	// and the leading silence of b, but nothing else
+	assert.Equal(t, 50*4, consumed)
+	assert.Equal(t, b[50*4:], remaining)
+}
+
+func TestTransitionSkip(t *testing.T) {
+	fade := testTransition(400, false)
+	fade.noFade = []string{"nofade"}
+
+	var next streamerTrack
+	next.track.DatabaseTrack = &radio.DatabaseTrack{Tags: "something NoFade"}
+
+	b := testPCM(1000, 2000)
+	head, rest, consumed := fade.head(next, bytes.NewReader(b))
+	assert.Nil(t, head)
+	assert.Zero(t, consumed)
+
+	remaining, err := io.ReadAll(rest)
+	require.NoError(t, err)
+	assert.Equal(t, b, remaining)
+}
+
+// testMP3 returns n frames of silent 128kbps 44.1kHz stereo mp3
+func testMP3(n int) []byte {
+	// MPEG-1 layer 3, 128kbps, 44100Hz, no padding, stereo
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x00})
+	return bytes.Repeat(frame, n)
+}
+
+func TestTrackLength(t *testing.T) {
+	// the length of an mp3 frame in testMP3
+	const frameLength = time.Second * 1152 / 44100
+
+	decoded := frameLength * 100
+	consumed := frameLength * 30
+
+	test := func(t *testing.T, consumedFirst bool) {
+		var tl trackLength
+		mp3 := audio.NewMP3Buffer()
+
+		if consumedFirst {
+			tl.setConsumed(mp3, consumed)
+			tl.setDecoded(mp3, decoded)
+		} else {
+			tl.setDecoded(mp3, decoded)
+			tl.setConsumed(mp3, consumed)
+		}
+
+		_, err := mp3.Write(testMP3(200))
+		assert.ErrorIs(t, err, audio.ErrBufferFull)
+		// the buffer stops at the first frame going over the cap
+		assert.InDelta(t, decoded-consumed, mp3.Length(), float64(frameLength))
+	}
+
+	t.Run("consumed first", func(t *testing.T) { test(t, true) })
+	t.Run("decoded first", func(t *testing.T) { test(t, false) })
+
+	t.Run("not decoded", func(t *testing.T) {
+		var tl trackLength
+		mp3 := audio.NewMP3Buffer()
+		tl.setConsumed(mp3, consumed)
+
+		// no cap is set until decoding is done
+		_, err := mp3.Write(testMP3(200))
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,106 @@
+package streamer
+
+import (
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+)
+
+// errNoJingles is returned by jingleRules.pick if the jingle pool is empty
+var errNoJingles = errors.New("no jingles in pool")
+
+// jingleRules are the rules of when to insert a jingle
+type jingleRules struct {
+	path           string
+	everySongs     int
+	everyDuration  time.Duration
+	beforeRequests bool
+}
+
+// jingleRules returns the jingle rules as configured currently
+func (s *Streamer) jingleRules() jingleRules {
+	cfg := s.Conf().Streamer.Jingles
+
+	return jingleRules{
+		path:           cfg.Path,
+		everySongs:     cfg.EverySongs,
+		everyDuration:  time.Duration(cfg.EveryDuration),
+		beforeRequests: cfg.BeforeRequests,
+	}
+}
+
+// enabled returns true if any of the rules is enabled
+func (jr jingleRules) enabled() bool {
+	if jr.path == "" {
+		return false
+	}
+	return jr.everySongs > 0 || jr.everyDuration > 0 || jr.beforeRequests
+}
+
+// pick returns the path to a random jingle from the pool
+func (jr jingleRules) pick() (string, error) {
+	entries, err := os.ReadDir(jr.path)
+	if err != nil {
+		return "", err
+	}
+
+	var pool []string
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		pool = append(pool, entry.Name())
+	}
+
+	if len(pool) == 0 {
+		return "", errNoJingles
+	}
+	return filepath.Join(jr.path, pool[rand.Intn(len(pool))]), nil
+}
+
+// jingleState keeps track of what has played since the last jingle
+type jingleState struct {
+	// songs is the amount of songs since the last jingle
+	songs int
+	// last is the time the last jingle was inserted
+	last time.Time
+}
+
+// due returns true if a jingle should be inserted before entry
+func (js *jingleState) due(jr jingleRules, entry radio.QueueEntry) bool {
+	if !jr.enabled() {
+		return false
+	}
+
+	switch {
+	case jr.beforeRequests && entry.IsUserRequest:
+		return true
+	case jr.everySongs > 0 && js.songs >= jr.everySongs:
+		return true
+	case jr.everyDuration > 0 && time.Since(js.last) >= jr.everyDuration:
+		return true
+	}
+	return false
+}
+
+// reset should be called when a jingle was inserted
+func (js *jingleState) reset() {
+	js.songs = 0
+	js.last = time.Now()
+}
+
+// newJingleTrack returns a track for the jingle at path, jingles have no
+// queue entry and are not announced
+func newJingleTrack(track streamerTrack, path string) streamerTrack {
+	track.jingle = true
+	track.filepath = path
+	track.track = radio.QueueEntry{
+		Song: radio.NewSong(filepath.Base(path)),
+	}
+	return track
+}
@@ -20,7 +20,7 @@ func Execute(ctx context.Context, cfg config.Config) error {
 		return err
 	}
 
-	streamer, err := NewStreamer(ctx, cfg, queue)
+	streamer, err := NewStreamer(ctx, cfg, queue, store)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,433 @@
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/cenkalti/backoff"
+	"github.com/rs/zerolog"
+)
+
+// mountQueueSize is the amount of tracks a mount can fall behind on the main
+// stream before it starts skipping tracks
+const mountQueueSize = 2
+
+// mount is an extra output of the streamer, it gets the same audio as the main
+// stream but encodes it with its own encoder and sends it to its own sink
+type mount struct {
+	logger *zerolog.Logger
+
+	name    string
+	sink    Sink
+	codec   audio.Codec
+	bitrate int
+
+	audioFormat audio.AudioFormat
+
+	// tracks receives the tracks to play from the pipeline
+	tracks chan streamerTrack
+	// meta receives metadata to send to icecast
+	meta chan string
+	// lastMeta is the last metadata we've received, for when we need to
+	// send it again after a reconnect
+	lastMeta atomic.Value
+	// restart is set when an Ogg mount reconnected and needs to start a new
+	// logical stream so that the listeners get the stream headers
+	restart atomic.Bool
+}
+
+// newMounts returns the extra mounts as configured currently, mounts with an
+// invalid configuration are logged and skipped
+func (s *Streamer) newMounts() []*mount {
+	var mounts []*mount
+
+	for _, mc := range s.Conf().Streamer.Mounts {
+		logger := s.logger.With().Str("mount", mc.Name).Logger()
+
+		codec, err := audio.ParseCodec(mc.Codec)
+		if err != nil {
+			logger.Error().Err(err).Msg("invalid mount configuration")
+			continue
+		}
+
+		sink, err := NewIcecastSink(mc.URL, codec.ContentType, s.Conf().UserAgent)
+		if err != nil {
+			logger.Error().Err(err).Msg("invalid mount configuration")
+			continue
+		}
+
+		mounts = append(mounts, &mount{
+			logger:      &logger,
+			name:        mc.Name,
+			sink:        sink,
+			codec:       codec,
+			bitrate:     mc.Bitrate,
+			audioFormat: s.AudioFormat,
+			tracks:      make(chan streamerTrack, mountQueueSize),
+			meta:        make(chan string, 1),
+		})
+	}
+
+	return mounts
+}
+
+// streamToMounts hands each track to the extra mounts, mounts that are too
+// far behind skip the track
+func (s *Streamer) streamToMounts(task streamerTask) error {
+	for {
+		var track streamerTrack
+
+		select {
+		case track = <-task.in:
+		case <-task.Done():
+			return nil
+		}
+
+		if track.fanout != nil {
+			for _, m := range s.mounts {
+				select {
+				case m.tracks <- track:
+				default:
+					m.logger.Warn().Str("metadata", track.track.Metadata).Msg("mount is behind, skipping track")
+				}
+			}
+		}
+
+		select {
+		case task.out <- track:
+		case <-task.Done():
+			return nil
+		}
+	}
+}
+
+// runForever runs the mount until ctx is canceled, restarting it with a
+// backoff when it stops because of an error
+func (m *mount) runForever(ctx context.Context) {
+	boff := config.NewConnectionBackoff(ctx)
+
+	for {
+		err := m.run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		d := boff.NextBackOff()
+		m.logger.Error().Err(err).Dur("backoff", d).Msg("mount error")
+
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// run runs the mount until ctx is canceled or an error occurs, this includes
+// the connection to the sink failing in a way that can't be retried
+func (m *mount) run(ctx context.Context) error {
+	// cancel everything, including the encoder, when either side of the mount
+	// stops so that we never block on an encoder nobody is reading from
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var enc trackEncoder
+	var out io.Reader
+	if m.codec.Ogg {
+		// icecast ignores metadata updates for Ogg, so each track gets its
+		// own logical stream with the metadata in its comment header
+		pr, pw := io.Pipe()
+		defer pr.Close()
+		enc, out = &oggEncoder{ctx: ctx, m: m, w: pw}, pr
+	} else {
+		es, err := audio.NewEncodeStream(ctx, m.audioFormat, m.codec, m.bitrate, "")
+		if err != nil {
+			return err
+		}
+		enc, out = streamEncoder{es}, es
+		go m.metadataToSink(ctx)
+	}
+	defer func() {
+		// cancel first so that the sink isn't waiting for the encoder to
+		// finish while the encoder waits on the sink
+		cancel()
+		enc.Close()
+	}()
+
+	var sinkErr = make(chan error, 1)
+	go func() { // exit on context cancellation or sink failure
+		defer cancel()
+		err := m.streamToSink(ctx, out)
+		if pr, ok := out.(*io.PipeReader); ok {
+			// unblock an Ogg encoder writing to us
+			pr.CloseWithError(err)
+		}
+		sinkErr <- err
+	}()
+
+	// stopped returns the reason the mount stopped given the error we ran
+	// into ourselves, a failed sink takes precedence since it is what
+	// caused our own error in that case
+	var stopped = func(err error) error {
+		if parent.Err() != nil {
+			return nil
+		}
+		select {
+		case serr := <-sinkErr:
+			if serr != nil {
+				return serr
+			}
+		default:
+		}
+		return err
+	}
+
+	var buf = make([]byte, bufferPCMSize)
+	var bufferEnd time.Time
+	var bufferLen = time.Second * 2
+	// skipped is set if the previous track was skipped
+	var skipped bool
+
+	for {
+		var track streamerTrack
+
+		select {
+		case track = <-m.tracks:
+		case <-ctx.Done():
+			return stopped(nil)
+		}
+
+		if !track.jingle {
+			m.setMetadata(track.track.Metadata)
+		}
+		title, _ := m.lastMeta.Load().(string)
+		if err := enc.startTrack(title); err != nil {
+			return stopped(err)
+		}
+
+		var pcm io.Reader = track.fanout.Reader()
+		if skipped && len(track.head) > 0 {
+			// the start of this track was mixed into the end of the track
+			// we skipped, so play it by itself first
+			pcm = io.MultiReader(bytes.NewReader(track.head), pcm)
+		}
+
+		skipped = false
+		for ctx.Err() == nil {
+			if track.skip.skipped() {
+				skipped = true
+				break
+			}
+
+			n, err := pcm.Read(buf)
+			if err != nil && n == 0 {
+				break
+			}
+
+			// pace ourselves the same way the main stream does, but based on
+			// the pcm data since we don't know what the encoder outputs
+			if bufferEnd.Before(time.Now()) {
+				bufferEnd = time.Now()
+			}
+			bufferEnd = bufferEnd.Add(m.audioFormat.Duration(int64(n)))
+
+			_, err = enc.Write(buf[:n])
+			if err != nil {
+				return stopped(err)
+			}
+
+			time.Sleep(time.Until(bufferEnd) - bufferLen)
+
+			if m.restart.CompareAndSwap(true, false) {
+				if err := enc.startTrack(title); err != nil {
+					return stopped(err)
+				}
+			}
+		}
+	}
+}
+
+// trackEncoder is the encoder of a mount
+type trackEncoder interface {
+	io.Writer
+	// startTrack is called before the audio of each track is written, with
+	// the metadata of the track
+	startTrack(title string) error
+	io.Closer
+}
+
+// streamEncoder is a trackEncoder that uses a single encoder for all tracks
+type streamEncoder struct {
+	*audio.EncodeStream
+}
+
+func (streamEncoder) startTrack(string) error { return nil }
+
+// oggEncoder is a trackEncoder that starts a new encoder for each track and
+// writes their output after each other to w, this chains the logical streams
+// of each track with their own comment header
+type oggEncoder struct {
+	ctx context.Context
+	m   *mount
+	w   *io.PipeWriter
+
+	enc *audio.EncodeStream
+	// copied receives the result of copying the output of enc to w
+	copied chan error
+}
+
+func (oe *oggEncoder) startTrack(title string) error {
+	if err := oe.finish(); err != nil {
+		return err
+	}
+
+	enc, err := audio.NewEncodeStream(oe.ctx, oe.m.audioFormat, oe.m.codec, oe.m.bitrate, title)
+	if err != nil {
+		return err
+	}
+	oe.enc = enc
+	oe.copied = make(chan error, 1)
+	go func() {
+		_, err := io.Copy(oe.w, enc.ReadCloser)
+		oe.copied <- err
+	}()
+	return nil
+}
+
+func (oe *oggEncoder) Write(p []byte) (int, error) {
+	return oe.enc.Write(p)
+}
+
+// finish ends the logical stream of the current encoder and waits until all
+// of it has been written to w
+func (oe *oggEncoder) finish() error {
+	if oe.enc == nil {
+		return nil
+	}
+	enc := oe.enc
+	oe.enc = nil
+
+	// closing the input makes the encoder flush and exit
+	err := enc.WriteCloser.Close()
+	if cerr := <-oe.copied; err == nil {
+		err = cerr
+	}
+	if cerr := enc.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (oe *oggEncoder) Close() error {
+	err := oe.finish()
+	oe.w.Close()
+	return err
+}
+
+// streamToSink copies the encoder output to the sink of the mount,
+// reconnecting when required
+func (m *mount) streamToSink(ctx context.Context, enc io.Reader) error {
+	var buf = make([]byte, bufferMP3Size)
+	var conn io.WriteCloser
+	// reconnect is set once we've had a connection before
+	var reconnect bool
+
+	var newConn = func() error {
+		c, err := m.sink.Connect(ctx)
+		if err != nil {
+			return err
+		}
+		conn = c
+		return nil
+	}
+	backOff := config.NewConnectionBackoff(ctx)
+
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+
+	for {
+		n, err := enc.Read(buf)
+		if err != nil {
+			return err
+		}
+
+		if conn == nil {
+			err = backoff.RetryNotify(newConn, backOff, func(err error, d time.Duration) {
+				m.logger.Error().Err(err).Dur("backoff", d).Msg("icecast connection failure")
+			})
+			if err != nil {
+				return err
+			}
+
+			if m.codec.Ogg {
+				// the listeners of the new connection need the stream headers
+				// which were send on the previous connection, so start over
+				m.restart.Store(reconnect)
+			} else if meta, ok := m.lastMeta.Load().(string); ok {
+				// since we have a new connection, send the metadata again
+				m.setMetadata(meta)
+			}
+			reconnect = true
+		}
+
+		_, err = conn.Write(buf[:n])
+		if err != nil {
+			conn.Close()
+			conn = nil
+			m.logger.Error().Err(err).Msg("icecast connection failure")
+		}
+	}
+}
+
+// setMetadata queues metadata to be send, replacing any metadata that hasn't
+// been send yet
+func (m *mount) setMetadata(metadata string) {
+	m.lastMeta.Store(metadata)
+
+	select {
+	case <-m.meta:
+	default:
+	}
+	select {
+	case m.meta <- metadata:
+	default:
+	}
+}
+
+// metadataToSink sends metadata received on the meta channel to the sink
+func (m *mount) metadataToSink(ctx context.Context) {
+	// for retrying the metadata request
+	var boff = config.NewConnectionBackoff(ctx)
+	var boffCh <-chan time.Time
+	var metadata string
+
+	for {
+		select {
+		case metadata = <-m.meta:
+			boff.Reset()
+		case <-boffCh:
+		case <-ctx.Done():
+			return
+		}
+
+		// use a timeout so we don't hang on a request for ages
+		reqCtx, cancel := context.WithTimeout(ctx, time.Second*30)
+		err := m.sink.Metadata(reqCtx, metadata)
+		cancel()
+		if err != nil {
+			m.logger.Error().Err(err).Msg("failed to send metadata")
+			// try and retry the operation in a little while
+			boffCh = time.After(boff.NextBackOff())
+		} else {
+			boffCh = nil
+		}
+	}
+}
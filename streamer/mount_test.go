@@ -0,0 +1,306 @@
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/cenkalti/backoff"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFFmpeg puts an ffmpeg on the PATH that outputs its input as-is
+func fakeFFmpeg(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "ffmpeg"), []byte("#!/bin/sh\nexec cat\n"), 0755)
+	require.NoError(t, err)
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+// titleFFmpeg puts an ffmpeg on the PATH that outputs the title metadata it
+// was started with on a line, followed by its input as-is
+func titleFFmpeg(t *testing.T) {
+	dir := t.TempDir()
+	script := `#!/bin/sh
+for arg; do
+	case "$arg" in title=*) printf '%s\n' "$arg";; esac
+done
+exec cat
+`
+	err := os.WriteFile(filepath.Join(dir, "ffmpeg"), []byte(script), 0755)
+	require.NoError(t, err)
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+// testSink is a Sink that calls connect for every connection and keeps
+// everything written to it
+type testSink struct {
+	mu       sync.Mutex
+	connects int
+	connect  func() error
+	written  []byte
+	metadata []string
+}
+
+func (ts *testSink) Connect(ctx context.Context) (io.WriteCloser, error) {
+	ts.mu.Lock()
+	ts.connects++
+	ts.mu.Unlock()
+
+	if err := ts.connect(); err != nil {
+		return nil, err
+	}
+	return nopWriteCloser{ts}, nil
+}
+
+func (ts *testSink) Write(p []byte) (int, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.written = append(ts.written, p...)
+	return len(p), nil
+}
+
+func (ts *testSink) Connects() int {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.connects
+}
+
+// Written returns everything written to the sink so far
+func (ts *testSink) Written() []byte {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return bytes.Clone(ts.written)
+}
+
+func (ts *testSink) Metadata(ctx context.Context, metadata string) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.metadata = append(ts.metadata, metadata)
+	return nil
+}
+
+// MetadataSent returns the metadata send to the sink so far
+func (ts *testSink) MetadataSent() []string {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.metadata
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func newTestMount(sink Sink) *mount {
+	logger := zerolog.Nop()
+	codec, _ := audio.ParseCodec("mp3")
+
+	return &mount{
+		logger:  &logger,
+		name:    "test",
+		sink:    sink,
+		codec:   codec,
+		bitrate: 128,
+		audioFormat: audio.AudioFormat{
+			ChannelCount:   2,
+			BytesPerSample: 2,
+			SampleRate:     44100,
+		},
+		tracks: make(chan streamerTrack, mountQueueSize),
+		meta:   make(chan string, 1),
+	}
+}
+
+// queueTrack gives the mount a track of silence that is long enough to fill
+// up the encoder pipes when nothing reads from them
+func queueTrack(m *mount) {
+	m.tracks <- newFanoutTrack(m, make([]byte, m.audioFormat.Bytes(time.Second*30)))
+}
+
+// newFanoutTrack returns a track for a mount with pcm as its audio
+func newFanoutTrack(m *mount, pcm []byte) streamerTrack {
+	fanout := audio.NewPCMBuffer(m.audioFormat)
+	_, _ = fanout.Write(pcm)
+	_ = fanout.Close()
+	return streamerTrack{jingle: true, fanout: fanout, skip: newTrackSkip()}
+}
+
+func TestMountSinkFailure(t *testing.T) {
+	fakeFFmpeg(t)
+
+	sinkErr := errors.New("sink refused")
+	m := newTestMount(&testSink{connect: func() error {
+		return backoff.Permanent(sinkErr)
+	}})
+	queueTrack(m)
+
+	done := make(chan error, 1)
+	go func() { done <- m.run(context.Background()) }()
+
+	// the sink failing should stop the mount instead of leaving it blocked
+	// on a full encoder
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, sinkErr)
+	case <-time.After(time.Second * 10):
+		t.Fatal("mount didn't stop after the sink failed")
+	}
+}
+
+func TestMountCanceled(t *testing.T) {
+	fakeFFmpeg(t)
+
+	m := newTestMount(&testSink{connect: func() error { return nil }})
+	queueTrack(m)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- m.run(ctx) }()
+
+	time.Sleep(time.Millisecond * 100)
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second * 10):
+		t.Fatal("mount didn't stop after being canceled")
+	}
+}
+
+func TestMountRestart(t *testing.T) {
+	fakeFFmpeg(t)
+
+	sink := &testSink{connect: func() error {
+		return backoff.Permanent(errors.New("sink refused"))
+	}}
+	m := newTestMount(sink)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.runForever(ctx)
+	}()
+
+	// every run needs a track to get the sink to connect
+	for range 2 {
+		queueTrack(m)
+	}
+	assert.Eventually(t, func() bool {
+		return sink.Connects() >= 2
+	}, time.Second*10, time.Millisecond*10, "mount should be restarted")
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second * 10):
+		t.Fatal("mount didn't stop after being canceled")
+	}
+}
+
+func TestMountSkip(t *testing.T) {
+	fakeFFmpeg(t)
+
+	sink := &testSink{connect: func() error { return nil }}
+	m := newTestMount(sink)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go m.run(ctx)
+
+	first := newFanoutTrack(m, testPCM(44100*30, 1000))
+	second := newFanoutTrack(m, testPCM(44100, 2000))
+	// the start of the second track was mixed into the end of the first
+	second.head = testPCM(4410, 3000)
+	m.tracks <- first
+	m.tracks <- second
+
+	require.Eventually(t, func() bool {
+		return len(sink.Written()) > 0
+	}, time.Second*10, time.Millisecond*10, "mount should start playing")
+	first.skip.skip()
+
+	// skipping the first track means the mix never plays, so the second
+	// track should start from its real beginning
+	expected := append(testPCM(4410, 3000), testPCM(44100, 2000)...)
+	require.Eventually(t, func() bool {
+		return bytes.HasSuffix(sink.Written(), expected)
+	}, time.Second*10, time.Millisecond*10, "second track should play with its head")
+	assert.Less(t, len(sink.Written())-len(expected), len(testPCM(44100*30, 1000)))
+}
+
+func TestMountNoSkip(t *testing.T) {
+	fakeFFmpeg(t)
+
+	sink := &testSink{connect: func() error { return nil }}
+	m := newTestMount(sink)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go m.run(ctx)
+
+	second := newFanoutTrack(m, testPCM(4410, 2000))
+	second.head = testPCM(4410, 3000)
+	m.tracks <- newFanoutTrack(m, testPCM(4410, 1000))
+	m.tracks <- second
+
+	// the head was already played as part of the first track
+	expected := append(testPCM(4410, 1000), testPCM(4410, 2000)...)
+	require.Eventually(t, func() bool {
+		return len(sink.Written()) >= len(expected)
+	}, time.Second*10, time.Millisecond*10)
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, expected, sink.Written())
+}
+
+func TestMountOggMetadata(t *testing.T) {
+	titleFFmpeg(t)
+
+	sink := &testSink{connect: func() error { return nil }}
+	m := newTestMount(sink)
+	m.codec, _ = audio.ParseCodec("opus")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go m.run(ctx)
+
+	first := newFanoutTrack(m, testPCM(4410, 1000))
+	first.jingle = false
+	first.track = radio.QueueEntry{Song: radio.Song{Metadata: "first"}}
+	second := newFanoutTrack(m, testPCM(4410, 2000))
+	second.jingle = false
+	second.track = radio.QueueEntry{Song: radio.Song{Metadata: "second"}}
+	m.tracks <- first
+	m.tracks <- second
+	// jingles keep the metadata of the track before them
+	m.tracks <- newFanoutTrack(m, testPCM(4410, 3000))
+
+	// every track should be encoded by its own encoder with the metadata
+	// in its stream
+	var expected []byte
+	expected = append(expected, "title=first\n"...)
+	expected = append(expected, testPCM(4410, 1000)...)
+	expected = append(expected, "title=second\n"...)
+	expected = append(expected, testPCM(4410, 2000)...)
+	expected = append(expected, "title=second\n"...)
+	expected = append(expected, testPCM(4410, 3000)...)
+	require.Eventually(t, func() bool {
+		return len(sink.Written()) >= len(expected)
+	}, time.Second*10, time.Millisecond*10)
+	assert.Equal(t, expected, sink.Written())
+	assert.Empty(t, sink.MetadataSent(), "icecast ignores metadata updates for ogg")
+}
@@ -0,0 +1,191 @@
+package streamer
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+)
+
+// PopulationCandidate is a song that can be added to the queue when it is
+// being populated
+type PopulationCandidate struct {
+	radio.Song
+	// Favorites is the amount of users that have this song as favorite
+	Favorites int64
+}
+
+// PopulationStrategy decides which candidates are picked to populate the
+// queue with
+type PopulationStrategy interface {
+	// Weight returns the weight of the candidate given, the chance of it
+	// being picked is its weight relative to the weights of all candidates.
+	// A weight of zero or less skips the candidate, reason should describe
+	// why it was skipped
+	Weight(queue []radio.QueueEntry, candidate PopulationCandidate) (weight float64, reason string)
+}
+
+// ParsePopulationStrategy returns the strategy with the name given, one of
+// "random", "lastplayed", "popular", "artist-separation" or "tag-balance".
+// separation is the amount of songs used by artist-separation
+func ParsePopulationStrategy(name string, separation int) (PopulationStrategy, error) {
+	switch name {
+	case "", "random":
+		return randomStrategy{}, nil
+	case "lastplayed":
+		return lastPlayedStrategy{}, nil
+	case "popular":
+		return popularStrategy{}, nil
+	case "artist-separation":
+		return artistSeparationStrategy{songs: separation}, nil
+	case "tag-balance":
+		return tagBalanceStrategy{}, nil
+	}
+	return nil, fmt.Errorf("unknown population strategy: %q", name)
+}
+
+// populationStrategy returns the strategy as configured currently, invalid
+// configuration is logged and falls back to random
+func (qs *QueueService) populationStrategy() PopulationStrategy {
+	cfg := qs.Conf().Streamer.Population
+
+	strategy, err := ParsePopulationStrategy(cfg.Strategy, cfg.ArtistSeparation)
+	if err != nil {
+		qs.logger.Error().Err(err).Msg("invalid population strategy, using random")
+		return randomStrategy{}
+	}
+	return strategy
+}
+
+// pickCandidate picks a candidate with the strategy given, it returns the
+// index of the candidate picked, or -1 if all candidates were skipped. The
+// reasons for skipped candidates are returned as well
+func pickCandidate(r *rand.Rand, strategy PopulationStrategy, queue []radio.QueueEntry, candidates []PopulationCandidate) (int, []skipped) {
+	var skipReasons []skipped
+	var weights = make([]float64, len(candidates))
+	var total float64
+
+	for i, c := range candidates {
+		weight, reason := strategy.Weight(queue, c)
+		if weight <= 0 {
+			skipReasons = append(skipReasons, skipped{
+				TrackID: c.TrackID,
+				Reason:  reason,
+			})
+			continue
+		}
+		weights[i] = weight
+		total += weight
+	}
+
+	if total <= 0 {
+		return -1, skipReasons
+	}
+
+	n := r.Float64() * total
+	for i, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		if n < weight {
+			return i, skipReasons
+		}
+		n -= weight
+	}
+
+	// rounding errors can make us end up here, so just return the last
+	// candidate that was usable
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return i, skipReasons
+		}
+	}
+	return -1, skipReasons
+}
+
+// randomStrategy picks every candidate with equal chance
+type randomStrategy struct{}
+
+func (randomStrategy) Weight(_ []radio.QueueEntry, _ PopulationCandidate) (float64, string) {
+	return 1, ""
+}
+
+// lastPlayedMaxAge is the age at which lastPlayedStrategy stops giving songs
+// a higher weight
+const lastPlayedMaxAge = time.Hour * 24 * 365
+
+// lastPlayedStrategy prefers candidates that haven't been played or requested
+// in a long time
+type lastPlayedStrategy struct{}
+
+func (lastPlayedStrategy) Weight(_ []radio.QueueEntry, c PopulationCandidate) (float64, string) {
+	last := c.LastPlayed
+	if c.LastRequested.After(last) {
+		last = c.LastRequested
+	}
+
+	age := time.Since(last)
+	if last.IsZero() || age > lastPlayedMaxAge {
+		age = lastPlayedMaxAge
+	}
+	// everything gets atleast some chance
+	return 1 + age.Hours(), ""
+}
+
+// popularStrategy prefers candidates that are requested and favorited more
+type popularStrategy struct{}
+
+func (popularStrategy) Weight(_ []radio.QueueEntry, c PopulationCandidate) (float64, string) {
+	return float64(1 + c.RequestCount + int(c.Favorites)), ""
+}
+
+// artistSeparationStrategy skips candidates that have an artist that is
+// already in the last songs of the queue
+type artistSeparationStrategy struct {
+	songs int
+}
+
+func (as artistSeparationStrategy) Weight(queue []radio.QueueEntry, c PopulationCandidate) (float64, string) {
+	artist := strings.TrimSpace(c.Artist)
+	if artist == "" {
+		return 1, ""
+	}
+
+	start := len(queue) - as.songs
+	if start < 0 {
+		start = 0
+	}
+
+	for _, e := range queue[start:] {
+		if e.HasTrack() && strings.EqualFold(strings.TrimSpace(e.Artist), artist) {
+			return 0, fmt.Sprintf("artist %q within %d songs", artist, as.songs)
+		}
+	}
+	return 1, ""
+}
+
+// tagBalanceStrategy prefers candidates that share less tags with the songs
+// already in the queue
+type tagBalanceStrategy struct{}
+
+func (tagBalanceStrategy) Weight(queue []radio.QueueEntry, c PopulationCandidate) (float64, string) {
+	tags := make(map[string]struct{})
+	for _, tag := range strings.Fields(strings.ToLower(c.Tags)) {
+		tags[tag] = struct{}{}
+	}
+
+	var shared int
+	for _, e := range queue {
+		if !e.HasTrack() {
+			continue
+		}
+		for _, tag := range strings.Fields(strings.ToLower(e.Tags)) {
+			if _, ok := tags[tag]; ok {
+				shared++
+			}
+		}
+	}
+	return 1 / float64(1+shared), ""
+}
@@ -0,0 +1,269 @@
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func candidate(id radio.TrackID, artist, tags string) PopulationCandidate {
+	return PopulationCandidate{
+		Song: radio.Song{
+			DatabaseTrack: &radio.DatabaseTrack{
+				TrackID: id,
+				Artist:  artist,
+				Tags:    tags,
+			},
+		},
+	}
+}
+
+func queueOf(candidates ...PopulationCandidate) []radio.QueueEntry {
+	var queue []radio.QueueEntry
+	for _, c := range candidates {
+		queue = append(queue, radio.QueueEntry{Song: c.Song})
+	}
+	return queue
+}
+
+// weightStrategy uses a fixed weight per track
+type weightStrategy map[radio.TrackID]float64
+
+func (ws weightStrategy) Weight(_ []radio.QueueEntry, c PopulationCandidate) (float64, string) {
+	return ws[c.TrackID], "no weight"
+}
+
+func TestPickCandidate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	candidates := []PopulationCandidate{
+		candidate(1, "", ""),
+		candidate(2, "", ""),
+		candidate(3, "", ""),
+	}
+
+	t.Run("all skipped", func(t *testing.T) {
+		n, reasons := pickCandidate(r, weightStrategy{}, nil, candidates)
+		assert.Equal(t, -1, n)
+		assert.Len(t, reasons, 3)
+	})
+
+	t.Run("only one usable", func(t *testing.T) {
+		for range 100 {
+			n, reasons := pickCandidate(r, weightStrategy{2: 1}, nil, candidates)
+			require.Equal(t, 1, n)
+			assert.Len(t, reasons, 2)
+		}
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		var picked [3]int
+		for range 10000 {
+			n, reasons := pickCandidate(r, weightStrategy{1: 1, 3: 3}, nil, candidates)
+			require.GreaterOrEqual(t, n, 0)
+			assert.Len(t, reasons, 1)
+			picked[n]++
+		}
+		assert.Zero(t, picked[1])
+		// candidate 3 has three times the weight of candidate 1
+		assert.InDelta(t, 3, float64(picked[2])/float64(picked[0]), 0.3)
+	})
+}
+
+func TestParsePopulationStrategy(t *testing.T) {
+	for _, name := range []string{"", "random", "lastplayed", "popular", "artist-separation", "tag-balance"} {
+		_, err := ParsePopulationStrategy(name, 5)
+		assert.NoError(t, err, name)
+	}
+
+	_, err := ParsePopulationStrategy("unknown", 5)
+	assert.Error(t, err)
+}
+
+func TestLastPlayedStrategy(t *testing.T) {
+	recent := candidate(1, "", "")
+	recent.LastPlayed = time.Now().Add(-time.Hour)
+	requested := candidate(2, "", "")
+	requested.LastPlayed = time.Now().Add(-time.Hour * 24 * 30)
+	requested.LastRequested = time.Now().Add(-time.Hour)
+	never := candidate(3, "", "")
+
+	var s lastPlayedStrategy
+	recentWeight, _ := s.Weight(nil, recent)
+	requestedWeight, _ := s.Weight(nil, requested)
+	neverWeight, _ := s.Weight(nil, never)
+
+	assert.Greater(t, recentWeight, 0.0)
+	// a recent request counts the same as a recent play
+	assert.InDelta(t, recentWeight, requestedWeight, 0.1)
+	assert.Greater(t, neverWeight, recentWeight)
+	assert.Equal(t, 1+lastPlayedMaxAge.Hours(), neverWeight)
+}
+
+func TestPopularStrategy(t *testing.T) {
+	unpopular := candidate(1, "", "")
+	popular := candidate(2, "", "")
+	popular.RequestCount = 5
+	popular.Favorites = 10
+
+	var s popularStrategy
+	w, _ := s.Weight(nil, unpopular)
+	assert.Equal(t, 1.0, w)
+	w, _ = s.Weight(nil, popular)
+	assert.Equal(t, 16.0, w)
+}
+
+func TestArtistSeparationStrategy(t *testing.T) {
+	s := artistSeparationStrategy{songs: 2}
+	queue := queueOf(
+		candidate(1, "Artist A", ""),
+		candidate(2, "Artist B", ""),
+		candidate(3, "Artist C", ""),
+	)
+
+	w, reason := s.Weight(queue, candidate(4, " artist c ", ""))
+	assert.Zero(t, w)
+	assert.NotEmpty(t, reason)
+
+	w, _ = s.Weight(queue, candidate(5, "Artist B", ""))
+	assert.Zero(t, w)
+
+	// outside of the separation
+	w, _ = s.Weight(queue, candidate(6, "Artist A", ""))
+	assert.Equal(t, 1.0, w)
+
+	// no artist can't be separated
+	w, _ = s.Weight(queue, candidate(7, "", ""))
+	assert.Equal(t, 1.0, w)
+}
+
+func TestTagBalanceStrategy(t *testing.T) {
+	var s tagBalanceStrategy
+	queue := queueOf(
+		candidate(1, "", "vocaloid touhou"),
+		candidate(2, "", "Touhou"),
+	)
+
+	w, _ := s.Weight(queue, candidate(3, "", "jazz"))
+	assert.Equal(t, 1.0, w)
+	w, _ = s.Weight(queue, candidate(4, "", "vocaloid"))
+	assert.Equal(t, 0.5, w)
+	w, _ = s.Weight(queue, candidate(5, "", "touhou vocaloid"))
+	assert.Equal(t, 0.25, w)
+}
+
+// newPopulateQueue returns a QueueService with the candidates given in its
+// storage, and the TrackStorage used
+func newPopulateQueue(cfg config.Config, logger zerolog.Logger, candidates []PopulationCandidate) (*QueueService, *mocks.TrackStorageMock) {
+	var ids []radio.TrackID
+	var songs = make(map[radio.TrackID]radio.FavoritedSong)
+	for _, c := range candidates {
+		ids = append(ids, c.TrackID)
+		songs[c.TrackID] = radio.FavoritedSong{Song: c.Song, Favorites: c.Favorites}
+	}
+
+	ts := &mocks.TrackStorageMock{
+		QueueCandidatesFunc: func() ([]radio.TrackID, error) {
+			return slices.Clone(ids), nil
+		},
+		GetWithFavoritesFunc: func(ids []radio.TrackID) ([]radio.FavoritedSong, error) {
+			var res []radio.FavoritedSong
+			for _, id := range ids {
+				if song, ok := songs[id]; ok {
+					res = append(res, song)
+				}
+			}
+			return res, nil
+		},
+		UpdateLastRequestedFunc: func(radio.TrackID) error {
+			return nil
+		},
+	}
+	tx := &mocks.StorageTxMock{
+		CommitFunc:   func() error { return nil },
+		RollbackFunc: func() error { return nil },
+	}
+	storage := &mocks.StorageServiceMock{
+		TrackTxFunc: func(context.Context, radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
+			return ts, tx, nil
+		},
+	}
+
+	return &QueueService{
+		Config:  cfg,
+		logger:  &logger,
+		Storage: storage,
+		rand:    rand.New(rand.NewSource(1)),
+		events:  eventstream.NewEventStream(radio.QueueEvent{}),
+	}, ts
+}
+
+func TestPopulateLooksUpBatches(t *testing.T) {
+	const candidateCount = 1000
+
+	var candidates []PopulationCandidate
+	for i := range candidateCount {
+		candidates = append(candidates, candidate(radio.TrackID(i+1), "", ""))
+	}
+
+	qs, ts := newPopulateQueue(config.TestConfig(), zerolog.Nop(), candidates)
+
+	require.NoError(t, qs.populate(context.Background()))
+	assert.Len(t, qs.queue, queueRequestThreshold/2)
+	// we only needed a few songs, so only a single batch should've been
+	// looked up instead of every candidate, and all in a single query
+	calls := ts.GetWithFavoritesCalls()
+	require.Len(t, calls, 1)
+	assert.Len(t, calls[0].TrackIDs, populationBatchSize)
+}
+
+func TestPopulateSkipReasons(t *testing.T) {
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Streamer.Population.Strategy = "artist-separation"
+	c.Streamer.Population.ArtistSeparation = 5
+	cfg.StoreConf(c)
+
+	var candidates = []PopulationCandidate{
+		candidate(1, "", ""),
+		candidate(2, "", ""),
+	}
+	for i := range 8 {
+		candidates = append(candidates, candidate(radio.TrackID(i+10), "same", ""))
+	}
+
+	var buf bytes.Buffer
+	qs, _ := newPopulateQueue(cfg, zerolog.New(&buf), candidates)
+	qs.queue = queueOf(candidate(100, "same", ""))
+
+	err := qs.populate(context.Background())
+	require.True(t, errors.Is(errors.QueueShort, err))
+	assert.Len(t, qs.queue, 3)
+
+	var logged struct {
+		Candidates []string
+	}
+	dec := json.NewDecoder(&buf)
+	for logged.Candidates == nil {
+		require.NoError(t, dec.Decode(&logged), "skip reasons should be logged")
+	}
+	// the separated candidates get skipped on every pick, but should only
+	// be logged once each
+	assert.Len(t, logged.Candidates, 8)
+	for i := range 8 {
+		assert.Contains(t, logged.Candidates, fmt.Sprintf("<%d> artist %q within 5 songs", i+10, "same"))
+	}
+}
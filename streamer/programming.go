@@ -0,0 +1,135 @@
+package streamer
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+)
+
+// programmingBlockInterval is how often we check if a different programming
+// block should be active
+const programmingBlockInterval = time.Minute
+
+// activeProgrammingBlock returns the programming block that should be active
+// right now, or nil if there is none
+func (qs *QueueService) activeProgrammingBlock(ctx context.Context) (*radio.ProgrammingBlock, error) {
+	const op errors.Op = "streamer/QueueService.activeProgrammingBlock"
+
+	blocks, err := qs.Storage.ProgrammingBlock(ctx).All()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return radio.ActiveProgrammingBlock(blocks, time.Now()), nil
+}
+
+// runProgrammingBlocks switches the programming block used by populate when
+// a block starts, ends or is edited
+func (qs *QueueService) runProgrammingBlocks(ctx context.Context) {
+	ticker := time.NewTicker(programmingBlockInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		block, err := qs.activeProgrammingBlock(ctx)
+		if err != nil {
+			qs.logger.Error().Err(err).Msg("failed to load programming blocks")
+			continue
+		}
+
+		qs.switchProgrammingBlock(ctx, block)
+	}
+}
+
+// switchProgrammingBlock makes block the active programming block, songs that
+// were added by populate and don't match the new block are removed from the
+// unreserved part of the queue and the queue is populated again
+func (qs *QueueService) switchProgrammingBlock(ctx context.Context, block *radio.ProgrammingBlock) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	if sameProgrammingBlock(qs.block, block) {
+		return
+	}
+	qs.block = block
+
+	if block == nil {
+		qs.logger.Info().Msg("programming block ended")
+		// songs from the old block are still fine to play, so we leave the
+		// queue alone and let it go back to normal as it drains
+		return
+	}
+	qs.logger.Info().Str("block", block.Name).Msg("programming block started")
+
+	var removed []radio.QueueEntry
+	qs.edit(func() {
+		tail := slices.DeleteFunc(qs.queue[qs.reservedIndex:], func(e radio.QueueEntry) bool {
+			if e.IsUserRequest || block.Matches(e.Song) {
+				return false
+			}
+			removed = append(removed, e)
+			return true
+		})
+		qs.queue = qs.queue[:qs.reservedIndex+len(tail)]
+	})
+	for _, e := range removed {
+		qs.logger.Info().Str("entry", e.String()).Msg("removing from queue for programming block")
+		qs.publish(radio.QueueEventRemove, e)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	err := qs.populate(ctx)
+	if err != nil {
+		qs.logger.Error().Err(err).Msg("failed to populate queue")
+	}
+
+	err = qs.Storage.Queue(ctx).Store(queueName, qs.queue)
+	if err != nil {
+		qs.logger.Error().Err(err).Msg("failed to store queue")
+	}
+}
+
+// sameProgrammingBlock returns true if a and b are the same version of a block
+func sameProgrammingBlock(a, b *radio.ProgrammingBlock) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.ID == b.ID && a.UpdatedAt.Equal(b.UpdatedAt)
+}
+
+// candidates returns the tracks populate should pick from, these are restricted
+// to the active programming block if there is one
+func (qs *QueueService) candidates(ctx context.Context, ts radio.TrackStorage, tx radio.StorageTx) ([]radio.TrackID, error) {
+	const op errors.Op = "streamer/QueueService.candidates"
+
+	if qs.block == nil {
+		return ts.QueueCandidates()
+	}
+
+	ps, _, err := qs.Storage.ProgrammingBlockTx(ctx, tx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	ids, err := ps.Candidates(*qs.block)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if len(ids) == 0 {
+		// an empty block would starve the queue, so use the normal candidates
+		qs.logger.Warn().Str("block", qs.block.Name).Msg("programming block has no candidates")
+		return ts.QueueCandidates()
+	}
+	return ids, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"slices"
 	"sync"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/streamer/audio"
 	"github.com/R-a-dio/valkyrie/util"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
 )
@@ -31,6 +33,7 @@ const queueName = "default"
 func NewQueueService(ctx context.Context, cfg config.Config, storage radio.StorageService) (*QueueService, error) {
 	const op errors.Op = "streamer/NewQueueService"
 
+	runCtx := ctx
 	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
 	defer cancel()
 
@@ -52,6 +55,16 @@ func NewQueueService(ctx context.Context, cfg config.Config, storage radio.Stora
 		Storage: storage,
 		queue:   queue,
 		rand:    config.NewRand(true),
+		events: eventstream.NewEventStream(radio.QueueEvent{
+			Kind:  radio.QueueEventInit,
+			Queue: slices.Clone(queue),
+		}),
+	}
+
+	qs.block, err = qs.activeProgrammingBlock(ctx)
+	if err != nil {
+		// not fatal, we can still run without the blocks
+		qs.logger.Error().Err(err).Msg("failed to load programming blocks")
 	}
 
 	if err = qs.populate(ctx); err != nil {
@@ -62,6 +75,8 @@ func NewQueueService(ctx context.Context, cfg config.Config, storage radio.Stora
 		return nil, errors.E(op, err)
 	}
 
+	go qs.runProgrammingBlocks(runCtx)
+
 	return qs, nil
 }
 
@@ -72,10 +87,14 @@ type QueueService struct {
 
 	Storage radio.StorageService
 	rand    *rand.Rand
+	// events is where changes to the queue are published
+	events *eventstream.EventStream[radio.QueueEvent]
 
 	// mu protects the fields below
 	mu    sync.Mutex
 	queue []radio.QueueEntry
+	// block is the active programming block, or nil if there is none
+	block *radio.ProgrammingBlock
 	// reservedIndex indicates what part of the queue has been reserved by calls
 	// to ReserveNext, it's the index of the first un-reserved entry
 	reservedIndex int
@@ -84,6 +103,33 @@ type QueueService struct {
 // append appends the entry given to the queue, it tries to probe a more accurate
 // song length with ffprobe and calculates the ExpectedStartTime on the entry
 func (qs *QueueService) append(ctx context.Context, entry radio.QueueEntry) {
+	entry = qs.prepare(ctx, entry)
+
+	if len(qs.queue) == 0 {
+		entry.ExpectedStartTime = time.Now()
+	} else {
+		last := qs.queue[len(qs.queue)-1]
+		entry.ExpectedStartTime = last.ExpectedStartTime.Add(last.Length)
+	}
+
+	qs.logger.Info().Str("entry", entry.String()).Msg("appending to queue")
+	qs.queue = append(qs.queue, entry)
+	qs.publish(radio.QueueEventAdd, entry)
+}
+
+// publish sends an event of the kind given to subscribers of Events, qs.mu
+// should be held
+func (qs *QueueService) publish(kind radio.QueueEventKind, entry radio.QueueEntry) {
+	qs.events.Send(radio.QueueEvent{
+		Kind:  kind,
+		Entry: entry,
+		Queue: slices.Clone(qs.queue),
+	})
+}
+
+// prepare prepares an entry for adding it to the queue, it tries to probe a
+// more accurate song length with ffprobe and gives it a new QueueID
+func (qs *QueueService) prepare(ctx context.Context, entry radio.QueueEntry) radio.QueueEntry {
 	// try running an ffprobe to get a more accurate song length
 	ctx, cancel := context.WithTimeout(ctx, time.Second)
 	defer cancel()
@@ -96,31 +142,165 @@ func (qs *QueueService) append(ctx context.Context, entry radio.QueueEntry) {
 	}
 
 	if length > 0 { // only change the length if we actually got one
+		if entry.HasTrack() {
+			// only part of the file might be played
+			length = entry.Playable(length)
+		}
 		entry.Length = length
 	}
 
-	if len(qs.queue) == 0 {
-		entry.ExpectedStartTime = time.Now()
-	} else {
-		last := qs.queue[len(qs.queue)-1]
-		entry.ExpectedStartTime = last.ExpectedStartTime.Add(last.Length)
-	}
 	entry.QueueID = radio.NewQueueID()
-
-	qs.logger.Info().Str("entry", entry.String()).Msg("appending to queue")
-	qs.queue = append(qs.queue, entry)
+	return entry
 }
 
 // calculateExpectedStartTime calculates the ExpectedStartTime fields of all entries
 // based on the first entries ExpectedStartTime; This will generate incorrect times
 // if the first entry has a wrong time.
 func (qs *QueueService) calculateExpectedStartTime() {
-	var length = qs.queue[0].Length
 	for i := 1; i < len(qs.queue); i++ {
-		qs.queue[i].ExpectedStartTime = qs.queue[i-1].ExpectedStartTime.Add(length)
+		prev := qs.queue[i-1]
+		qs.queue[i].ExpectedStartTime = prev.ExpectedStartTime.Add(prev.Length)
 	}
 }
 
+// edit calls fn to change the order of the queue and recalculates the
+// ExpectedStartTime of all entries afterwards, the start time of the first
+// entry is kept as is
+func (qs *QueueService) edit(fn func()) {
+	var start time.Time
+	if len(qs.queue) > 0 {
+		start = qs.queue[0].ExpectedStartTime
+	}
+
+	fn()
+
+	if len(qs.queue) > 0 {
+		qs.queue[0].ExpectedStartTime = start
+		qs.calculateExpectedStartTime()
+	}
+}
+
+// index returns the index of the entry with the id given, or -1 if it
+// doesn't exist
+func (qs *QueueService) index(id radio.QueueID) int {
+	for i := range qs.queue {
+		if qs.queue[i].QueueID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// clamp returns position clamped to be between the end of the reserved part
+// of the queue and max
+func (qs *QueueService) clamp(position, max int) int {
+	if position > max {
+		position = max
+	}
+	if position < qs.reservedIndex {
+		position = qs.reservedIndex
+	}
+	return position
+}
+
+// Move implements radio.QueueService
+func (qs *QueueService) Move(ctx context.Context, id radio.QueueID, position int) (bool, error) {
+	const op errors.Op = "streamer/QueueService.Move"
+
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	ok, err := qs.move(id, position)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	if !ok {
+		return false, nil
+	}
+
+	err = qs.Storage.Queue(ctx).Store(queueName, qs.queue)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return true, nil
+}
+
+// Promote implements radio.QueueService
+func (qs *QueueService) Promote(ctx context.Context, id radio.QueueID) (bool, error) {
+	const op errors.Op = "streamer/QueueService.Promote"
+
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	ok, err := qs.move(id, qs.reservedIndex)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	if !ok {
+		return false, nil
+	}
+
+	err = qs.Storage.Queue(ctx).Store(queueName, qs.queue)
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return true, nil
+}
+
+// move moves the entry with the id given to position, qs.mu should be held
+func (qs *QueueService) move(id radio.QueueID, position int) (bool, error) {
+	i := qs.index(id)
+	if i < 0 {
+		return false, nil
+	}
+	if i < qs.reservedIndex {
+		return false, errors.E(errors.InvalidArgument, "entry is reserved")
+	}
+
+	position = qs.clamp(position, len(qs.queue)-1)
+	entry := qs.queue[i]
+
+	qs.logger.Info().Str("entry", entry.String()).Int("position", position).Msg("moving in queue")
+	qs.edit(func() {
+		qs.queue = slices.Delete(qs.queue, i, i+1)
+		qs.queue = slices.Insert(qs.queue, position, entry)
+	})
+	qs.publish(radio.QueueEventReorder, entry)
+	return true, nil
+}
+
+// InsertAt implements radio.QueueService
+func (qs *QueueService) InsertAt(ctx context.Context, song radio.Song, position int) error {
+	const op errors.Op = "streamer/QueueService.InsertAt"
+
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+
+	entry := radio.QueueEntry{
+		Song: song,
+	}
+	position = qs.clamp(position, len(qs.queue))
+
+	if position == len(qs.queue) {
+		// this is just an append, which also handles giving an entry in an
+		// empty queue its start time
+		qs.append(ctx, entry)
+	} else {
+		entry = qs.prepare(ctx, entry)
+		qs.logger.Info().Str("entry", entry.String()).Int("position", position).Msg("inserting in queue")
+		qs.edit(func() {
+			qs.queue = slices.Insert(qs.queue, position, entry)
+		})
+		qs.publish(radio.QueueEventAdd, entry)
+	}
+
+	err := qs.Storage.Queue(ctx).Store(queueName, qs.queue)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 // AddRequest implements radio.QueueService
 func (qs *QueueService) AddRequest(ctx context.Context, song radio.Song, identifier string) error {
 	const op errors.Op = "streamer/QueueService.AddRequest"
@@ -159,6 +339,7 @@ func (qs *QueueService) ReserveNext(ctx context.Context) (*radio.QueueEntry, err
 	entry := qs.queue[qs.reservedIndex]
 	qs.reservedIndex++
 	qs.logger.Info().Str("entry", entry.String()).Msg("reserve in queue")
+	qs.publish(radio.QueueEventReserve, entry)
 
 	return &entry, nil
 }
@@ -170,6 +351,7 @@ func (qs *QueueService) ResetReserved(ctx context.Context) error {
 
 	qs.logger.Info().Int("index", qs.reservedIndex).Msg("reset reserve in queue")
 	qs.reservedIndex = 0
+	qs.publish(radio.QueueEventReserve, radio.QueueEntry{})
 	return nil
 }
 
@@ -200,6 +382,7 @@ func (qs *QueueService) Remove(ctx context.Context, id radio.QueueID) (bool, err
 			qs.queue[0].ExpectedStartTime = time.Now().Add(e.Length)
 			qs.calculateExpectedStartTime()
 		}
+		qs.publish(radio.QueueEventRemove, e)
 		break
 	}
 
@@ -239,6 +422,11 @@ func (qs *QueueService) Entries(ctx context.Context) ([]radio.QueueEntry, error)
 	return all, nil
 }
 
+// Events implements radio.QueueService
+func (qs *QueueService) Events(ctx context.Context) (eventstream.Stream[radio.QueueEvent], error) {
+	return qs.events.SubStream(ctx), nil
+}
+
 func (qs *QueueService) populate(ctx context.Context) error {
 	const op errors.Op = "streamer/QueueService.populate"
 	ctx, span := otel.Tracer("queue").Start(ctx, string(op))
@@ -273,64 +461,73 @@ func (qs *QueueService) populate(ctx context.Context) error {
 	// wanted final length of the queue
 	wantedLength := len(qs.queue) + (randomThreshold - randomEntries)
 
-	candidates, err := ts.QueueCandidates()
+	ids, err := qs.candidates(ctx, ts, tx)
 	if err != nil {
 		return errors.E(op, err)
 	}
 
-	if len(candidates) == 0 {
+	if len(ids) == 0 {
 		return errors.E(op, errors.QueueShort)
 	}
 
 	// bookmarking so we can tell what happens here
-	var candidateCount = len(candidates)
+	var candidateCount = len(ids)
 	var skipReasons []error
+	// candidates stay around between picks and get skipped again each time,
+	// so keep track of which ones we already have a reason for
+	var reported = make(map[radio.TrackID]struct{})
+
+	// candidates are looked up in batches from a random order, so that we
+	// only have to look up the tracks we might actually use
+	qs.rand.Shuffle(len(ids), func(i, j int) {
+		ids[i], ids[j] = ids[j], ids[i]
+	})
 
-outer:
+	strategy := qs.populationStrategy()
+
+	var candidates []PopulationCandidate
 	for len(qs.queue) < wantedLength {
-		// we've run out of candidates
 		if len(candidates) == 0 {
-			break
-		}
-
-		// grab a candidate at random
-		n := qs.rand.Intn(len(candidates))
-		id := candidates[n]
+			// we've run out of candidates
+			if len(ids) == 0 {
+				break
+			}
 
-		candidates[n] = candidates[len(candidates)-1]
-		candidates = candidates[:len(candidates)-1]
+			var reasons []error
+			candidates, ids, reasons = qs.loadCandidates(ts, ids)
+			skipReasons = append(skipReasons, reasons...)
+			continue
+		}
 
-		// check if our candidate might already be in the queue
-		for i := range qs.queue {
-			// and skip it if it is already there
-			if qs.queue[i].TrackID == id {
-				skipReasons = append(skipReasons, skipped{
-					TrackID: id,
-					Reason:  "duplicate entry",
-				})
-				continue outer
+		n, reasons := pickCandidate(qs.rand, strategy, qs.queue, candidates)
+		for _, reason := range reasons {
+			if _, ok := reported[reason.TrackID]; ok {
+				continue
 			}
+			reported[reason.TrackID] = struct{}{}
+			skipReasons = append(skipReasons, reason)
 		}
-
-		song, err := ts.Get(id)
-		if err != nil {
-			skipReasons = append(skipReasons, skipped{
-				TrackID: id,
-				Err:     err,
-			})
+		if n < 0 {
+			// the strategy skipped all the candidates in this batch, so
+			// try the next one
+			candidates = nil
 			continue
 		}
+		candidate := candidates[n]
+
+		candidates[n] = candidates[len(candidates)-1]
+		candidates = candidates[:len(candidates)-1]
 
-		if err = ts.UpdateLastRequested(id); err != nil {
+		if err = ts.UpdateLastRequested(candidate.TrackID); err != nil {
 			skipReasons = append(skipReasons, skipped{
-				TrackID: id,
+				TrackID: candidate.TrackID,
 				Err:     err,
 			})
 			continue
 		}
 
 		qs.append(ctx, radio.QueueEntry{
-			Song: *song,
+			Song: candidate.Song,
 		})
 	}
 
@@ -359,6 +556,70 @@ outer:
 	return errors.E(op, errors.QueueShort)
 }
 
+// populationBatchSize is the amount of candidates looked up at a time when
+// populating the queue
+const populationBatchSize = 50
+
+// loadCandidates looks up the candidates for the first populationBatchSize
+// usable ids given, it returns the candidates found, the ids that are left and
+// the reasons ids were skipped
+func (qs *QueueService) loadCandidates(ts radio.TrackStorage, ids []radio.TrackID) ([]PopulationCandidate, []radio.TrackID, []error) {
+	var skipReasons []error
+	var batch = make([]radio.TrackID, 0, populationBatchSize)
+
+outer:
+	for len(ids) > 0 && len(batch) < populationBatchSize {
+		id := ids[0]
+		ids = ids[1:]
+
+		// check if our candidate might already be in the queue
+		for i := range qs.queue {
+			// and skip it if it is already there
+			if qs.queue[i].TrackID == id {
+				skipReasons = append(skipReasons, skipped{
+					TrackID: id,
+					Reason:  "duplicate entry",
+				})
+				continue outer
+			}
+		}
+
+		batch = append(batch, id)
+	}
+
+	songs, err := ts.GetWithFavorites(batch)
+	if err != nil {
+		for _, id := range batch {
+			skipReasons = append(skipReasons, skipped{
+				TrackID: id,
+				Err:     err,
+			})
+		}
+		return nil, ids, skipReasons
+	}
+
+	var found = make(map[radio.TrackID]struct{}, len(songs))
+	var candidates = make([]PopulationCandidate, 0, len(songs))
+	for _, song := range songs {
+		found[song.TrackID] = struct{}{}
+		candidates = append(candidates, PopulationCandidate{
+			Song:      song.Song,
+			Favorites: song.Favorites,
+		})
+	}
+
+	for _, id := range batch {
+		if _, ok := found[id]; !ok {
+			skipReasons = append(skipReasons, skipped{
+				TrackID: id,
+				Reason:  "unknown track",
+			})
+		}
+	}
+
+	return candidates, ids, skipReasons
+}
+
 type skipped struct {
 	TrackID radio.TrackID
 	Reason  string
@@ -0,0 +1,217 @@
+package streamer
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/util/eventstream"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestQueue returns a QueueService with entries of the lengths given, the
+// first entry is reserved
+func newTestQueue(lengths ...time.Duration) (*QueueService, *int) {
+	var stores int
+	storage := &mocks.StorageServiceMock{
+		QueueFunc: func(context.Context) radio.QueueStorage {
+			return &mocks.QueueStorageMock{
+				StoreFunc: func(string, []radio.QueueEntry) error {
+					stores++
+					return nil
+				},
+			}
+		},
+		// populating isn't tested here
+		TrackTxFunc: func(context.Context, radio.StorageTx) (radio.TrackStorage, radio.StorageTx, error) {
+			return nil, nil, errors.E(errors.Testing)
+		},
+	}
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var queue []radio.QueueEntry
+	for i, length := range lengths {
+		queue = append(queue, radio.QueueEntry{
+			Song: radio.Song{
+				Metadata:      string(rune('a' + i)),
+				Length:        length,
+				DatabaseTrack: &radio.DatabaseTrack{TrackID: radio.TrackID(i + 1)},
+			},
+			QueueID:           radio.NewQueueID(),
+			ExpectedStartTime: start,
+		})
+		start = start.Add(length)
+	}
+
+	logger := zerolog.Nop()
+	return &QueueService{
+		Config:  config.TestConfig(),
+		logger:  &logger,
+		Storage: storage,
+		rand:    rand.New(rand.NewSource(1)),
+		events: eventstream.NewEventStream(radio.QueueEvent{
+			Kind:  radio.QueueEventInit,
+			Queue: slices.Clone(queue),
+		}),
+		queue:         queue,
+		reservedIndex: 1,
+	}, &stores
+}
+
+func queueMetadata(queue []radio.QueueEntry) string {
+	var s string
+	for _, e := range queue {
+		s += e.Metadata
+	}
+	return s
+}
+
+// assertStartTimes checks that every entry starts when the previous one ends
+func assertStartTimes(t *testing.T, start time.Time, queue []radio.QueueEntry) {
+	t.Helper()
+	for _, e := range queue {
+		assert.Equal(t, start, e.ExpectedStartTime, e.Metadata)
+		start = start.Add(e.Length)
+	}
+}
+
+func TestQueueMove(t *testing.T) {
+	ctx := context.Background()
+	qs, stores := newTestQueue(time.Minute, time.Minute*2, time.Minute*3, time.Minute*4)
+	start := qs.queue[0].ExpectedStartTime
+
+	ok, err := qs.Move(ctx, qs.queue[3].QueueID, 1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "adbc", queueMetadata(qs.queue))
+	assertStartTimes(t, start, qs.queue)
+
+	ok, err = qs.Move(ctx, qs.queue[1].QueueID, 100)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "abcd", queueMetadata(qs.queue))
+	assertStartTimes(t, start, qs.queue)
+	assert.Equal(t, 2, *stores)
+
+	// unknown entries aren't an error
+	ok, err = qs.Move(ctx, radio.NewQueueID(), 1)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// but moving a reserved entry is
+	ok, err = qs.Move(ctx, qs.queue[0].QueueID, 2)
+	assert.Error(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, "abcd", queueMetadata(qs.queue))
+	assert.Equal(t, 2, *stores)
+}
+
+func TestQueuePromote(t *testing.T) {
+	ctx := context.Background()
+	qs, stores := newTestQueue(time.Minute, time.Minute*2, time.Minute*3, time.Minute*4)
+	start := qs.queue[0].ExpectedStartTime
+
+	// promoting goes to the front of the unreserved part of the queue
+	ok, err := qs.Promote(ctx, qs.queue[2].QueueID)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "acbd", queueMetadata(qs.queue))
+	assertStartTimes(t, start, qs.queue)
+	assert.Equal(t, 1, *stores)
+
+	ok, err = qs.Promote(ctx, radio.NewQueueID())
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, 1, *stores)
+}
+
+// queueSong returns a song with a track that can be added to the queue
+func queueSong(metadata string, id radio.TrackID) radio.Song {
+	return radio.Song{
+		Metadata:      metadata,
+		Length:        time.Minute,
+		DatabaseTrack: &radio.DatabaseTrack{TrackID: id},
+	}
+}
+
+// nextQueueEvent returns the next event from the stream given
+func nextQueueEvent(t *testing.T, stream eventstream.Stream[radio.QueueEvent]) radio.QueueEvent {
+	t.Helper()
+	ev, err := stream.Next()
+	require.NoError(t, err, "no event received")
+	return ev
+}
+
+// assertQueueEvent checks that the event given is of kind for the entry with
+// the metadata given, and that it carries the queue as it is right now
+func assertQueueEvent(t *testing.T, qs *QueueService, ev radio.QueueEvent, kind radio.QueueEventKind, metadata string) {
+	t.Helper()
+	assert.Equal(t, kind, ev.Kind)
+	assert.Equal(t, metadata, ev.Entry.Metadata)
+
+	queue, err := qs.Entries(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, queue, ev.Queue)
+}
+
+func TestQueueEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	qs, _ := newTestQueue(time.Minute, time.Minute*2, time.Minute*3, time.Minute*4)
+
+	stream, err := qs.Events(ctx)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	// the first event is the queue as it is when subscribing
+	assertQueueEvent(t, qs, nextQueueEvent(t, stream), radio.QueueEventInit, "")
+
+	require.NoError(t, qs.AddRequest(ctx, queueSong("e", 5), "127.0.0.1"))
+	ev := nextQueueEvent(t, stream)
+	assertQueueEvent(t, qs, ev, radio.QueueEventAdd, "e")
+	assert.True(t, ev.Entry.IsUserRequest)
+	assert.Equal(t, "abcde", queueMetadata(ev.Queue))
+
+	require.NoError(t, qs.InsertAt(ctx, queueSong("f", 6), 2))
+	added := nextQueueEvent(t, stream)
+	assertQueueEvent(t, qs, added, radio.QueueEventAdd, "f")
+	assert.Equal(t, "abfcde", queueMetadata(added.Queue))
+
+	ok, err := qs.Move(ctx, added.Entry.QueueID, 4)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ev = nextQueueEvent(t, stream)
+	assertQueueEvent(t, qs, ev, radio.QueueEventReorder, "f")
+	assert.Equal(t, "abcdfe", queueMetadata(ev.Queue))
+	// events carry a copy of the queue, so older events don't change
+	assert.Equal(t, "abfcde", queueMetadata(added.Queue))
+
+	entry, err := qs.ReserveNext(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "b", entry.Metadata)
+	assertQueueEvent(t, qs, nextQueueEvent(t, stream), radio.QueueEventReserve, "b")
+
+	require.NoError(t, qs.ResetReserved(ctx))
+	assertQueueEvent(t, qs, nextQueueEvent(t, stream), radio.QueueEventReserve, "")
+
+	ok, err = qs.Remove(ctx, qs.queue[0].QueueID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ev = nextQueueEvent(t, stream)
+	assertQueueEvent(t, qs, ev, radio.QueueEventRemove, "a")
+	assert.Equal(t, "bcdfe", queueMetadata(ev.Queue))
+
+	// new subscribers get the current queue first
+	late, err := qs.Events(ctx)
+	require.NoError(t, err)
+	defer late.Close()
+	assertQueueEvent(t, qs, nextQueueEvent(t, late), radio.QueueEventRemove, "a")
+}
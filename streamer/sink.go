@@ -0,0 +1,309 @@
+package streamer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/R-a-dio/valkyrie/streamer/icecast"
+)
+
+// Sink is an output of the streamer, it receives the encoded audio stream
+type Sink interface {
+	// Connect returns a writer to send the stream to, the writer is closed
+	// and Connect is called again when a write to it fails
+	Connect(ctx context.Context) (io.WriteCloser, error)
+	// Metadata sets the metadata of the stream
+	Metadata(ctx context.Context, metadata string) error
+}
+
+// newSink returns the main sink of the streamer as configured currently
+func (s *Streamer) newSink() (Sink, error) {
+	cfg := s.Conf().Streamer
+
+	switch cfg.Sink.Type {
+	case "", "icecast":
+		return NewIcecastSink(cfg.StreamURL, "audio/mpeg", s.Conf().UserAgent)
+	case "file":
+		return NewFileSink(cfg.Sink.Path), nil
+	case "rotating-file":
+		return NewRotatingFileSink(cfg.Sink.Path, time.Duration(cfg.Sink.Rotate)), nil
+	case "http":
+		return NewHTTPSink(cfg.Sink.ListenAddr, "audio/mpeg"), nil
+	}
+	return nil, fmt.Errorf("unknown sink type: %q", cfg.Sink.Type)
+}
+
+// IcecastSink is a Sink that sends the stream to an icecast mount
+type IcecastSink struct {
+	url         string
+	contentType string
+	userAgent   string
+
+	metaFn func(context.Context, string) error
+}
+
+// NewIcecastSink returns a Sink that sends the stream to the icecast mount at
+// url, the url should include any authorization required
+func NewIcecastSink(url, contentType, userAgent string) (*IcecastSink, error) {
+	metaFn, err := icecast.Metadata(url, icecast.UserAgent(userAgent))
+	if err != nil {
+		return nil, err
+	}
+
+	return &IcecastSink{
+		url:         url,
+		contentType: contentType,
+		userAgent:   userAgent,
+		metaFn:      metaFn,
+	}, nil
+}
+
+// Connect implements Sink
+func (is *IcecastSink) Connect(ctx context.Context) (io.WriteCloser, error) {
+	return icecast.Dial(ctx, is.url,
+		icecast.ContentType(is.contentType),
+		icecast.UserAgent(is.userAgent),
+	)
+}
+
+// Metadata implements Sink
+func (is *IcecastSink) Metadata(ctx context.Context, metadata string) error {
+	return is.metaFn(ctx, metadata)
+}
+
+// FileSink is a Sink that appends the stream to a file
+type FileSink struct {
+	path string
+}
+
+// NewFileSink returns a Sink that appends the stream to the file at path
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+// Connect implements Sink
+func (fs *FileSink) Connect(ctx context.Context) (io.WriteCloser, error) {
+	return openSinkFile(fs.path)
+}
+
+// Metadata implements Sink, metadata is not stored
+func (fs *FileSink) Metadata(ctx context.Context, metadata string) error {
+	return nil
+}
+
+// openSinkFile opens path for appending, creating it and any missing
+// parent directories
+func openSinkFile(path string) (*os.File, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return nil, err
+	}
+	return os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+}
+
+// RotatingFileSink is a Sink that writes the stream to a new file every
+// rotation period
+type RotatingFileSink struct {
+	layout string
+	every  time.Duration
+}
+
+// NewRotatingFileSink returns a Sink that writes the stream to a new file
+// every period given, the filename is the start of the period formatted with
+// layout as time layout
+func NewRotatingFileSink(layout string, every time.Duration) *RotatingFileSink {
+	if every <= 0 {
+		every = time.Hour
+	}
+	return &RotatingFileSink{layout: layout, every: every}
+}
+
+// Connect implements Sink
+func (rs *RotatingFileSink) Connect(ctx context.Context) (io.WriteCloser, error) {
+	w := &rotatingWriter{RotatingFileSink: rs}
+	if err := w.rotate(time.Now()); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Metadata implements Sink, metadata is not stored
+func (rs *RotatingFileSink) Metadata(ctx context.Context, metadata string) error {
+	return nil
+}
+
+// rotatingWriter is the writer returned by RotatingFileSink.Connect
+type rotatingWriter struct {
+	*RotatingFileSink
+
+	f     *os.File
+	start time.Time
+}
+
+// rotate closes the current file and opens the file for the period now is in
+func (w *rotatingWriter) rotate(now time.Time) error {
+	if w.f != nil {
+		w.f.Close()
+		w.f = nil
+	}
+
+	w.start = now.Truncate(w.every)
+	f, err := openSinkFile(w.start.Format(w.layout))
+	if err != nil {
+		return err
+	}
+	w.f = f
+	return nil
+}
+
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	if now := time.Now(); now.Sub(w.start) >= w.every {
+		if err := w.rotate(now); err != nil {
+			return 0, err
+		}
+	}
+	return w.f.Write(p)
+}
+
+func (w *rotatingWriter) Close() error {
+	if w.f == nil {
+		return nil
+	}
+	return w.f.Close()
+}
+
+// httpClientQueueSize is the amount of writes a client of the HTTPSink can
+// fall behind before it is disconnected
+const httpClientQueueSize = 64
+
+// HTTPSink is a Sink that serves the stream over HTTP to any client that
+// connects to it
+type HTTPSink struct {
+	addr        string
+	contentType string
+
+	mu      sync.Mutex
+	clients map[chan []byte]struct{}
+}
+
+// NewHTTPSink returns a Sink that serves the stream on addr, the server is
+// started by Connect and stopped when the writer returned is closed
+func NewHTTPSink(addr, contentType string) *HTTPSink {
+	return &HTTPSink{
+		addr:        addr,
+		contentType: contentType,
+		clients:     make(map[chan []byte]struct{}),
+	}
+}
+
+// Connect implements Sink
+func (hs *HTTPSink) Connect(ctx context.Context) (io.WriteCloser, error) {
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", hs.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	srv := &http.Server{Handler: hs}
+	go srv.Serve(ln)
+
+	return &httpSinkWriter{hs, srv}, nil
+}
+
+// Metadata implements Sink, metadata is not send to clients
+func (hs *HTTPSink) Metadata(ctx context.Context, metadata string) error {
+	return nil
+}
+
+// ServeHTTP sends the stream to the client until either side goes away
+func (hs *HTTPSink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ch := make(chan []byte, httpClientQueueSize)
+
+	hs.mu.Lock()
+	hs.clients[ch] = struct{}{}
+	hs.mu.Unlock()
+	defer hs.remove(ch)
+
+	w.Header().Set("Content-Type", hs.contentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	// send the headers right away, the client would otherwise be waiting on
+	// them until the first write
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+
+	for {
+		select {
+		case p, ok := <-ch:
+			if !ok {
+				return
+			}
+			if _, err := w.Write(p); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// broadcast sends p to all clients, clients that are too far behind are
+// disconnected
+func (hs *HTTPSink) broadcast(p []byte) {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+
+	for ch := range hs.clients {
+		select {
+		case ch <- p:
+		default:
+			delete(hs.clients, ch)
+			close(ch)
+		}
+	}
+}
+
+// remove removes the client ch if it still exists
+func (hs *HTTPSink) remove(ch chan []byte) {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+
+	if _, ok := hs.clients[ch]; ok {
+		delete(hs.clients, ch)
+		close(ch)
+	}
+}
+
+// httpSinkWriter is the writer returned by HTTPSink.Connect
+type httpSinkWriter struct {
+	*HTTPSink
+	srv *http.Server
+}
+
+func (w *httpSinkWriter) Write(p []byte) (int, error) {
+	// the caller reuses p, so give the clients their own copy
+	w.broadcast(append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func (w *httpSinkWriter) Close() error {
+	w.mu.Lock()
+	for ch := range w.clients {
+		delete(w.clients, ch)
+		close(ch)
+	}
+	w.mu.Unlock()
+	return w.srv.Close()
+}
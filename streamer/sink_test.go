@@ -0,0 +1,99 @@
+package streamer
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "sub", "stream.mp3")
+	sink := NewFileSink(path)
+
+	w, err := sink.Connect(ctx)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("hello "))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	// a reconnect should append to the file instead of replacing it
+	w, err = sink.Connect(ctx)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("world"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+}
+
+func TestRotatingFileSink(t *testing.T) {
+	dir := t.TempDir()
+	sink := NewRotatingFileSink(filepath.Join(dir, "2006", "stream-20060102-150405.mp3"), time.Hour)
+
+	w, err := sink.Connect(context.Background())
+	require.NoError(t, err)
+	_, err = w.Write([]byte("audio"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	path := time.Now().Truncate(time.Hour).Format(filepath.Join(dir, "2006", "stream-20060102-150405.mp3"))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "audio", string(data))
+}
+
+func TestHTTPSink(t *testing.T) {
+	// find a free port to use for the sink
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	ln.Close()
+
+	sink := NewHTTPSink(addr, "audio/mpeg")
+	w, err := sink.Connect(context.Background())
+	require.NoError(t, err)
+	defer w.Close()
+
+	resp, err := http.Get("http://" + addr + "/")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "audio/mpeg", resp.Header.Get("Content-Type"))
+
+	// wait for the client to be registered before we write anything, data
+	// written before that is never seen by the client
+	require.Eventually(t, func() bool {
+		sink.mu.Lock()
+		defer sink.mu.Unlock()
+		return len(sink.clients) == 1
+	}, time.Second*5, time.Millisecond*10)
+
+	buf := []byte("first")
+	_, err = w.Write(buf)
+	require.NoError(t, err)
+	// the writer should've copied the data
+	copy(buf, "xxxxx")
+	_, err = w.Write([]byte("second"))
+	require.NoError(t, err)
+
+	got := make([]byte, len("firstsecond"))
+	_, err = io.ReadFull(resp.Body, got)
+	require.NoError(t, err)
+	assert.Equal(t, "firstsecond", string(got))
+
+	// closing the writer should disconnect the client
+	require.NoError(t, w.Close())
+	rest, _ := io.ReadAll(resp.Body)
+	assert.Empty(t, rest)
+}
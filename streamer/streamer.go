@@ -1,10 +1,10 @@
 package streamer
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
-	"net"
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
@@ -14,7 +14,6 @@ import (
 	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/errors"
 	"github.com/R-a-dio/valkyrie/streamer/audio"
-	"github.com/R-a-dio/valkyrie/streamer/icecast"
 	"github.com/R-a-dio/valkyrie/util"
 	"github.com/cenkalti/backoff"
 	"github.com/rs/zerolog"
@@ -34,14 +33,28 @@ type Streamer struct {
 	// forceDone is set when we want an immediate shutdown, instead of waiting
 	// on work to finish before shutdown
 	forceDone int32
-
 	config.Config
 	logger *zerolog.Logger
 
 	// queue used by the streamer
 	queue radio.QueueService
+	// storage used to store information found while playing tracks
+	storage radio.StorageService
 	// Format of the PCM audio data
 	AudioFormat audio.AudioFormat
+	// sink is the main output of the streamer
+	sink Sink
+	// mounts are the extra outputs of the streamer
+	mounts []*mount
+
+	// skipMu protects playing and skipPending
+	skipMu sync.Mutex
+	// playing is the skip of the track the sink is playing, this is nil
+	// in between tracks
+	playing *trackSkip
+	// skipPending is set if Skip was called in between tracks, the next
+	// track is skipped as soon as it starts playing
+	skipPending bool
 
 	// sync primitives
 	wg sync.WaitGroup
@@ -53,11 +66,12 @@ type Streamer struct {
 }
 
 // NewStreamer returns a new streamer using the state given
-func NewStreamer(ctx context.Context, cfg config.Config, queue radio.QueueService) (*Streamer, error) {
+func NewStreamer(ctx context.Context, cfg config.Config, queue radio.QueueService, storage radio.StorageService) (*Streamer, error) {
 	var s = &Streamer{
-		Config: cfg,
-		logger: zerolog.Ctx(ctx),
-		queue:  queue,
+		Config:  cfg,
+		logger:  zerolog.Ctx(ctx),
+		queue:   queue,
+		storage: storage,
 	}
 
 	s.AudioFormat = audio.AudioFormat{
@@ -88,15 +102,33 @@ func (s *Streamer) Start(ctx context.Context) {
 	s.wgDone = make(chan struct{})
 	var once sync.Once
 
+	sink, err := s.newSink()
+	if err != nil {
+		s.logger.Error().Err(err).Msg("invalid sink configuration")
+		atomic.StoreInt32(&s.started, 0)
+		return
+	}
+	s.sink = sink
+
 	ctx, s.cancel = context.WithCancel(ctx)
 
+	s.mounts = s.newMounts()
+	for _, m := range s.mounts {
+		s.wg.Add(1)
+		go func(m *mount) { // exit on context cancellation
+			defer s.wg.Done()
+			m.runForever(ctx)
+		}(m)
+	}
+
 	pipeline := []pipelineFunc{
 		s.headTask,
 		s.queueFiles,
 		s.decodeFiles,
 		s.encodeToMP3,
-		s.streamToIcecast,
-		s.metadataToIcecast,
+		s.streamToMounts,
+		s.streamToSink,
+		s.metadataToSink,
 		s.tailTask,
 	}
 
@@ -195,6 +227,51 @@ func (s *Streamer) ForceStop(ctx context.Context) error {
 	return nil
 }
 
+// Skip skips the track that is currently playing, the track is treated as if
+// it finished playing normally
+func (s *Streamer) Skip(ctx context.Context) error {
+	const op errors.Op = "streamer/Streamer.Skip"
+
+	if atomic.LoadInt32(&s.started) == 0 {
+		return errors.E(op, errors.StreamerNotRunning)
+	}
+
+	s.logger.Info().Str("event", "skip").Msg("")
+
+	s.skipMu.Lock()
+	defer s.skipMu.Unlock()
+	if s.playing != nil {
+		s.playing.skip()
+	} else {
+		s.skipPending = true
+	}
+	return nil
+}
+
+// startPlaying marks the track with the skip given as the one being played by
+// the sink, a skip that came in while no track was playing is applied to it
+func (s *Streamer) startPlaying(ts *trackSkip) {
+	s.skipMu.Lock()
+	defer s.skipMu.Unlock()
+	s.playing = ts
+	if s.skipPending {
+		s.skipPending = false
+		ts.skip()
+	}
+}
+
+// stopPlaying marks the track with the skip given as done playing, skipped
+// should be true if the sink acted on a skip of the track. A skip that came in
+// after the track finished by itself is moved to the next track
+func (s *Streamer) stopPlaying(ts *trackSkip, skipped bool) {
+	s.skipMu.Lock()
+	defer s.skipMu.Unlock()
+	s.playing = nil
+	if !skipped && ts.skipped() {
+		s.skipPending = true
+	}
+}
+
 // Wait waits for the streamer to stop running; either by an error occuring or
 // by someone else calling Stop or ForceStop.
 func (s *Streamer) Wait() error {
@@ -213,10 +290,86 @@ type streamerTrack struct {
 	track    radio.QueueEntry
 	pcm      *audio.PCMBuffer
 	mp3      *audio.MP3Buffer
+	// fanout is the audio as it was encoded for the main stream, this is
+	// only set if there are extra mounts configured
+	fanout *audio.PCMBuffer
+	// jingle is set if this track is a jingle, jingles aren't part of the
+	// queue and don't send metadata
+	jingle bool
+	// length is the expected playback length of the track
+	length *trackLength
+	// skip is used to skip this track while it's playing
+	skip *trackSkip
+	// head is the start of the track that was mixed into the end of the
+	// previous track, it's played by itself if the previous track got
+	// skipped before it could play the mix
+	head []byte
 
 	once *sync.Once
 }
 
+// trackLength is the expected playback length of a track. The start of a track
+// can be consumed by the transition from the previous track, which makes it
+// shorter than the audio that was decoded
+type trackLength struct {
+	mu       sync.Mutex
+	decoded  time.Duration
+	done     bool
+	consumed time.Duration
+}
+
+// setDecoded sets the length of the decoded audio, it should be called once
+// decoding has finished
+func (tl *trackLength) setDecoded(mp3 *audio.MP3Buffer, d time.Duration) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	tl.decoded, tl.done = d, true
+	tl.setCap(mp3)
+}
+
+// setConsumed sets the length of the start of the track that was used by the
+// transition from the previous track
+func (tl *trackLength) setConsumed(mp3 *audio.MP3Buffer, d time.Duration) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	tl.consumed = d
+	tl.setCap(mp3)
+}
+
+// setCap sets the cap of mp3 to the expected length if decoding has finished,
+// tl.mu should be held when calling this
+func (tl *trackLength) setCap(mp3 *audio.MP3Buffer) {
+	if tl.done {
+		mp3.SetCap(max(tl.decoded-tl.consumed, 0))
+	}
+}
+
+// trackSkip is the skip state of a single track, every output playing the
+// track stops playing it once it's skipped
+type trackSkip struct {
+	once sync.Once
+	ch   chan struct{}
+}
+
+func newTrackSkip() *trackSkip {
+	return &trackSkip{ch: make(chan struct{})}
+}
+
+// skip skips the track
+func (ts *trackSkip) skip() {
+	ts.once.Do(func() { close(ts.ch) })
+}
+
+// skipped returns true if the track was skipped
+func (ts *trackSkip) skipped() bool {
+	select {
+	case <-ts.ch:
+		return true
+	default:
+		return false
+	}
+}
+
 type streamerTask struct {
 	context.Context
 
@@ -241,10 +394,7 @@ func (s *Streamer) errored(task streamerTask, track streamerTrack) {
 
 	track.once.Do(func() {
 		s.logger.Error().Str("metadata", track.track.Metadata).Msg("error in pipeline")
-		_, err := s.queue.Remove(task.Context, track.track.QueueID)
-		if err != nil {
-			s.logger.Error().Err(err).Msg("queue removal")
-		}
+		s.removeFromQueue(task, track)
 
 		select {
 		case task._head.out <- streamerTrack{}:
@@ -253,6 +403,19 @@ func (s *Streamer) errored(task streamerTask, track streamerTrack) {
 	})
 }
 
+// removeFromQueue removes the track from the queue, jingles are skipped
+// since they were never in the queue
+func (s *Streamer) removeFromQueue(task streamerTask, track streamerTrack) {
+	if track.jingle {
+		return
+	}
+
+	_, err := s.queue.Remove(task.Context, track.track.QueueID)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("queue removal")
+	}
+}
+
 // headTask is the function running at the start of the pipeline
 func (s *Streamer) headTask(task streamerTask) error {
 	for {
@@ -263,7 +426,9 @@ func (s *Streamer) headTask(task streamerTask) error {
 		}
 
 		track := streamerTrack{
-			once: new(sync.Once),
+			length: new(trackLength),
+			skip:   newTrackSkip(),
+			once:   new(sync.Once),
 		}
 
 		select {
@@ -287,10 +452,7 @@ func (s *Streamer) tailTask(task streamerTask) error {
 
 		track.once.Do(func() {
 			s.logger.Info().Str("metadata", track.track.Metadata).Msg("working")
-			_, err := s.queue.Remove(task.Context, track.track.QueueID)
-			if err != nil {
-				s.logger.Error().Err(err).Msg("queue removal")
-			}
+			s.removeFromQueue(task, track)
 
 			select {
 			case task.out <- streamerTrack{}:
@@ -305,8 +467,14 @@ func (s *Streamer) queueFiles(task streamerTask) error {
 		s.queue.ResetReserved(context.Background())
 	}()
 
+	var jingles = jingleState{last: time.Now()}
+	// pending is the entry we reserved but held back to play a jingle first
+	var pending *radio.QueueEntry
+
 	for {
 		var track streamerTrack
+		var entry *radio.QueueEntry
+		var err error
 
 		select {
 		case track = <-task.in:
@@ -314,10 +482,34 @@ func (s *Streamer) queueFiles(task streamerTask) error {
 			return nil
 		}
 
-		entry, err := s.queue.ReserveNext(task.Context)
-		if err != nil {
-			return err
+		held := pending != nil
+		if held {
+			entry, pending = pending, nil
+		} else {
+			entry, err = s.queue.ReserveNext(task.Context)
+			if err != nil {
+				return err
+			}
+		}
+
+		// a held entry already had its jingle played before it
+		if rules := s.jingleRules(); !held && jingles.due(rules, *entry) {
+			path, err := rules.pick()
+			if err == nil {
+				jingles.reset()
+				pending = entry
+
+				select {
+				case task.out <- newJingleTrack(track, path):
+				case <-task.Done():
+					return nil
+				}
+				continue
+			}
+			s.logger.Error().Err(err).Msg("failed to pick jingle")
 		}
+		jingles.songs++
+
 		track.track = *entry
 
 		track.filepath = util.AbsolutePath(s.Conf().MusicPath, track.track.FilePath)
@@ -341,12 +533,13 @@ func (s *Streamer) decodeFiles(task streamerTask) error {
 			return nil
 		}
 
-		track.pcm, err = audio.DecodeFileGain(track.filepath)
+		track.pcm, err = s.decode(task, track)
 		if err != nil {
 			s.logger.Error().Err(err).Str("metadata", track.track.Metadata).Msg("")
 			s.errored(task, track)
 			continue
 		}
+		track.pcm = s.trimSilence(task, track, track.pcm)
 		track.mp3 = audio.NewMP3Buffer()
 
 		select {
@@ -360,8 +553,73 @@ func (s *Streamer) decodeFiles(task streamerTask) error {
 		// next function in the pipeline
 		_ = track.pcm.Wait()
 		// set the expected length of the mp3 output
-		track.mp3.SetCap(track.pcm.Length())
+		track.length.setDecoded(track.mp3, track.pcm.Length())
+	}
+}
+
+// decode decodes the track given, if the track has a stored loudness analysis
+// it is used instead of analyzing the file again, otherwise the analysis is
+// stored for the next time. Only the part between the stored cue points is
+// decoded
+func (s *Streamer) decode(task streamerTask, track streamerTrack) (*audio.PCMBuffer, error) {
+	var loudness radio.Loudness
+	var cue audio.Cue
+
+	if track.track.HasTrack() {
+		loudness = track.track.Loudness
+		cue = audio.Cue{In: track.track.CueIn, Out: track.track.CueOut}
+	}
+
+	if loudness.IsZero() {
+		var err error
+		loudness, err = audio.AnalyzeLoudness(task.Context, track.filepath)
+		if err != nil {
+			return nil, err
+		}
+
+		if track.track.HasTrack() {
+			err = s.storage.Track(task.Context).UpdateLoudness(track.track.TrackID, loudness)
+			if err != nil {
+				s.logger.Error().Err(err).Str("metadata", track.track.Metadata).Msg("failed to store loudness")
+			}
+		}
 	}
+	return audio.DecodeFileLoudness(track.filepath, loudness, cue)
+}
+
+// trimSilence returns pcm with its silence trimmed if the track has no cue
+// points stored yet, the cue points found are stored once the whole track
+// has been decoded
+func (s *Streamer) trimSilence(task streamerTask, track streamerTrack, pcm *audio.PCMBuffer) *audio.PCMBuffer {
+	cfg := s.Conf().Streamer.Silence
+	if cfg.MinDuration <= 0 || !track.track.HasTrack() || track.track.CueOut > 0 {
+		return pcm
+	}
+
+	trimmer := audio.NewSilenceTrimmer(pcm.Reader(), s.AudioFormat,
+		audio.ThresholdFromDB(cfg.Threshold), time.Duration(cfg.MinDuration))
+	out := audio.NewPCMBuffer(s.AudioFormat)
+
+	go func() { // exit when decoding finishes
+		_, err := out.ReadFrom(trimmer)
+		if err != nil {
+			out.SetError(err)
+			return
+		}
+		out.Close()
+
+		cue, ok := trimmer.Cue()
+		if !ok {
+			return
+		}
+
+		err = s.storage.Track(task.Context).UpdateCue(track.track.TrackID, cue.In, cue.Out)
+		if err != nil {
+			s.logger.Error().Err(err).Str("metadata", track.track.Metadata).Msg("failed to store cue points")
+		}
+	}()
+
+	return out
 }
 
 func (s *Streamer) encodeToMP3(task streamerTask) error {
@@ -370,6 +628,12 @@ func (s *Streamer) encodeToMP3(task streamerTask) error {
 	var enc *audio.LAME
 	var err error
 
+	// next is set when we've received the next track early to mix its start
+	// into the end of the previous track, nextPCM is the reader to continue
+	// from for the rest of said track
+	var next *streamerTrack
+	var nextPCM io.Reader
+
 	defer func() {
 		// free our encoder when we're exiting
 		if enc != nil {
@@ -379,11 +643,18 @@ func (s *Streamer) encodeToMP3(task streamerTask) error {
 
 	for {
 		var track streamerTrack
+		var pcm io.Reader
 
-		select {
-		case track = <-task.in:
-		case <-task.Done():
-			return nil
+		if next != nil {
+			track, pcm = *next, nextPCM
+			next, nextPCM = nil, nil
+		} else {
+			select {
+			case track = <-task.in:
+			case <-task.Done():
+				return nil
+			}
+			pcm = track.pcm.Reader()
 		}
 
 		// handle any leftover data we overwrote into the previous track buffer
@@ -398,11 +669,38 @@ func (s *Streamer) encodeToMP3(task streamerTask) error {
 			}
 		}
 
-		pcm := track.pcm.Reader()
 		// clear the pcm buffer reference so that it can be gc'd sooner,
 		// the rest of the pipeline does not need it anymore
 		track.pcm = nil
 
+		// wrap the reader if we want to transition into the next track, this
+		// holds back the end of the track until we have the next one
+		fade := s.newTransition()
+		if fade.enabled() && !fade.skip(track) {
+			pcm = fade.reader(pcm, func() ([]byte, error) {
+				var nt streamerTrack
+
+				select {
+				case nt = <-task.in:
+				case <-task.Done():
+					return nil, task.Err()
+				}
+
+				head, r, consumed := fade.head(nt, nt.pcm.Reader())
+				nt.length.setConsumed(nt.mp3, s.AudioFormat.Duration(int64(consumed)))
+				nt.head = head
+				nt.pcm = nil
+				next, nextPCM = &nt, r
+				return head, nil
+			})
+		}
+
+		// copy the audio we encode for any extra mounts
+		if len(s.mounts) > 0 {
+			track.fanout = audio.NewPCMBuffer(s.AudioFormat)
+			pcm = io.TeeReader(pcm, track.fanout)
+		}
+
 		// send over the track concurrently so that we can encode the track
 		// before it starts playing
 		send := make(chan bool)
@@ -456,6 +754,9 @@ func (s *Streamer) encodeToMP3(task streamerTask) error {
 		}
 
 		_ = track.mp3.Close()
+		if track.fanout != nil {
+			_ = track.fanout.Close()
+		}
 		// the buffer used only accepts data that makes a full mp3 frame, and
 		// keeps an internal buffer for data that started a frame but didn't
 		// finish it yet. Since we're going to swap to a different buffer after
@@ -483,23 +784,18 @@ func (s *Streamer) encodeToMP3(task streamerTask) error {
 	}
 }
 
-func (s *Streamer) newIcecastConn(streamurl string) (conn net.Conn, err error) {
-	return icecast.Dial(context.TODO(), streamurl,
-		icecast.ContentType("audio/mpeg"),
-		icecast.UserAgent(s.Conf().UserAgent),
-	)
-}
-
-func (s *Streamer) streamToIcecast(task streamerTask) error {
+func (s *Streamer) streamToSink(task streamerTask) error {
 	var buf = make([]byte, bufferMP3Size)
 	var bufferEnd time.Time
 	var bufferLen = time.Second * 2
 	var track streamerTrack
-	var conn net.Conn
+	var conn io.WriteCloser
+	// skipped is set if the previous track was skipped
+	var skipped bool
 
-	// setup helpers for backoff handling of the icecast connection
+	// setup helpers for backoff handling of the sink connection
 	var newConn = func() error {
-		c, err := s.newIcecastConn(s.Conf().Streamer.StreamURL)
+		c, err := s.sink.Connect(task.Context)
 		if err != nil {
 			s.logger.Error().Err(err).Msg("newconn failure")
 			return err
@@ -509,6 +805,12 @@ func (s *Streamer) streamToIcecast(task streamerTask) error {
 	}
 	backOff := config.NewConnectionBackoff(task.Context)
 
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+
 	for {
 		select {
 		case track = <-task.in:
@@ -523,13 +825,33 @@ func (s *Streamer) streamToIcecast(task streamerTask) error {
 		}
 
 		var mp3 = track.mp3.Reader()
+		var r io.Reader = mp3
 		var progress time.Duration
 		var err error
 
+		if skipped && len(track.head) > 0 {
+			// the start of this track was mixed into the end of the track
+			// we skipped, so play it by itself first
+			intro, err := s.encodeHead(track.head)
+			if err != nil {
+				s.logger.Error().Err(err).Msg("failed to encode start of track")
+			}
+			r = io.MultiReader(bytes.NewReader(intro), mp3)
+			bufferEnd = bufferEnd.Add(s.AudioFormat.Duration(int64(len(track.head))))
+		}
+
+		s.startPlaying(track.skip)
+		skipped = false
 		for atomic.LoadInt32(&s.forceDone) == 0 {
+			if track.skip.skipped() {
+				// someone wants the current track skipped
+				skipped = true
+				break
+			}
+
 			if conn == nil {
 				err = backoff.RetryNotify(newConn, backOff, func(err error, d time.Duration) {
-					s.logger.Error().Err(err).Dur("backoff", d).Msg("icecast connection failure")
+					s.logger.Error().Err(err).Dur("backoff", d).Msg("sink connection failure")
 				})
 				if err != nil {
 					return err
@@ -543,7 +865,7 @@ func (s *Streamer) streamToIcecast(task streamerTask) error {
 				}
 			}
 
-			n, err := mp3.Read(buf)
+			n, err := r.Read(buf)
 			if err != nil && n == 0 {
 				break
 			}
@@ -560,7 +882,7 @@ func (s *Streamer) streamToIcecast(task streamerTask) error {
 			if err != nil {
 				conn.Close()
 				conn = nil
-				s.logger.Error().Err(err).Msg("icecast connection failure")
+				s.logger.Error().Err(err).Msg("sink connection failure")
 				continue
 			}
 
@@ -568,17 +890,29 @@ func (s *Streamer) streamToIcecast(task streamerTask) error {
 			// bufferLen duration
 			time.Sleep(time.Until(bufferEnd) - bufferLen)
 		}
+		s.stopPlaying(track.skip, skipped)
 	}
 }
 
-func (s *Streamer) metadataToIcecast(task streamerTask) error {
-	metaFn, err := icecast.Metadata(s.Conf().Streamer.StreamURL,
-		icecast.UserAgent(s.Conf().UserAgent),
-	)
+// encodeHead encodes the start of a track that was meant to be mixed into the
+// previous track, for when it has to be played by itself
+func (s *Streamer) encodeHead(head []byte) ([]byte, error) {
+	enc, err := audio.NewLAME(s.AudioFormat)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer enc.Close()
 
+	out, err := enc.Encode(head)
+	if err != nil {
+		return nil, err
+	}
+	// out is only valid until the next call on the encoder
+	out = bytes.Clone(out)
+	return append(out, enc.Flush()...), nil
+}
+
+func (s *Streamer) metadataToSink(task streamerTask) error {
 	// for retrying the metadata request
 	var boff = config.NewConnectionBackoff(task.Context)
 
@@ -614,9 +948,16 @@ func (s *Streamer) metadataToIcecast(task streamerTask) error {
 			}
 		}
 
+		// jingles keep the metadata of the previous track so that they
+		// aren't seen as a new song by anything listening to the stream
+		if track.jingle {
+			retrying = false
+			continue
+		}
+
 		// use a timeout so we don't hang on a request for ages
 		ctx, cancel := context.WithTimeout(task.Context, time.Second*30)
-		err = metaFn(ctx, track.track.Metadata)
+		err := s.sink.Metadata(ctx, track.track.Metadata)
 		cancel()
 		if err != nil {
 			s.logger.Error().Err(err).Msg("failed to send metadata")
@@ -0,0 +1,316 @@
+package streamer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/streamer/audio"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueFilesJingles(t *testing.T) {
+	cases := []struct {
+		name           string
+		beforeRequests bool
+		everySongs     int
+		requests       bool
+		expected       []string
+	}{
+		{
+			name:           "before requests",
+			beforeRequests: true,
+			requests:       true,
+			expected:       []string{"jingle", "1", "jingle", "2", "jingle", "3"},
+		},
+		{
+			name:       "every songs",
+			everySongs: 2,
+			expected:   []string{"1", "2", "jingle", "3", "4", "jingle", "5"},
+		},
+		{
+			name:     "disabled",
+			requests: true,
+			expected: []string{"1", "2", "3"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			jingleDir := t.TempDir()
+			err := os.WriteFile(filepath.Join(jingleDir, "jingle.mp3"), []byte("jingle"), 0644)
+			require.NoError(t, err)
+
+			cfg := config.TestConfig()
+			conf := cfg.Conf()
+			conf.Streamer.Jingles.Path = jingleDir
+			conf.Streamer.Jingles.BeforeRequests = c.beforeRequests
+			conf.Streamer.Jingles.EverySongs = c.everySongs
+			cfg.StoreConf(conf)
+
+			var mu sync.Mutex
+			var reserved int
+			queue := &mocks.QueueServiceMock{
+				ReserveNextFunc: func(ctx context.Context) (*radio.QueueEntry, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					reserved++
+					return &radio.QueueEntry{
+						Song: radio.Song{
+							Metadata:      strconv.Itoa(reserved),
+							DatabaseTrack: &radio.DatabaseTrack{FilePath: "song.mp3"},
+						},
+						IsUserRequest: c.requests,
+					}, nil
+				},
+				ResetReservedFunc: func(ctx context.Context) error {
+					return nil
+				},
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			s, err := NewStreamer(ctx, cfg, queue, nil)
+			require.NoError(t, err)
+
+			in := make(chan streamerTrack)
+			out := make(chan streamerTrack)
+			done := make(chan error, 1)
+			go func() {
+				done <- s.queueFiles(streamerTask{Context: ctx, in: in, out: out})
+			}()
+
+			var got []string
+			for range c.expected {
+				in <- streamerTrack{once: new(sync.Once)}
+
+				select {
+				case track := <-out:
+					if track.jingle {
+						assert.Equal(t, filepath.Join(jingleDir, "jingle.mp3"), track.filepath)
+						got = append(got, "jingle")
+					} else {
+						got = append(got, track.track.Metadata)
+					}
+				case <-time.After(time.Second * 5):
+					t.Fatal("queueFiles didn't send a track")
+				}
+			}
+			assert.Equal(t, c.expected, got)
+
+			cancel()
+			require.NoError(t, <-done)
+		})
+	}
+}
+
+// markedMP3 returns n frames of testMP3 with marker in the audio data of every
+// frame so that the frames of different tracks can be told apart
+func markedMP3(n int, marker byte) []byte {
+	frames := testMP3(n)
+	for i := 0; i < len(frames); i += 417 {
+		frames[i+100] = marker
+	}
+	return frames
+}
+
+// countFrames returns the amount of frames with marker in data
+func countFrames(data []byte, marker byte) int {
+	var n int
+	for i := 0; i+417 <= len(data); i += 417 {
+		if data[i+100] == marker {
+			n++
+		}
+	}
+	return n
+}
+
+func newMP3Track(frames []byte) streamerTrack {
+	mp3 := audio.NewMP3Buffer()
+	_, _ = mp3.Write(frames)
+	_ = mp3.Close()
+	return streamerTrack{mp3: mp3, skip: newTrackSkip(), once: new(sync.Once)}
+}
+
+// runStreamToSink runs streamToSink with sink as the sink, the returned
+// channel is used to give it tracks
+func runStreamToSink(t *testing.T, sink Sink) (*Streamer, chan<- streamerTrack) {
+	logger := zerolog.Nop()
+	s := &Streamer{
+		Config:  config.TestConfig(),
+		logger:  &logger,
+		sink:    sink,
+		started: 1,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	in := make(chan streamerTrack)
+	out := make(chan streamerTrack)
+	go func() {
+		for range out {
+		}
+	}()
+	go s.streamToSink(streamerTask{Context: ctx, in: in, out: out})
+	return s, in
+}
+
+func TestSkip(t *testing.T) {
+	t.Run("while playing", func(t *testing.T) {
+		sink := &testSink{connect: func() error { return nil }}
+		s, in := runStreamToSink(t, sink)
+
+		in <- newMP3Track(markedMP3(2000, 'a'))
+		require.Eventually(t, func() bool {
+			return countFrames(sink.Written(), 'a') > 0
+		}, time.Second*5, time.Millisecond*10)
+
+		require.NoError(t, s.Skip(context.Background()))
+		in <- newMP3Track(markedMP3(50, 'b'))
+		require.Eventually(t, func() bool {
+			return countFrames(sink.Written(), 'b') == 50
+		}, time.Second*10, time.Millisecond*10, "next track should play")
+		assert.Less(t, countFrames(sink.Written(), 'a'), 2000)
+	})
+
+	t.Run("between tracks", func(t *testing.T) {
+		sink := &testSink{connect: func() error { return nil }}
+		s, in := runStreamToSink(t, sink)
+
+		// nothing is playing yet, so the skip is for the next track
+		require.NoError(t, s.Skip(context.Background()))
+		in <- newMP3Track(markedMP3(50, 'a'))
+		in <- newMP3Track(markedMP3(50, 'b'))
+		require.Eventually(t, func() bool {
+			return countFrames(sink.Written(), 'b') == 50
+		}, time.Second*10, time.Millisecond*10, "next track should play")
+		assert.Zero(t, countFrames(sink.Written(), 'a'))
+	})
+
+	t.Run("after track finished", func(t *testing.T) {
+		var s Streamer
+		ts := newTrackSkip()
+		s.startPlaying(ts)
+		ts.skip()
+		// the track ended by itself before it noticed the skip
+		s.stopPlaying(ts, false)
+
+		next := newTrackSkip()
+		s.startPlaying(next)
+		assert.True(t, next.skipped(), "skip should move to the next track")
+		s.stopPlaying(next, true)
+
+		last := newTrackSkip()
+		s.startPlaying(last)
+		assert.False(t, last.skipped())
+	})
+
+	t.Run("not running", func(t *testing.T) {
+		var s Streamer
+		logger := zerolog.Nop()
+		s.logger = &logger
+		assert.True(t, errors.Is(errors.StreamerNotRunning, s.Skip(context.Background())))
+	})
+}
+
+// loudnessFFmpeg puts an ffmpeg on the PATH that logs its arguments to the file
+// returned and prints the result of a loudness analysis when asked for one
+func loudnessFFmpeg(t *testing.T) string {
+	dir := t.TempDir()
+	log := filepath.Join(dir, "calls")
+	script := `#!/bin/sh
+echo "$@" >> ` + log + `
+case "$*" in
+*print_format=json*)
+	echo '{"input_i":"-20.00","input_tp":"-1.00","input_lra":"5.00","input_thresh":"-30.00","target_offset":"0.50"}' >&2
+	;;
+esac
+`
+	err := os.WriteFile(filepath.Join(dir, "ffmpeg"), []byte(script), 0755)
+	require.NoError(t, err)
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return log
+}
+
+func TestDecodeLoudness(t *testing.T) {
+	measured := radio.Loudness{Integrated: -20, Range: 5, TruePeak: -1, Threshold: -30, Offset: 0.5}
+	stored := radio.Loudness{Integrated: -14, Range: 3, TruePeak: -2, Threshold: -24, Offset: 1}
+
+	cases := []struct {
+		name     string
+		loudness radio.Loudness
+		calls    int
+		updates  []radio.Loudness
+		used     string
+	}{
+		{
+			name:    "unmeasured",
+			calls:   2,
+			updates: []radio.Loudness{measured},
+			used:    "measured_I=-20.00",
+		},
+		{
+			name:     "stored",
+			loudness: stored,
+			calls:    1,
+			used:     "measured_I=-14.00",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			log := loudnessFFmpeg(t)
+
+			var updates []radio.Loudness
+			storage := &mocks.StorageServiceMock{
+				TrackFunc: func(context.Context) radio.TrackStorage {
+					return &mocks.TrackStorageMock{
+						UpdateLoudnessFunc: func(id radio.TrackID, l radio.Loudness) error {
+							assert.Equal(t, radio.TrackID(10), id)
+							updates = append(updates, l)
+							return nil
+						},
+					}
+				},
+			}
+
+			logger := zerolog.Nop()
+			s := &Streamer{
+				Config:  config.TestConfig(),
+				logger:  &logger,
+				storage: storage,
+			}
+
+			track := streamerTrack{
+				filepath: "song.mp3",
+				track: radio.QueueEntry{Song: radio.Song{
+					DatabaseTrack: &radio.DatabaseTrack{TrackID: 10, Loudness: c.loudness},
+				}},
+			}
+			pcm, err := s.decode(streamerTask{Context: context.Background()}, track)
+			require.NoError(t, err)
+			require.NoError(t, pcm.Wait())
+
+			calls, err := os.ReadFile(log)
+			require.NoError(t, err)
+			lines := strings.Split(strings.TrimSpace(string(calls)), "\n")
+			// a stored analysis means we only need a single pass over the file
+			assert.Len(t, lines, c.calls)
+			assert.Contains(t, lines[len(lines)-1], c.used)
+			assert.Equal(t, c.updates, updates)
+		})
+	}
+}
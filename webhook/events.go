@@ -0,0 +1,162 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/util"
+)
+
+// names of the events that can be sent
+const (
+	EventSong      = "song"
+	EventDJ        = "dj"
+	EventThread    = "thread"
+	EventListeners = "listeners"
+)
+
+// SongData is the data of EventSong
+type SongData struct {
+	Metadata string        `json:"metadata"`
+	TrackID  radio.TrackID `json:"track_id,omitempty"`
+	Start    time.Time     `json:"start"`
+	End      time.Time     `json:"end"`
+}
+
+// DJData is the data of EventDJ
+type DJData struct {
+	ID   radio.DJID `json:"id"`
+	Name string     `json:"name"`
+}
+
+// ThreadData is the data of EventThread
+type ThreadData struct {
+	Thread string `json:"thread"`
+}
+
+// ListenersData is the data of EventListeners
+type ListenersData struct {
+	Listeners radio.Listeners `json:"listeners"`
+	// Milestone is the configured milestone that was reached
+	Milestone radio.Listeners `json:"milestone"`
+}
+
+// Watch subscribes to the streams of the manager given and publishes an event
+// for every change, the current values at the time of subscribing are skipped
+func (p *Publisher) Watch(ctx context.Context, m radio.ManagerService) {
+	util.StreamValue(ctx, m.CurrentSong, p.onSong())
+	util.StreamValue(ctx, m.CurrentUser, p.onUser())
+	util.StreamValue(ctx, m.CurrentThread, p.onThread())
+	util.StreamValue(ctx, m.CurrentListeners, p.onListeners())
+}
+
+// onChange returns a callback that calls fn with the value it receives if the
+// key of it is different from the previous value received. The first value is
+// only recorded since it's the state at the time of subscribing
+func onChange[T any, K comparable](key func(T) K, fn func(context.Context, T)) util.StreamCallbackFn[T] {
+	var seen bool
+	var last K
+	return func(ctx context.Context, v T) {
+		k := key(v)
+		if !seen || k == last {
+			seen, last = true, k
+			return
+		}
+		last = k
+		fn(ctx, v)
+	}
+}
+
+func (p *Publisher) onSong() util.StreamCallbackFn[*radio.SongUpdate] {
+	key := func(su *radio.SongUpdate) string {
+		if su == nil {
+			return ""
+		}
+		return su.Metadata
+	}
+
+	return onChange(key, func(ctx context.Context, su *radio.SongUpdate) {
+		if su == nil {
+			return
+		}
+
+		data := SongData{
+			Metadata: su.Metadata,
+			Start:    su.Info.Start,
+			End:      su.Info.End,
+		}
+		if su.HasTrack() {
+			data.TrackID = su.TrackID
+		}
+		p.publish(ctx, EventSong, data)
+	})
+}
+
+func (p *Publisher) onUser() util.StreamCallbackFn[*radio.User] {
+	key := func(u *radio.User) radio.UserID {
+		if u == nil {
+			return 0
+		}
+		return u.ID
+	}
+
+	return onChange(key, func(ctx context.Context, u *radio.User) {
+		var data DJData
+		if u != nil {
+			data.ID, data.Name = u.DJ.ID, u.DJ.Name
+		}
+		p.publish(ctx, EventDJ, data)
+	})
+}
+
+func (p *Publisher) onThread() util.StreamCallbackFn[radio.Thread] {
+	key := func(t radio.Thread) radio.Thread { return t }
+
+	return onChange(key, func(ctx context.Context, t radio.Thread) {
+		p.publish(ctx, EventThread, ThreadData{Thread: t})
+	})
+}
+
+func (p *Publisher) onListeners() util.StreamCallbackFn[radio.Listeners] {
+	var seen bool
+	var prev radio.Listeners
+
+	return func(ctx context.Context, current radio.Listeners) {
+		if !seen {
+			seen, prev = true, current
+			return
+		}
+
+		milestone, ok := crossedMilestone(p.cfg.Conf().Webhook.ListenerMilestones, prev, current)
+		prev = current
+		if !ok {
+			return
+		}
+
+		p.publish(ctx, EventListeners, ListenersData{
+			Listeners: current,
+			Milestone: milestone,
+		})
+	}
+}
+
+// crossedMilestone returns the highest milestone that was reached by going from
+// prev to current listeners
+func crossedMilestone(milestones []int64, prev, current radio.Listeners) (radio.Listeners, bool) {
+	var highest radio.Listeners
+	var ok bool
+	for _, m := range milestones {
+		if prev < m && current >= m && m > highest {
+			highest, ok = m, true
+		}
+	}
+	return highest, ok
+}
+
+func (p *Publisher) publish(ctx context.Context, event string, data any) {
+	err := p.Publish(ctx, event, data)
+	if err != nil {
+		p.logger.Error().Err(err).Str("event", event).Msg("failed to publish webhook")
+	}
+}
@@ -0,0 +1,29 @@
+// Package webhook sends signed JSON payloads to configured endpoints when the
+// state of the manager changes
+package webhook
+
+import (
+	"context"
+
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/storage"
+)
+
+// Execute executes the webhook publisher with the context and configuration given; it
+// returns with any error that occurs; Execution can be interrupted by canceling the
+// context given.
+func Execute(ctx context.Context, cfg config.Config) error {
+	const op errors.Op = "webhook/Execute"
+
+	store, err := storage.Open(ctx, cfg)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	p := NewPublisher(ctx, cfg, store)
+	p.Watch(ctx, cfg.Manager)
+
+	<-ctx.Done()
+	return nil
+}
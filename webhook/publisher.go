@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// SignatureHeader is the header that contains the signature of the payload
+	SignatureHeader = "X-Valkyrie-Signature"
+	// EventHeader is the header that contains the name of the event
+	EventHeader = "X-Valkyrie-Event"
+)
+
+// Payload is the JSON body sent to webhook endpoints
+type Payload struct {
+	// Event is the name of the event
+	Event string `json:"event"`
+	// Time is when the event happened
+	Time time.Time `json:"time"`
+	// Data is the data of the event, the type depends on the event
+	Data any `json:"data"`
+}
+
+// Sign returns the signature of body with the secret given, this is the value
+// of the SignatureHeader
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Publisher sends events to the configured webhook endpoints
+type Publisher struct {
+	cfg     config.Config
+	logger  zerolog.Logger
+	storage radio.WebhookStorageService
+	client  *http.Client
+}
+
+func NewPublisher(ctx context.Context, cfg config.Config, storage radio.WebhookStorageService) *Publisher {
+	return &Publisher{
+		cfg:     cfg,
+		logger:  *zerolog.Ctx(ctx),
+		storage: storage,
+		client:  &http.Client{},
+	}
+}
+
+// Publish sends the event given to every endpoint that wants it, the deliveries
+// happen in the background
+func (p *Publisher) Publish(ctx context.Context, event string, data any) error {
+	const op errors.Op = "webhook/Publisher.Publish"
+
+	body, err := json.Marshal(Payload{
+		Event: event,
+		Time:  time.Now(),
+		Data:  data,
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	for _, endpoint := range p.cfg.Conf().Webhook.Endpoints {
+		if !endpoint.Wants(event) {
+			continue
+		}
+		go p.deliver(ctx, event, endpoint.URL, endpoint.Secret, body)
+	}
+	return nil
+}
+
+// deliver sends body to the url given, retrying with backoff if it fails, and
+// records the outcome in the delivery log
+func (p *Publisher) deliver(ctx context.Context, event, url, secret string, body []byte) radio.WebhookDelivery {
+	cfg := p.cfg.Conf().Webhook
+
+	delivery := radio.WebhookDelivery{
+		Event:     event,
+		URL:       url,
+		Payload:   string(body),
+		CreatedAt: time.Now(),
+	}
+
+	var b backoff.BackOff = backoff.NewExponentialBackOff(
+		backoff.WithInitialInterval(time.Duration(cfg.RetryBackoff)),
+		backoff.WithMultiplier(2),
+		backoff.WithRandomizationFactor(0),
+		backoff.WithMaxElapsedTime(0),
+	)
+	b = backoff.WithContext(backoff.WithMaxRetries(b, uint64(max(cfg.Retries, 0))), ctx)
+
+	err := backoff.RetryNotify(func() error {
+		var err error
+		delivery.Attempts++
+		delivery.StatusCode, err = p.send(ctx, event, url, secret, body)
+		return err
+	}, b, func(err error, d time.Duration) {
+		p.logger.Warn().Err(err).Str("url", url).Dur("backoff", d).Msg("webhook delivery failed")
+	})
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+
+	// record the delivery even if our context was canceled
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*5)
+	defer cancel()
+
+	delivery.ID, err = p.storage.Webhook(ctx).AddDelivery(delivery)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("failed to store webhook delivery")
+	}
+	return delivery
+}
+
+// send does a single delivery attempt and returns the status code received
+func (p *Publisher) send(ctx context.Context, event, url, secret string, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(p.cfg.Conf().Webhook.Timeout))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		// a broken request isn't going to fix itself by retrying
+		return 0, backoff.Permanent(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", p.cfg.Conf().UserAgent)
+	req.Header.Set(EventHeader, event)
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, nil
+	}
+
+	err = fmt.Errorf("unexpected status code: %s", resp.Status)
+	// client errors won't change by retrying, except for rate limits
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return resp.StatusCode, backoff.Permanent(err)
+	}
+	return resp.StatusCode, err
+}
@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPublisher(t *testing.T, stored *[]radio.WebhookDelivery) *Publisher {
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Webhook.Retries = 2
+	c.Webhook.RetryBackoff = config.Duration(time.Millisecond)
+	cfg.StoreConf(c)
+
+	storage := &mocks.StorageServiceMock{
+		WebhookFunc: func(contextMoqParam context.Context) radio.WebhookStorage {
+			return &mocks.WebhookStorageMock{
+				AddDeliveryFunc: func(delivery radio.WebhookDelivery) (radio.WebhookDeliveryID, error) {
+					*stored = append(*stored, delivery)
+					return radio.WebhookDeliveryID(len(*stored)), nil
+				},
+			}
+		},
+	}
+
+	return NewPublisher(context.Background(), cfg, storage)
+}
+
+func TestDeliver(t *testing.T) {
+	secret := "hackme"
+	body := []byte(`{"event":"song"}`)
+
+	t.Run("signed", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, body, got)
+			assert.Equal(t, Sign(secret, body), r.Header.Get(SignatureHeader))
+			assert.Equal(t, EventSong, r.Header.Get(EventHeader))
+		}))
+		defer srv.Close()
+
+		var stored []radio.WebhookDelivery
+		p := newTestPublisher(t, &stored)
+
+		delivery := p.deliver(context.Background(), EventSong, srv.URL, secret, body)
+		assert.True(t, delivery.Success())
+		assert.Equal(t, 1, delivery.Attempts)
+		require.Len(t, stored, 1)
+		assert.Equal(t, string(body), stored[0].Payload)
+	})
+
+	t.Run("retry server error", func(t *testing.T) {
+		var calls atomic.Int64
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}))
+		defer srv.Close()
+
+		var stored []radio.WebhookDelivery
+		p := newTestPublisher(t, &stored)
+
+		delivery := p.deliver(context.Background(), EventSong, srv.URL, secret, body)
+		assert.True(t, delivery.Success())
+		assert.Equal(t, 3, delivery.Attempts)
+		assert.Equal(t, http.StatusOK, delivery.StatusCode)
+	})
+
+	t.Run("give up", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		var stored []radio.WebhookDelivery
+		p := newTestPublisher(t, &stored)
+
+		delivery := p.deliver(context.Background(), EventSong, srv.URL, secret, body)
+		assert.False(t, delivery.Success())
+		assert.Equal(t, 3, delivery.Attempts)
+		assert.NotEmpty(t, delivery.Error)
+		require.Len(t, stored, 1)
+	})
+
+	t.Run("no retry on client error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer srv.Close()
+
+		var stored []radio.WebhookDelivery
+		p := newTestPublisher(t, &stored)
+
+		delivery := p.deliver(context.Background(), EventSong, srv.URL, secret, body)
+		assert.False(t, delivery.Success())
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, http.StatusNotFound, delivery.StatusCode)
+	})
+}
+
+func TestCrossedMilestone(t *testing.T) {
+	milestones := []int64{100, 250, 500}
+
+	cases := []struct {
+		name      string
+		prev      radio.Listeners
+		current   radio.Listeners
+		milestone radio.Listeners
+		ok        bool
+	}{
+		{"below", 50, 99, 0, false},
+		{"reached", 99, 100, 100, true},
+		{"already above", 100, 120, 0, false},
+		{"going down", 260, 240, 0, false},
+		{"multiple", 90, 300, 250, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			milestone, ok := crossedMilestone(milestones, c.prev, c.current)
+			assert.Equal(t, c.ok, ok)
+			assert.Equal(t, c.milestone, milestone)
+		})
+	}
+}
+
+func TestOnChange(t *testing.T) {
+	var got []string
+	fn := onChange(func(s string) string { return s }, func(_ context.Context, s string) {
+		got = append(got, s)
+	})
+
+	for _, v := range []string{"initial", "initial", "second", "second", "third"} {
+		fn(context.Background(), v)
+	}
+	assert.Equal(t, []string{"second", "third"}, got)
+}
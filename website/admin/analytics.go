@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+)
+
+const (
+	analyticsDefaultDays = 30
+	analyticsSongLimit   = 50
+)
+
+type AnalyticsInput struct {
+	middleware.Input
+
+	// Days is the amount of days the retention is calculated over
+	Days  int
+	DJs   []radio.DJRetention
+	Songs []radio.SongRetention
+}
+
+func (AnalyticsInput) TemplateBundle() string {
+	return "analytics"
+}
+
+func NewAnalyticsInput(ss radio.SongStorage, r *http.Request) (*AnalyticsInput, error) {
+	const op errors.Op = "website/admin.NewAnalyticsInput"
+
+	days := analyticsDefaultDays
+	if raw := r.FormValue("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			return nil, errors.E(op, errors.InvalidForm, "invalid amount of days")
+		}
+		days = parsed
+	}
+	since := time.Now().AddDate(0, 0, -days)
+
+	djs, err := ss.RetentionByDJ(since)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	songs, err := ss.RetentionBySong(since, analyticsSongLimit)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return &AnalyticsInput{
+		Input: middleware.InputFromRequest(r),
+		Days:  days,
+		DJs:   djs,
+		Songs: songs,
+	}, nil
+}
+
+func (s *State) GetAnalytics(w http.ResponseWriter, r *http.Request) {
+	input, err := NewAnalyticsInput(s.Storage.Song(r.Context()), r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
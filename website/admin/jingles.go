@@ -0,0 +1,214 @@
+package admin
+
+import (
+	"html/template"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/gorilla/csrf"
+	"github.com/spf13/afero"
+)
+
+// jingleMaxSize is the maximum size of an uploaded jingle
+const jingleMaxSize = 32 * 1024 * 1024
+
+type JinglesInput struct {
+	middleware.Input
+	CSRFTokenInput template.HTML
+
+	Jingles []JingleFile
+}
+
+func (JinglesInput) TemplateBundle() string {
+	return "jingles"
+}
+
+// JingleFile is a single file in the jingle pool
+type JingleFile struct {
+	Name    string
+	Size    int64
+	ModTime time.Time
+}
+
+func NewJinglesInput(fsys afero.Fs, r *http.Request) (*JinglesInput, error) {
+	const op errors.Op = "website/admin.NewJinglesInput"
+
+	entries, err := afero.ReadDir(fsys, "/")
+	if err != nil && !errors.IsE(err, os.ErrNotExist) {
+		return nil, errors.E(op, err)
+	}
+
+	var jingles []JingleFile
+	for _, fi := range entries {
+		if !fi.Mode().IsRegular() || strings.HasPrefix(fi.Name(), ".") {
+			continue
+		}
+		jingles = append(jingles, JingleFile{
+			Name:    fi.Name(),
+			Size:    fi.Size(),
+			ModTime: fi.ModTime(),
+		})
+	}
+	sort.Slice(jingles, func(i, j int) bool {
+		return jingles[i].Name < jingles[j].Name
+	})
+
+	input := &JinglesInput{
+		Input:          middleware.InputFromRequest(r),
+		CSRFTokenInput: csrf.TemplateField(r),
+		Jingles:        jingles,
+	}
+	return input, nil
+}
+
+// jingleFS returns the filesystem of the jingle pool, it returns an error if
+// no jingle path is configured since an empty path would otherwise resolve to
+// our working directory
+func (s *State) jingleFS() (afero.Fs, error) {
+	const op errors.Op = "website/admin.jingleFS"
+
+	path := s.Conf().Streamer.Jingles.Path
+	if path == "" {
+		return nil, errors.E(op, errors.InvalidArgument, "no jingle path configured")
+	}
+	return afero.NewBasePathFs(s.FS, path), nil
+}
+
+func (s *State) GetJingles(w http.ResponseWriter, r *http.Request) {
+	fsys, err := s.jingleFS()
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	input, err := NewJinglesInput(fsys, r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+func (s *State) PostJingles(w http.ResponseWriter, r *http.Request) {
+	err := s.postJingles(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	s.GetJingles(w, r)
+}
+
+// postJingles adds the uploaded jingle to the pool
+func (s *State) postJingles(r *http.Request) error {
+	const op errors.Op = "website/admin.postJingles"
+
+	fsys, err := s.jingleFS()
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	err = r.ParseMultipartForm(16 * 1024)
+	if err != nil {
+		return errors.E(op, errors.InvalidForm, err)
+	}
+
+	files := r.MultipartForm.File["jingle"]
+	if len(files) == 0 {
+		return errors.E(op, errors.InvalidForm, "no jingle in form")
+	}
+	header := files[0]
+
+	if header.Size > jingleMaxSize {
+		return errors.E(op, errors.InvalidForm, "jingle is too large")
+	}
+
+	name, err := jingleName(header.Filename)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	in, err := header.Open()
+	if err != nil {
+		return errors.E(op, errors.InternalServer, err)
+	}
+	defer in.Close()
+
+	err = fsys.MkdirAll("/", 0775)
+	if err != nil {
+		return errors.E(op, errors.InternalServer, err)
+	}
+
+	out, err := fsys.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0664)
+	if err != nil {
+		return errors.E(op, errors.InternalServer, err)
+	}
+
+	_, err = io.Copy(out, in)
+	if err != nil {
+		out.Close()
+		_ = fsys.Remove(name)
+		return errors.E(op, errors.InternalServer, err)
+	}
+
+	err = out.Close()
+	if err != nil {
+		return errors.E(op, errors.InternalServer, err)
+	}
+	return nil
+}
+
+func (s *State) PostJinglesRemove(w http.ResponseWriter, r *http.Request) {
+	err := s.postJinglesRemove(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	s.GetJingles(w, r)
+}
+
+// postJinglesRemove removes a jingle from the pool
+func (s *State) postJinglesRemove(r *http.Request) error {
+	const op errors.Op = "website/admin.postJinglesRemove"
+
+	fsys, err := s.jingleFS()
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	name, err := jingleName(r.FormValue("name"))
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	err = fsys.Remove(name)
+	if err != nil {
+		return errors.E(op, errors.InternalServer, err)
+	}
+	return nil
+}
+
+// jingleName returns the filename to use for a jingle named name, it returns
+// an error if the name can't be used
+func jingleName(name string) (string, error) {
+	const op errors.Op = "website/admin.jingleName"
+
+	name = filepath.Base(filepath.Clean("/" + name))
+	if name == "/" || strings.HasPrefix(name, ".") {
+		return "", errors.E(op, errors.InvalidForm, "invalid jingle name")
+	}
+	return name, nil
+}
@@ -0,0 +1,115 @@
+package admin
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newJingleState(t *testing.T) (State, afero.Fs) {
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Streamer.Jingles.Path = "/radio/jingles"
+	cfg.StoreConf(c)
+
+	fs := afero.NewMemMapFs()
+	return State{Config: cfg, FS: fs}, fs
+}
+
+func TestPostJingles(t *testing.T) {
+	state, fs := newJingleState(t)
+
+	contents := []byte("a jingle")
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, err := mw.CreateFormFile("jingle", "../../station id.mp3")
+	require.NoError(t, err)
+	_, err = fw.Write(contents)
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/jingles", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	require.NoError(t, state.postJingles(req))
+
+	// the upload should end up in the jingle path, even with a sneaky name
+	got, err := afero.ReadFile(fs, "/radio/jingles/station id.mp3")
+	if assert.NoError(t, err) {
+		assert.Equal(t, contents, got)
+	}
+
+	// and the listing should contain it
+	fsys, err := state.jingleFS()
+	require.NoError(t, err)
+	input, err := NewJinglesInput(fsys, req)
+	require.NoError(t, err)
+	if assert.Len(t, input.Jingles, 1) {
+		assert.Equal(t, "station id.mp3", input.Jingles[0].Name)
+		assert.EqualValues(t, len(contents), input.Jingles[0].Size)
+	}
+}
+
+func TestPostJinglesRemove(t *testing.T) {
+	state, fs := newJingleState(t)
+
+	require.NoError(t, afero.WriteFile(fs, "/radio/jingles/id.mp3", []byte("jingle"), 0664))
+	require.NoError(t, afero.WriteFile(fs, "/radio/important", []byte("not a jingle"), 0664))
+
+	remove := func(name string) error {
+		form := url.Values{"name": {name}}
+		req := httptest.NewRequest(http.MethodPost, "/admin/jingles/remove", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return state.postJinglesRemove(req)
+	}
+
+	// names outside of the jingle path shouldn't resolve to anything
+	assert.Error(t, remove("../important"))
+	ok, err := afero.Exists(fs, "/radio/important")
+	require.NoError(t, err)
+	assert.True(t, ok, "file outside of jingle path was removed")
+
+	assert.Error(t, remove(""))
+
+	require.NoError(t, remove("id.mp3"))
+	ok, err = afero.Exists(fs, "/radio/jingles/id.mp3")
+	require.NoError(t, err)
+	assert.False(t, ok, "jingle should no longer exist")
+}
+
+func TestJinglesWithoutPath(t *testing.T) {
+	state, fs := newJingleState(t)
+	c := state.Conf()
+	c.Streamer.Jingles.Path = ""
+	state.StoreConf(c)
+
+	// an empty path would resolve to the working directory, so nothing
+	// should be listed or removed from there
+	require.NoError(t, afero.WriteFile(fs, "important", []byte("not a jingle"), 0664))
+
+	_, err := state.jingleFS()
+	assert.Error(t, err)
+
+	form := url.Values{"name": {"important"}}
+	req := httptest.NewRequest(http.MethodPost, "/admin/jingles/remove", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	assert.Error(t, state.postJinglesRemove(req))
+
+	ok, err := afero.Exists(fs, "important")
+	require.NoError(t, err)
+	assert.True(t, ok, "file in the working directory was removed")
+
+	rr := httptest.NewRecorder()
+	state.GetJingles(rr, httptest.NewRequest(http.MethodGet, "/admin/jingles", nil))
+	assert.NotEqual(t, http.StatusOK, rr.Code)
+}
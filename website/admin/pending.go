@@ -275,6 +275,17 @@ func (s *State) postPendingDoReplace(w http.ResponseWriter, r *http.Request, for
 		return form, errors.E(op, err, errors.InternalServer)
 	}
 
+	// the file changed so any stored loudness analysis and cue points are
+	// no longer valid
+	err = ts.UpdateLoudness(track.TrackID, radio.Loudness{})
+	if err != nil {
+		return form, errors.E(op, err, errors.InternalServer)
+	}
+	err = ts.UpdateCue(track.TrackID, 0, 0)
+	if err != nil {
+		return form, errors.E(op, err, errors.InternalServer)
+	}
+
 	// commit
 	if err = tx.Commit(); err != nil {
 		return form, errors.E(op, err, errors.InternalServer)
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
@@ -202,6 +203,17 @@ func TestPostPending(t *testing.T) {
 					GetFunc: func(id radio.TrackID) (*radio.Song, error) {
 						return test.GetRet, test.GetErr
 					},
+					UpdateLoudnessFunc: func(id radio.TrackID, loudness radio.Loudness) error {
+						// replacements should clear the analysis of the old file
+						assert.True(t, loudness.IsZero())
+						return nil
+					},
+					UpdateCueFunc: func(id radio.TrackID, in, out time.Duration) error {
+						// and the cue points
+						assert.Zero(t, in)
+						assert.Zero(t, out)
+						return nil
+					},
 				}, mocks.NotUsedTx(t), nil
 			}
 
@@ -123,6 +123,11 @@ type ProfileInput struct {
 	middleware.Input
 
 	Form ProfileForm
+	// StreamKeys are the stream keys of the user
+	StreamKeys []radio.StreamKey
+	// NewStreamKey is a stream key that was just generated, this is the only
+	// time the key itself can be shown since only the hash is stored
+	NewStreamKey string
 }
 
 func (ProfileInput) TemplateBundle() string {
@@ -151,6 +156,23 @@ type ProfilePasswordChangeForm struct {
 
 func (s *State) getProfile(w http.ResponseWriter, r *http.Request) error {
 	const op errors.Op = "website/admin.getProfile"
+
+	toView, err := s.profileUser(r)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	err = s.executeProfile(w, r, toView, "")
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// profileUser returns the user whose profile is being viewed, this is the
+// current user unless an admin asked for another user
+func (s *State) profileUser(r *http.Request) (radio.User, error) {
+	const op errors.Op = "website/admin.profileUser"
 	ctx := r.Context()
 
 	user := middleware.UserFromContext(ctx)
@@ -167,17 +189,30 @@ func (s *State) getProfile(w http.ResponseWriter, r *http.Request) error {
 			// lookup provided username and use that for the form instead
 			other, err := s.Storage.User(ctx).Get(username)
 			if err != nil {
-				return errors.E(op, err)
+				return radio.User{}, errors.E(op, err)
 			}
 			toView = *other
 		}
 	}
+	return toView, nil
+}
+
+// executeProfile renders the profile page of the user given, newKey is a newly
+// generated stream key to show or empty
+func (s *State) executeProfile(w http.ResponseWriter, r *http.Request, toView radio.User, newKey string) error {
+	const op errors.Op = "website/admin.executeProfile"
 
 	input, err := NewProfileInput(toView, r)
 	if err != nil {
 		return errors.E(op, err)
 	}
 
+	input.StreamKeys, err = s.Storage.User(r.Context()).StreamKeys(toView.ID)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	input.NewStreamKey = newKey
+
 	err = s.TemplateExecutor.Execute(w, r, input)
 	if err != nil {
 		return errors.E(op, err)
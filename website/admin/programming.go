@@ -0,0 +1,216 @@
+package admin
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/gorilla/csrf"
+)
+
+// programmingBlockMaxLength is the maximum length of a programming block
+const programmingBlockMaxLength = time.Hour * 24 * 7
+
+type ProgrammingBlockForm struct {
+	middleware.Input
+	CSRFTokenInput template.HTML
+
+	Block radio.ProgrammingBlock
+}
+
+func (ProgrammingBlockForm) TemplateBundle() string {
+	return "schedule"
+}
+
+func (ProgrammingBlockForm) TemplateName() string {
+	return "form_programming_block"
+}
+
+func newProgrammingBlockForms(ps radio.ProgrammingBlockStorage, r *http.Request) ([]ProgrammingBlockForm, error) {
+	const op errors.Op = "website/admin.newProgrammingBlockForms"
+
+	blocks, err := ps.All()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	shared := middleware.InputFromRequest(r)
+	csrfToken := csrf.TemplateField(r)
+	// add an extra empty form at the end for creating a new block
+	forms := make([]ProgrammingBlockForm, len(blocks)+1)
+	for i := range forms {
+		forms[i].Input = shared
+		forms[i].CSRFTokenInput = csrfToken
+		if i < len(blocks) {
+			forms[i].Block = blocks[i]
+		}
+	}
+	return forms, nil
+}
+
+func NewProgrammingBlockForm(r *http.Request) (*ProgrammingBlockForm, error) {
+	const op errors.Op = "website/admin.NewProgrammingBlockForm"
+
+	values := r.PostForm
+
+	var block radio.ProgrammingBlock
+	var err error
+
+	if v := values.Get("id"); v != "" {
+		block.ID, err = radio.ParseProgrammingBlockID(v)
+		if err != nil {
+			return nil, errors.E(op, errors.InvalidForm, err)
+		}
+	}
+	block.Name = strings.TrimSpace(values.Get("name"))
+	block.Weekday = radio.ParseScheduleDay(values.Get("weekday"))
+
+	if v := values.Get("start"); v != "" {
+		start, err := time.Parse("15:04", v)
+		if err != nil {
+			return nil, errors.E(op, errors.InvalidForm, err)
+		}
+		block.Start = time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute
+	}
+	if v := values.Get("length"); v != "" {
+		block.Length, err = time.ParseDuration(v)
+		if err != nil {
+			return nil, errors.E(op, errors.InvalidForm, err)
+		}
+	}
+
+	block.Tags = strings.Fields(values.Get("tags"))
+	for _, artist := range strings.Split(values.Get("artists"), "\n") {
+		if artist = strings.TrimSpace(artist); artist != "" {
+			block.Artists = append(block.Artists, artist)
+		}
+	}
+	for _, v := range strings.FieldsFunc(values.Get("playlist"), isPlaylistSeparator) {
+		id, err := radio.ParseTrackID(v)
+		if err != nil {
+			return nil, errors.E(op, errors.InvalidForm, err)
+		}
+		block.Playlist = append(block.Playlist, id)
+	}
+
+	return &ProgrammingBlockForm{
+		Input:          middleware.InputFromRequest(r),
+		CSRFTokenInput: csrf.TemplateField(r),
+		Block:          block,
+	}, nil
+}
+
+// isPlaylistSeparator returns true for the characters allowed between the
+// track ids of a playlist
+func isPlaylistSeparator(r rune) bool {
+	return r == ',' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+}
+
+func (pf *ProgrammingBlockForm) Validate() error {
+	const op errors.Op = "website/admin.ProgrammingBlockForm.Validate"
+
+	if pf.Block.Name == "" {
+		return errors.E(op, errors.InvalidForm, errors.Info("name is required"))
+	}
+	if pf.Block.Weekday == radio.UnknownDay {
+		return errors.E(op, errors.InvalidForm, errors.Info("unknown weekday"))
+	}
+	if pf.Block.Length <= 0 || pf.Block.Length > programmingBlockMaxLength {
+		return errors.E(op, errors.InvalidForm, errors.Info("length should be between zero and a week"))
+	}
+	return nil
+}
+
+func (pf *ProgrammingBlockForm) ToValues() url.Values {
+	values := url.Values{}
+	if pf == nil {
+		return values
+	}
+	block := pf.Block
+
+	if block.ID != 0 {
+		values.Set("id", block.ID.String())
+	}
+	values.Set("name", block.Name)
+	values.Set("weekday", block.Weekday.String())
+	values.Set("start", fmt.Sprintf("%02d:%02d", int(block.Start.Hours()), int(block.Start.Minutes())%60))
+	values.Set("length", block.Length.String())
+	values.Set("tags", strings.Join(block.Tags, " "))
+	values.Set("artists", strings.Join(block.Artists, "\n"))
+
+	playlist := make([]string, len(block.Playlist))
+	for i, id := range block.Playlist {
+		playlist[i] = id.String()
+	}
+	values.Set("playlist", strings.Join(playlist, " "))
+	return values
+}
+
+func (s *State) PostScheduleBlock(w http.ResponseWriter, r *http.Request) {
+	form, err := s.postScheduleBlock(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, form)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+// postScheduleBlock creates or updates a programming block, depending on if
+// an id was given in the form
+func (s *State) postScheduleBlock(r *http.Request) (*ProgrammingBlockForm, error) {
+	const op errors.Op = "website/admin.postScheduleBlock"
+
+	err := r.ParseForm()
+	if err != nil {
+		return nil, errors.E(op, errors.InvalidForm, err)
+	}
+
+	form, err := NewProgrammingBlockForm(r)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if err = form.Validate(); err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	ps := s.Storage.ProgrammingBlock(r.Context())
+	if form.Block.ID == 0 {
+		form.Block.ID, err = ps.Create(form.Block)
+	} else {
+		err = ps.Update(form.Block)
+	}
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	form.Block.UpdatedAt = time.Now()
+
+	return form, nil
+}
+
+func (s *State) PostScheduleBlockRemove(w http.ResponseWriter, r *http.Request) {
+	id, err := radio.ParseProgrammingBlockID(r.FormValue("id"))
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.Storage.ProgrammingBlock(r.Context()).Delete(id)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	s.GetSchedule(w, r)
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgrammingBlockForm(t *testing.T) {
+	in := ProgrammingBlockForm{
+		Block: radio.ProgrammingBlock{
+			ID:       5,
+			Name:     "Vocaloid hour",
+			Weekday:  radio.Friday,
+			Start:    time.Hour*20 + time.Minute*30,
+			Length:   time.Hour,
+			Tags:     []string{"vocaloid", "utau"},
+			Artists:  []string{"Hatsune Miku", "Kagamine Rin"},
+			Playlist: []radio.TrackID{10, 20},
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/schedule/block", nil)
+	req.PostForm = in.ToValues()
+
+	out, err := NewProgrammingBlockForm(req)
+	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+	assert.Equal(t, in.Block, out.Block)
+}
+
+func TestProgrammingBlockFormValidate(t *testing.T) {
+	valid := radio.ProgrammingBlock{
+		Name:    "OST sunday",
+		Weekday: radio.Sunday,
+		Length:  time.Hour * 4,
+	}
+	assert.NoError(t, (&ProgrammingBlockForm{Block: valid}).Validate())
+
+	noName := valid
+	noName.Name = ""
+	assert.Error(t, (&ProgrammingBlockForm{Block: noName}).Validate())
+
+	noDay := valid
+	noDay.Weekday = radio.UnknownDay
+	assert.Error(t, (&ProgrammingBlockForm{Block: noDay}).Validate())
+
+	tooLong := valid
+	tooLong.Length = time.Hour * 24 * 8
+	assert.Error(t, (&ProgrammingBlockForm{Block: tooLong}).Validate())
+}
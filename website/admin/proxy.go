@@ -0,0 +1,230 @@
+package admin
+
+import (
+	"bytes"
+	"cmp"
+	"html/template"
+	"net/http"
+	"slices"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/templates"
+	"github.com/R-a-dio/valkyrie/util/sse"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/gorilla/csrf"
+	"github.com/rs/zerolog/hlog"
+)
+
+type ProxyInput struct {
+	middleware.Input
+	CSRFTokenInput template.HTML
+
+	Mounts []ProxyMount
+}
+
+func (ProxyInput) TemplateBundle() string {
+	return "proxy"
+}
+
+// ProxyMount is a mount of the proxy with its sources in priority order and
+// the master servers it sends to
+type ProxyMount struct {
+	Name      string
+	Sources   []radio.ProxySource
+	Upstreams []radio.ProxyUpstream
+}
+
+func NewProxyInput(ps radio.ProxyService, r *http.Request) (*ProxyInput, error) {
+	const op errors.Op = "website/admin.NewProxyInput"
+
+	sources, err := ps.ListSources(r.Context())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	upstreams, err := ps.ListUpstreams(r.Context())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return &ProxyInput{
+		Input:          middleware.InputFromRequest(r),
+		CSRFTokenInput: csrf.TemplateField(r),
+		Mounts:         groupProxySources(sources, upstreams),
+	}, nil
+}
+
+// groupProxySources groups the sources and upstreams by mount, both are
+// expected to be sorted by mount and the sources by priority after that
+func groupProxySources(sources []radio.ProxySource, upstreams []radio.ProxyUpstream) []ProxyMount {
+	var mounts []ProxyMount
+	mount := func(name string) *ProxyMount {
+		i := slices.IndexFunc(mounts, func(m ProxyMount) bool { return m.Name == name })
+		if i < 0 {
+			mounts = append(mounts, ProxyMount{Name: name})
+			i = len(mounts) - 1
+		}
+		return &mounts[i]
+	}
+
+	for _, source := range sources {
+		m := mount(source.MountName)
+		m.Sources = append(m.Sources, source)
+	}
+	for _, upstream := range upstreams {
+		m := mount(upstream.MountName)
+		m.Upstreams = append(m.Upstreams, upstream)
+	}
+
+	slices.SortStableFunc(mounts, func(a, b ProxyMount) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return mounts
+}
+
+func (s *State) GetProxy(w http.ResponseWriter, r *http.Request) {
+	input, err := NewProxyInput(s.Proxy, r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+// GetProxyEvents sends the sources of the proxy as server-sent events every time
+// they change
+func (s *State) GetProxyEvents(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/admin.GetProxyEvents"
+
+	ctx := r.Context()
+	logger := hlog.FromRequest(r)
+
+	stream, err := s.Proxy.Events(ctx)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err), "")
+		return
+	}
+	defer stream.Close()
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("X-Accel-Buffering", "no")
+
+	theme := templates.GetTheme(ctx)
+	input := ProxyInput{
+		Input:          middleware.InputFromRequest(r),
+		CSRFTokenInput: csrf.TemplateField(r),
+	}
+
+	var buf bytes.Buffer
+	for {
+		ev, err := stream.Next()
+		if err != nil {
+			// either the client left or the proxy went away, the client will
+			// reconnect in the second case
+			return
+		}
+
+		// the upstreams aren't part of the event, so ask for them separately
+		upstreams, err := s.Proxy.ListUpstreams(ctx)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to list proxy upstreams")
+		}
+		input.Mounts = groupProxySources(ev.Sources, upstreams)
+
+		buf.Reset()
+		err = s.TemplateExecutor.ExecuteTemplate(ctx, theme, input.TemplateBundle(), "proxy-sources", &buf, input)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to execute proxy template")
+			return
+		}
+
+		_, err = w.Write(sse.Event{
+			Name: ev.Kind.String(),
+			Data: bytes.TrimSpace(buf.Bytes()),
+		}.Encode())
+		if err != nil {
+			return
+		}
+		if err = rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *State) PostProxyKick(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/admin.PostProxyKick"
+
+	id, err := radio.ParseSourceID(r.FormValue("id"))
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err, errors.InvalidForm), "")
+		return
+	}
+
+	err = s.Proxy.KickSource(r.Context(), id)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err), "")
+		return
+	}
+
+	s.GetProxy(w, r)
+}
+
+func (s *State) PostProxyLive(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/admin.PostProxyLive"
+
+	id, err := radio.ParseSourceID(r.FormValue("id"))
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err, errors.InvalidForm), "")
+		return
+	}
+
+	err = s.Proxy.SetLive(r.Context(), id)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err), "")
+		return
+	}
+
+	s.GetProxy(w, r)
+}
+
+func (s *State) PostProxyPriority(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/admin.PostProxyPriority"
+
+	if err := r.ParseForm(); err != nil {
+		s.errorHandler(w, r, errors.E(op, err, errors.InvalidForm), "")
+		return
+	}
+
+	mount := r.PostForm.Get("mount")
+	if mount == "" {
+		s.errorHandler(w, r, errors.E(op, errors.InvalidForm, "no mount in form"), "")
+		return
+	}
+
+	// the ids of the sources in their new order
+	rawOrder := r.PostForm["order"]
+	order := make([]radio.SourceID, 0, len(rawOrder))
+	for _, raw := range rawOrder {
+		id, err := radio.ParseSourceID(raw)
+		if err != nil {
+			s.errorHandler(w, r, errors.E(op, err, errors.InvalidForm), "")
+			return
+		}
+		order = append(order, id)
+	}
+
+	err := s.Proxy.SetPriorities(r.Context(), mount, order)
+	if err != nil {
+		s.errorHandler(w, r, errors.E(op, err), "")
+		return
+	}
+
+	s.GetProxy(w, r)
+}
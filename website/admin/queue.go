@@ -2,7 +2,9 @@ package admin
 
 import (
 	"html/template"
+	"math"
 	"net/http"
+	"strconv"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
@@ -67,3 +69,110 @@ func (s *State) PostQueueRemove(w http.ResponseWriter, r *http.Request) {
 
 	s.GetQueue(w, r)
 }
+
+func (s *State) PostQueueSkip(w http.ResponseWriter, r *http.Request) {
+	err := s.Streamer.Skip(r.Context())
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	s.GetQueue(w, r)
+}
+
+func (s *State) PostQueueMove(w http.ResponseWriter, r *http.Request) {
+	err := s.postQueueMove(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	s.GetQueue(w, r)
+}
+
+// postQueueMove moves a queue entry to a new position in the queue
+func (s *State) postQueueMove(r *http.Request) error {
+	const op errors.Op = "website/admin.postQueueMove"
+
+	id, err := radio.ParseQueueID(r.FormValue("id"))
+	if err != nil {
+		return errors.E(op, errors.InvalidForm, err)
+	}
+
+	position, err := strconv.Atoi(r.FormValue("position"))
+	if err != nil {
+		return errors.E(op, errors.InvalidForm, err)
+	}
+
+	_, err = s.Queue.Move(r.Context(), id, position)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+func (s *State) PostQueuePromote(w http.ResponseWriter, r *http.Request) {
+	err := s.postQueuePromote(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	s.GetQueue(w, r)
+}
+
+// postQueuePromote moves a queue entry to the front of the queue
+func (s *State) postQueuePromote(r *http.Request) error {
+	const op errors.Op = "website/admin.postQueuePromote"
+
+	id, err := radio.ParseQueueID(r.FormValue("id"))
+	if err != nil {
+		return errors.E(op, errors.InvalidForm, err)
+	}
+
+	_, err = s.Queue.Promote(r.Context(), id)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+func (s *State) PostQueueInsert(w http.ResponseWriter, r *http.Request) {
+	err := s.postQueueInsert(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	s.GetQueue(w, r)
+}
+
+// postQueueInsert inserts a track into the queue at the position given, an
+// empty position appends it to the end of the queue
+func (s *State) postQueueInsert(r *http.Request) error {
+	const op errors.Op = "website/admin.postQueueInsert"
+
+	tid, err := radio.ParseTrackID(r.FormValue("trackid"))
+	if err != nil {
+		return errors.E(op, errors.InvalidForm, err)
+	}
+
+	position := math.MaxInt
+	if v := r.FormValue("position"); v != "" {
+		position, err = strconv.Atoi(v)
+		if err != nil {
+			return errors.E(op, errors.InvalidForm, err)
+		}
+	}
+
+	song, err := s.Storage.Track(r.Context()).Get(tid)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	err = s.Queue.InsertAt(r.Context(), *song, position)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package admin
+
+import (
+	"net/http"
+	"path/filepath"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/proxy/recording"
+	"github.com/R-a-dio/valkyrie/util"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/spf13/afero"
+)
+
+// recordingTimeFormat is the format of the start time used in the filename of
+// a downloaded recording
+const recordingTimeFormat = "2006-01-02 1504"
+
+type RecordingsInput struct {
+	middleware.Input
+
+	// DJs are the recordings grouped by the user that was live, admins see
+	// the recordings of everyone and other users only their own
+	DJs []RecordingsDJ
+}
+
+func (RecordingsInput) TemplateBundle() string {
+	return "recordings"
+}
+
+// RecordingsDJ is a user with their recordings, newest first
+type RecordingsDJ struct {
+	UserID     radio.UserID
+	Username   string
+	Recordings []radio.Recording
+}
+
+func NewRecordingsInput(fs afero.Fs, path string, r *http.Request) (*RecordingsInput, error) {
+	const op errors.Op = "website/admin.NewRecordingsInput"
+
+	input := &RecordingsInput{
+		Input: middleware.InputFromRequest(r),
+	}
+
+	recs, err := recording.NewStore(fs, path).List()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	user := middleware.UserFromContext(r.Context())
+	for _, rec := range recs {
+		if !canAccessRecording(user, rec) {
+			continue
+		}
+		input.DJs = groupRecording(input.DJs, rec)
+	}
+	return input, nil
+}
+
+// groupRecording adds rec to the entry of its user in djs
+func groupRecording(djs []RecordingsDJ, rec radio.Recording) []RecordingsDJ {
+	for i := range djs {
+		if djs[i].UserID == rec.UserID {
+			djs[i].Recordings = append(djs[i].Recordings, rec)
+			return djs
+		}
+	}
+	return append(djs, RecordingsDJ{
+		UserID:     rec.UserID,
+		Username:   rec.Username,
+		Recordings: []radio.Recording{rec},
+	})
+}
+
+// canAccessRecording returns true if the user given is allowed to see and
+// download the recording
+func canAccessRecording(user *radio.User, rec radio.Recording) bool {
+	if user == nil {
+		return false
+	}
+	return user.ID == rec.UserID || user.UserPermissions.Has(radio.PermAdmin)
+}
+
+func (s *State) GetRecordings(w http.ResponseWriter, r *http.Request) {
+	input, err := NewRecordingsInput(s.FS, s.Conf().Proxy.Recordings.Path, r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+// GetRecording sends the audio file of a recording
+func (s *State) GetRecording(w http.ResponseWriter, r *http.Request) {
+	const op errors.Op = "website/admin.GetRecording"
+
+	id, err := radio.ParseRecordingID(chi.URLParam(r, "RecordingID"))
+	if err != nil {
+		http.Error(w, "unknown recording", http.StatusNotFound)
+		return
+	}
+
+	store := recording.NewStore(s.FS, s.Conf().Proxy.Recordings.Path)
+	f, rec, err := store.Open(id)
+	if err != nil {
+		if errors.Is(errors.RecordingUnknown, err) {
+			http.Error(w, "unknown recording", http.StatusNotFound)
+			return
+		}
+		s.errorHandler(w, r, errors.E(op, err), "")
+		return
+	}
+	defer f.Close()
+
+	// act like recordings of other users don't exist
+	if !canAccessRecording(middleware.UserFromContext(r.Context()), rec) {
+		http.Error(w, "unknown recording", http.StatusNotFound)
+		return
+	}
+
+	filename := rec.Username + " " + rec.Start.Format(recordingTimeFormat) + filepath.Ext(rec.Filename)
+	util.AddContentDisposition(w, filename)
+	http.ServeContent(w, r, "", rec.End, f)
+}
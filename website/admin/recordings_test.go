@@ -0,0 +1,109 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
+	"github.com/R-a-dio/valkyrie/proxy/recording"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/go-chi/chi/v5"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRecordingState(t *testing.T) (State, *recording.Store) {
+	cfg := config.TestConfig()
+	c := cfg.Conf()
+	c.Proxy.Recordings.Path = "/radio/recordings"
+	cfg.StoreConf(c)
+
+	fs := afero.NewMemMapFs()
+	return State{Config: cfg, FS: fs}, recording.NewStore(fs, c.Proxy.Recordings.Path)
+}
+
+func newRecording(t *testing.T, store *recording.Store, user radio.User, data string) radio.RecordingID {
+	w, err := store.Create(user, "/main.mp3", "audio/mpeg")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return w.ID()
+}
+
+func TestRecordings(t *testing.T) {
+	state, store := newRecordingState(t)
+
+	dj := radio.User{ID: 1, Username: "dj", UserPermissions: radio.UserPermissions{
+		radio.PermActive: {}, radio.PermDJ: {},
+	}}
+	other := radio.User{ID: 2, Username: "other", UserPermissions: radio.UserPermissions{
+		radio.PermActive: {}, radio.PermDJ: {},
+	}}
+	admin := radio.User{ID: 3, Username: "admin", UserPermissions: radio.UserPermissions{
+		radio.PermActive: {}, radio.PermAdmin: {},
+	}}
+
+	djFirst := newRecording(t, store, dj, "first show")
+	otherShow := newRecording(t, store, other, "other show")
+	djSecond := newRecording(t, store, dj, "second show")
+
+	input := func(user radio.User) *RecordingsInput {
+		req := httptest.NewRequest(http.MethodGet, "/admin/recordings", nil)
+		req = middleware.RequestWithUser(req, &user)
+		input, err := NewRecordingsInput(state.FS, state.Conf().Proxy.Recordings.Path, req)
+		require.NoError(t, err)
+		return input
+	}
+
+	// a dj only sees their own recordings
+	djs := input(dj).DJs
+	require.Len(t, djs, 1)
+	assert.Equal(t, "dj", djs[0].Username)
+	if assert.Len(t, djs[0].Recordings, 2) {
+		// newest first
+		assert.Equal(t, djSecond, djs[0].Recordings[0].ID)
+		assert.Equal(t, djFirst, djs[0].Recordings[1].ID)
+	}
+
+	// and an admin sees everyone
+	djs = input(admin).DJs
+	require.Len(t, djs, 2)
+	assert.Equal(t, "dj", djs[0].Username)
+	assert.Len(t, djs[0].Recordings, 2)
+	assert.Equal(t, "other", djs[1].Username)
+	assert.Len(t, djs[1].Recordings, 1)
+
+	download := func(user radio.User, id string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/admin/recordings/"+id, nil)
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("RecordingID", id)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		req = middleware.RequestWithUser(req, &user)
+
+		w := httptest.NewRecorder()
+		state.GetRecording(w, req)
+		return w
+	}
+
+	w := download(dj, djFirst.String())
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "first show", w.Body.String())
+	assert.Contains(t, w.Header().Get("Content-Disposition"), ".mp3")
+
+	w = download(dj, otherShow.String())
+	assert.Equal(t, http.StatusNotFound, w.Code, "recordings of others should be hidden")
+
+	w = download(admin, otherShow.String())
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "other show", w.Body.String())
+
+	w = download(dj, radio.NewRecordingID().String())
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = download(dj, "../../etc/passwd")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -77,6 +77,9 @@ func Route(ctx context.Context, s State) func(chi.Router) {
 		r.HandleFunc("/", s.GetHome)
 		r.Get("/profile", s.GetProfile)
 		r.Post("/profile", s.PostProfile)
+		r.Post("/profile/streamkey", s.PostStreamKey)
+		r.Post("/profile/streamkey/revoke", s.PostStreamKeyRevoke)
+		r.Post("/profile/streamkey/rotate", s.PostStreamKeyRotate)
 		r.Get("/pending", p(radio.PermPendingView, s.GetPending))
 		r.Post("/pending", p(radio.PermPendingEdit, s.PostPending))
 		r.Get("/pending-song/{SubmissionID:[0-9]+}", p(radio.PermPendingView, s.GetPendingSong))
@@ -89,10 +92,28 @@ func Route(ctx context.Context, s State) func(chi.Router) {
 		r.Post("/news/render", p(radio.PermNews, s.PostNewsRender))
 		r.Get("/queue", p(radio.PermQueueEdit, s.GetQueue))
 		r.Post("/queue/remove", p(radio.PermQueueEdit, s.PostQueueRemove))
+		r.Post("/queue/skip", p(radio.PermQueueEdit, s.PostQueueSkip))
+		r.Post("/queue/move", p(radio.PermQueueEdit, s.PostQueueMove))
+		r.Post("/queue/promote", p(radio.PermQueueEdit, s.PostQueuePromote))
+		r.Post("/queue/insert", p(radio.PermQueueEdit, s.PostQueueInsert))
+		r.Get("/jingles", p(radio.PermQueueEdit, s.GetJingles))
+		r.Post("/jingles", p(radio.PermQueueEdit, s.PostJingles))
+		r.Post("/jingles/remove", p(radio.PermQueueEdit, s.PostJinglesRemove))
 		r.Get("/schedule", p(radio.PermScheduleEdit, s.GetSchedule))
 		r.Post("/schedule", p(radio.PermScheduleEdit, s.PostSchedule))
+		r.Post("/schedule/block", p(radio.PermScheduleEdit, s.PostScheduleBlock))
+		r.Post("/schedule/block/remove", p(radio.PermScheduleEdit, s.PostScheduleBlockRemove))
 		r.Get("/tracker", p(radio.PermListenerView, s.GetListeners))
 		r.Post("/tracker/remove", p(radio.PermListenerKick, s.PostRemoveListener))
+		r.Get("/webhooks", p(radio.PermAdmin, s.GetWebhooks))
+		r.Get("/analytics", p(radio.PermListenerView, s.GetAnalytics))
+		r.Get("/proxy", p(radio.PermProxyKick, s.GetProxy))
+		r.Get("/proxy/events", p(radio.PermProxyKick, s.GetProxyEvents))
+		r.Post("/proxy/kick", p(radio.PermProxyKick, s.PostProxyKick))
+		r.Post("/proxy/live", p(radio.PermProxyKick, s.PostProxyLive))
+		r.Post("/proxy/priority", p(radio.PermProxyKick, s.PostProxyPriority))
+		r.Get("/recordings", p(radio.PermDJ, s.GetRecordings))
+		r.Get("/recordings/{RecordingID}", p(radio.PermDJ, s.GetRecording))
 
 		// proxy to the grafana host
 		grafana, _ := url.Parse("http://localhost:3000")
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"fmt"
 	"html/template"
 	"net/http"
 	"net/url"
@@ -17,6 +18,7 @@ type ScheduleInput struct {
 	middleware.Input
 
 	Schedule []ScheduleForm
+	Blocks   []ProgrammingBlockForm
 }
 
 func (ScheduleInput) TemplateBundle() string {
@@ -37,7 +39,7 @@ func scheduleGetUsers(us radio.UserStorage) ([]radio.User, error) {
 	return users, nil
 }
 
-func NewScheduleInput(ss radio.ScheduleStorage, us radio.UserStorage, r *http.Request) (*ScheduleInput, error) {
+func NewScheduleInput(ss radio.ScheduleStorage, us radio.UserStorage, ps radio.ProgrammingBlockStorage, r *http.Request) (*ScheduleInput, error) {
 	const op errors.Op = "website/admin.NewScheduleInput"
 	schedule, err := ss.Latest()
 	if err != nil {
@@ -49,6 +51,11 @@ func NewScheduleInput(ss radio.ScheduleStorage, us radio.UserStorage, r *http.Re
 		return nil, errors.E(op, err)
 	}
 
+	blocks, err := newProgrammingBlockForms(ps, r)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	shared := middleware.InputFromRequest(r)
 	csrfToken := csrf.TemplateField(r)
 	scheduleForms := make([]ScheduleForm, 7)
@@ -64,6 +71,7 @@ func NewScheduleInput(ss radio.ScheduleStorage, us radio.UserStorage, r *http.Re
 	return &ScheduleInput{
 		Input:    shared,
 		Schedule: scheduleForms,
+		Blocks:   blocks,
 	}, nil
 }
 
@@ -73,6 +81,7 @@ func (s *State) GetSchedule(w http.ResponseWriter, r *http.Request) {
 	input, err := NewScheduleInput(
 		s.Storage.Schedule(ctx),
 		s.Storage.User(ctx),
+		s.Storage.ProgrammingBlock(ctx),
 		r,
 	)
 	if err != nil {
@@ -157,6 +166,21 @@ func NewScheduleForm(us radio.UserStorage, user radio.User, r *http.Request) (*S
 	}
 	entry.Text = values.Get("text")
 
+	if v := values.Get("start"); v != "" {
+		start, err := time.Parse("15:04", v)
+		if err != nil {
+			return nil, errors.E(op, errors.InvalidForm, err)
+		}
+		entry.Start = time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute
+	}
+	if v := values.Get("length"); v != "" {
+		length, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, errors.E(op, errors.InvalidForm, err)
+		}
+		entry.Length = length
+	}
+
 	if v := values.Get("owner.id"); v != "" && v != "0" {
 		ownerID, err := radio.ParseDJID(v)
 		if err != nil {
@@ -191,6 +215,9 @@ func (sf *ScheduleForm) Validate() error {
 	if sf.Entry.Weekday == radio.UnknownDay {
 		return errors.E(op, errors.InvalidArgument, errors.Info("unknown weekday"))
 	}
+	if sf.Entry.Length < 0 || sf.Entry.Length > time.Hour*24 {
+		return errors.E(op, errors.InvalidArgument, errors.Info("length should be between zero and a day"))
+	}
 	return nil
 }
 
@@ -205,6 +232,8 @@ func (sf *ScheduleForm) ToValues() url.Values {
 	}
 	values.Set("weekday", sf.Entry.Weekday.String())
 	values.Set("text", sf.Entry.Text)
+	values.Set("start", fmt.Sprintf("%02d:%02d", int(sf.Entry.Start.Hours()), int(sf.Entry.Start.Minutes())%60))
+	values.Set("length", sf.Entry.Length.String())
 	if sf.Entry.Notification {
 		values.Set("notification", "true")
 	}
@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/mocks"
@@ -32,6 +33,12 @@ func TestScheduleForm(t *testing.T) {
 		"Text":         gen.AnyString(),
 		"Notification": gen.Bool(),
 		"Owner":        a.GenForType(reflect.TypeFor[*radio.User]()),
+		"Start": gen.Int64Range(0, 24*60-1).Map(func(m int64) time.Duration {
+			return time.Duration(m) * time.Minute
+		}),
+		"Length": gen.Int64Range(0, 24*60).Map(func(m int64) time.Duration {
+			return time.Duration(m) * time.Minute
+		}),
 	})
 
 	var getByDJIDRet *radio.User
@@ -68,6 +75,8 @@ func TestScheduleForm(t *testing.T) {
 			return assert.Equal(t, a.Text, b.Text) &&
 				assert.Equal(t, a.Weekday, b.Weekday) &&
 				assert.Equal(t, a.Notification, b.Notification) &&
+				assert.Equal(t, a.Start, b.Start) &&
+				assert.Equal(t, a.Length, b.Length) &&
 				assert.EqualExportedValues(t, a.Owner, b.Owner) &&
 				assert.EqualExportedValues(t, user, b.UpdatedBy)
 		}, scheduleEntryGen, a.GenForType(reflect.TypeFor[radio.User]()),
@@ -0,0 +1,182 @@
+package admin
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+)
+
+// streamKeyExpiresFormat is the format of the expires form value, it's the
+// same as a html date input
+const streamKeyExpiresFormat = "2006-01-02"
+
+// PostStreamKey generates a new stream key for the user and shows it on the
+// profile page
+func (s *State) PostStreamKey(w http.ResponseWriter, r *http.Request) {
+	user, key, err := s.postStreamKey(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.executeProfile(w, r, user, key)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+func (s *State) postStreamKey(r *http.Request) (radio.User, string, error) {
+	const op errors.Op = "website/admin.postStreamKey"
+
+	user, err := s.profileUser(r)
+	if err != nil {
+		return user, "", errors.E(op, err)
+	}
+
+	sk := radio.StreamKey{
+		UserID: user.ID,
+		Name:   strings.TrimSpace(r.FormValue("name")),
+		Mount:  strings.ToLower(strings.TrimSpace(r.FormValue("mount"))),
+	}
+
+	if mount := sk.Mount; mount != "" && !strings.HasPrefix(mount, "/") {
+		sk.Mount = "/" + mount
+	}
+
+	if expires := r.FormValue("expires"); expires != "" {
+		t, err := time.Parse(streamKeyExpiresFormat, expires)
+		if err != nil {
+			return user, "", errors.E(op, errors.InvalidForm, err)
+		}
+		sk.ExpiresAt = &t
+	}
+
+	key, err := s.createStreamKey(r, sk)
+	if err != nil {
+		return user, "", errors.E(op, err)
+	}
+	return user, key, nil
+}
+
+// PostStreamKeyRevoke revokes a stream key of the user
+func (s *State) PostStreamKeyRevoke(w http.ResponseWriter, r *http.Request) {
+	user, err := s.postStreamKeyRevoke(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	http.Redirect(w, r, profileURL(user), http.StatusSeeOther)
+}
+
+func (s *State) postStreamKeyRevoke(r *http.Request) (radio.User, error) {
+	const op errors.Op = "website/admin.postStreamKeyRevoke"
+
+	user, err := s.profileUser(r)
+	if err != nil {
+		return user, errors.E(op, err)
+	}
+
+	id, err := radio.ParseStreamKeyID(r.FormValue("id"))
+	if err != nil {
+		return user, errors.E(op, errors.InvalidForm, err)
+	}
+
+	err = s.Storage.User(r.Context()).RevokeStreamKey(user.ID, id)
+	if err != nil {
+		return user, errors.E(op, err)
+	}
+	return user, nil
+}
+
+// PostStreamKeyRotate replaces a stream key of the user with a new key that
+// has the same name, mount and expiry, the old key stops working
+func (s *State) PostStreamKeyRotate(w http.ResponseWriter, r *http.Request) {
+	user, key, err := s.postStreamKeyRotate(r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.executeProfile(w, r, user, key)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
+
+func (s *State) postStreamKeyRotate(r *http.Request) (radio.User, string, error) {
+	const op errors.Op = "website/admin.postStreamKeyRotate"
+
+	user, err := s.profileUser(r)
+	if err != nil {
+		return user, "", errors.E(op, err)
+	}
+
+	id, err := radio.ParseStreamKeyID(r.FormValue("id"))
+	if err != nil {
+		return user, "", errors.E(op, errors.InvalidForm, err)
+	}
+
+	us := s.Storage.User(r.Context())
+	keys, err := us.StreamKeys(user.ID)
+	if err != nil {
+		return user, "", errors.E(op, err)
+	}
+
+	var old *radio.StreamKey
+	for i := range keys {
+		if keys[i].ID == id {
+			old = &keys[i]
+			break
+		}
+	}
+	if old == nil {
+		return user, "", errors.E(op, errors.StreamKeyUnknown)
+	}
+
+	key, err := s.createStreamKey(r, radio.StreamKey{
+		UserID:    user.ID,
+		Name:      old.Name,
+		Mount:     old.Mount,
+		ExpiresAt: old.ExpiresAt,
+	})
+	if err != nil {
+		return user, "", errors.E(op, err)
+	}
+
+	err = us.RevokeStreamKey(user.ID, old.ID)
+	if err != nil {
+		return user, "", errors.E(op, err)
+	}
+	return user, key, nil
+}
+
+// createStreamKey generates a new key and stores it, it returns the key
+func (s *State) createStreamKey(r *http.Request, sk radio.StreamKey) (string, error) {
+	const op errors.Op = "website/admin.createStreamKey"
+
+	key, hash, err := radio.GenerateStreamKey()
+	if err != nil {
+		return "", errors.E(op, errors.InternalServer, err)
+	}
+	sk.Hash = hash
+
+	_, err = s.Storage.User(r.Context()).CreateStreamKey(sk)
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+	return key, nil
+}
+
+// profileURL returns the url of the profile page of the user given
+func profileURL(user radio.User) string {
+	query := url.Values{}
+	query.Set("username", user.Username)
+	return profileFormAction + "?" + query.Encode()
+}
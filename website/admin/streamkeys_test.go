@@ -0,0 +1,116 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStreamKeyState(keys *[]radio.StreamKey) State {
+	us := &mocks.UserStorageMock{
+		StreamKeysFunc: func(id radio.UserID) ([]radio.StreamKey, error) {
+			var res []radio.StreamKey
+			for _, sk := range *keys {
+				if sk.UserID == id {
+					res = append(res, sk)
+				}
+			}
+			return res, nil
+		},
+		CreateStreamKeyFunc: func(sk radio.StreamKey) (radio.StreamKeyID, error) {
+			sk.ID = radio.StreamKeyID(len(*keys) + 1)
+			*keys = append(*keys, sk)
+			return sk.ID, nil
+		},
+		RevokeStreamKeyFunc: func(id radio.UserID, keyID radio.StreamKeyID) error {
+			for i, sk := range *keys {
+				if sk.UserID == id && sk.ID == keyID {
+					*keys = append((*keys)[:i], (*keys)[i+1:]...)
+					return nil
+				}
+			}
+			return errors.E(errors.StreamKeyUnknown)
+		},
+	}
+
+	return State{
+		Storage: &mocks.StorageServiceMock{
+			UserFunc: func(contextMoqParam context.Context) radio.UserStorage {
+				return us
+			},
+		},
+	}
+}
+
+func newStreamKeyRequest(user radio.User, target string, form url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return middleware.RequestWithUser(req, &user)
+}
+
+func TestPostStreamKey(t *testing.T) {
+	user := radio.User{ID: 5, Username: "test"}
+	var keys []radio.StreamKey
+	state := newStreamKeyState(&keys)
+
+	req := newStreamKeyRequest(user, "/admin/profile/streamkey", url.Values{
+		"name":    {"laptop"},
+		"mount":   {"Main.mp3"},
+		"expires": {"2030-01-02"},
+	})
+	_, key, err := state.postStreamKey(req)
+	require.NoError(t, err)
+	require.True(t, radio.IsStreamKey(key))
+
+	require.Len(t, keys, 1)
+	sk := keys[0]
+	assert.Equal(t, user.ID, sk.UserID)
+	assert.Equal(t, "laptop", sk.Name)
+	assert.Equal(t, "/main.mp3", sk.Mount)
+	assert.Equal(t, radio.HashStreamKey(key), sk.Hash)
+	if assert.NotNil(t, sk.ExpiresAt) {
+		assert.Equal(t, time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC), *sk.ExpiresAt)
+	}
+
+	// rotating should keep everything but the key itself
+	req = newStreamKeyRequest(user, "/admin/profile/streamkey/rotate", url.Values{
+		"id": {sk.ID.String()},
+	})
+	_, rotated, err := state.postStreamKeyRotate(req)
+	require.NoError(t, err)
+	require.NotEqual(t, key, rotated)
+
+	require.Len(t, keys, 1)
+	assert.NotEqual(t, sk.ID, keys[0].ID)
+	assert.Equal(t, radio.HashStreamKey(rotated), keys[0].Hash)
+	assert.Equal(t, sk.Name, keys[0].Name)
+	assert.Equal(t, sk.Mount, keys[0].Mount)
+	assert.Equal(t, sk.ExpiresAt, keys[0].ExpiresAt)
+
+	// revoking a key of another user shouldn't work
+	other := radio.User{ID: 6, Username: "other"}
+	req = newStreamKeyRequest(other, "/admin/profile/streamkey/revoke", url.Values{
+		"id": {keys[0].ID.String()},
+	})
+	_, err = state.postStreamKeyRevoke(req)
+	assert.Error(t, err)
+	assert.Len(t, keys, 1)
+
+	req = newStreamKeyRequest(user, "/admin/profile/streamkey/revoke", url.Values{
+		"id": {keys[0].ID.String()},
+	})
+	_, err = state.postStreamKeyRevoke(req)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"net/http"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/R-a-dio/valkyrie/website/shared"
+)
+
+const webhookPageSize = 50
+
+type WebhooksInput struct {
+	middleware.Input
+
+	Deliveries []radio.WebhookDelivery
+	Total      int
+	Page       *shared.Pagination
+}
+
+func (WebhooksInput) TemplateBundle() string {
+	return "webhooks"
+}
+
+func NewWebhooksInput(ws radio.WebhookStorage, r *http.Request) (*WebhooksInput, error) {
+	const op errors.Op = "website/admin.NewWebhooksInput"
+
+	page, offset, err := shared.PageAndOffset(r, webhookPageSize)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	deliveries, err := ws.Deliveries(webhookPageSize, offset)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return &WebhooksInput{
+		Input:      middleware.InputFromRequest(r),
+		Deliveries: deliveries.Entries,
+		Total:      deliveries.Total,
+		Page: shared.NewPagination(
+			page,
+			shared.PageCount(int64(deliveries.Total), webhookPageSize),
+			r.URL,
+		),
+	}, nil
+}
+
+func (s *State) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	input, err := NewWebhooksInput(s.Storage.Webhook(r.Context()), r)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+
+	err = s.TemplateExecutor.Execute(w, r, input)
+	if err != nil {
+		s.errorHandler(w, r, err, "")
+		return
+	}
+}
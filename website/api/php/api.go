@@ -15,6 +15,7 @@ import (
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/policy"
 	"github.com/R-a-dio/valkyrie/search"
 	"github.com/R-a-dio/valkyrie/util"
 	"github.com/R-a-dio/valkyrie/website/middleware"
@@ -220,9 +221,10 @@ func (a *API) getSearch(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// move over the results to sanitized output structs
+	rp := policy.NewRequestPolicy(a.Config)
 	response.Results = make([]searchResponseItem, len(songs))
 	for i := range songs {
-		response.Results[i].fromSong(songs[i])
+		response.Results[i].fromSong(rp, songs[i])
 	}
 
 	err = json.NewEncoder(w).Encode(response)
@@ -253,7 +255,7 @@ type searchResponseItem struct {
 }
 
 // fromSong copies relevant fields from the song given to the response item
-func (sri *searchResponseItem) fromSong(s radio.Song) error {
+func (sri *searchResponseItem) fromSong(rp policy.RequestPolicy, s radio.Song) error {
 	if !s.HasTrack() {
 		// TODO: look at error handling
 		return errors.New("Song without track found in search API")
@@ -271,7 +273,7 @@ func (sri *searchResponseItem) fromSong(s radio.Song) error {
 	} else {
 		sri.LastRequested = s.LastRequested.Unix()
 	}
-	sri.Requestable = s.Requestable()
+	_, sri.Requestable = rp.SongCooldown(s)
 	return nil
 }
 
@@ -301,18 +303,11 @@ func (a *API) getCanRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// TODO(wessie): check if this is the right identifier
-	identifier := r.RemoteAddr
-	userLastRequest, err := a.storage.Request(r.Context()).LastRequest(identifier)
-	if err != nil {
-		return
-	}
-
-	_, ok := radio.CalculateCooldown(
-		time.Duration(a.Conf().UserRequestDelay),
-		userLastRequest,
+	_, ok, err := policy.NewRequestPolicy(a.Config).UserCooldown(
+		a.storage.Request(r.Context()),
+		middleware.RequesterFromRequest(r),
 	)
-	if !ok {
+	if err != nil || !ok {
 		return
 	}
 
@@ -380,7 +375,7 @@ func (a *API) postRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := a.streamer.RequestSong(ctx, song, r.RemoteAddr)
+	err := a.streamer.RequestSong(ctx, song, middleware.RequesterFromRequest(r))
 	if err == nil {
 		response["success"] = "Thank you for making your request!"
 		return
@@ -391,6 +386,8 @@ func (a *API) postRequest(w http.ResponseWriter, r *http.Request) {
 		response["error"] = "That song is still on cooldown, You'll have to wait longer to request it."
 	case errors.Is(errors.UserCooldown, err):
 		response["error"] = "You recently requested a song. You have to wait longer until you can request again."
+	case errors.Is(errors.RequestsLimited, err):
+		response["error"] = "The queue is full right now. You have to wait a bit before requests open up again."
 	case errors.Is(errors.StreamerNoRequests, err):
 		response["error"] = "Requests are disabled currently."
 	default:
@@ -2,11 +2,12 @@ package v1
 
 import (
 	"net/http"
-	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/policy"
 	"github.com/R-a-dio/valkyrie/util"
+	"github.com/R-a-dio/valkyrie/website/middleware"
 	"github.com/R-a-dio/valkyrie/website/public"
 	"github.com/rs/zerolog/hlog"
 )
@@ -21,6 +22,8 @@ func (a *API) PostRequest(w http.ResponseWriter, r *http.Request) {
 			message = "Song is on cooldown"
 		case errors.Is(errors.UserCooldown, err):
 			message = "You can't request yet"
+		case errors.Is(errors.RequestsLimited, err):
+			message = "The queue is full, try again later"
 		case errors.Is(errors.StreamerNoRequests, err):
 			message = "Requests are currently disabled"
 		case errors.Is(errors.InvalidForm, err):
@@ -37,7 +40,7 @@ func (a *API) PostRequest(w http.ResponseWriter, r *http.Request) {
 		a.Search,
 		a.storage.Request(r.Context()),
 		r,
-		time.Duration(a.Config.Conf().UserRequestDelay),
+		policy.NewRequestPolicy(a.Config),
 	)
 	if err != nil {
 		hlog.FromRequest(r).Error().Err(err).Msg("")
@@ -80,7 +83,7 @@ func (a *API) postRequest(r *http.Request) error {
 		return errors.E(op, err, errors.SongUnknown)
 	}
 
-	err = a.streamer.RequestSong(ctx, *song, r.RemoteAddr)
+	err = a.streamer.RequestSong(ctx, *song, middleware.RequesterFromRequest(r))
 	if err != nil {
 		return err
 	}
@@ -2,9 +2,9 @@ package v1
 
 import (
 	"net/http"
-	"time"
 
 	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/policy"
 	"github.com/R-a-dio/valkyrie/website/public"
 	"github.com/rs/zerolog/hlog"
 )
@@ -18,7 +18,7 @@ func (a *API) SearchHTML(w http.ResponseWriter, r *http.Request) {
 		a.Search,
 		a.storage.Request(r.Context()),
 		r,
-		time.Duration(a.Config.Conf().UserRequestDelay),
+		policy.NewRequestPolicy(a.Config),
 		searchPageSize,
 	)
 	if err != nil {
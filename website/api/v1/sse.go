@@ -43,7 +43,6 @@ func (a *API) runStatusUpdates(ctx context.Context) {
 		if !status.Song.EqualTo(previous.Song) {
 			log.Debug().Str("event", EventMetadata).Any("value", status).Msg("sending")
 			a.sse.SendNowPlaying(status)
-			go a.sendQueue(ctx)
 			go a.sendLastPlayed(ctx)
 		}
 
@@ -57,16 +56,13 @@ func (a *API) runStatusUpdates(ctx context.Context) {
 
 		previous = status
 	})
-}
-
-func (a *API) sendQueue(ctx context.Context) {
-	q, err := a.streamer.Queue(ctx)
-	if err != nil {
-		zerolog.Ctx(ctx).Error().Err(err).Str("sse", "queue").Msg("")
-		return
-	}
 
-	a.sse.SendQueue(q)
+	_ = util.StreamValue(ctx, a.queue.Events, func(ctx context.Context, ev radio.QueueEvent) {
+		// every event carries the full queue so we can just pass it through, this
+		// includes the initial event we get when (re)connecting to the stream
+		log.Debug().Str("event", EventQueue).Stringer("kind", ev.Kind).Msg("sending")
+		a.sse.SendQueue(ev.Queue)
+	})
 }
 
 func (a *API) sendLastPlayed(ctx context.Context) {
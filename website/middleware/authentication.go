@@ -390,6 +390,14 @@ func UserFromContext(ctx context.Context) *radio.User {
 	return u
 }
 
+// RequesterFromRequest returns the radio.Requester for the request given, the
+// requester is considered staff if a user with the DJ permission is logged in
+func RequesterFromRequest(r *http.Request) radio.Requester {
+	// TODO(wessie): check if this is the right identifier
+	u, _ := r.Context().Value(userContextKey{}).(*radio.User)
+	return radio.NewRequester(r.RemoteAddr, u)
+}
+
 // RequestWithUser adds a user to a requests context and returns the new updated
 // request after, user can be retrieved by UserFromContext
 func RequestWithUser(r *http.Request, u *radio.User) *http.Request {
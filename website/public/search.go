@@ -8,6 +8,7 @@ import (
 
 	radio "github.com/R-a-dio/valkyrie"
 	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/policy"
 	"github.com/R-a-dio/valkyrie/website/middleware"
 	"github.com/R-a-dio/valkyrie/website/shared"
 	"github.com/gorilla/csrf"
@@ -30,10 +31,10 @@ func (SearchInput) TemplateBundle() string {
 	return "search"
 }
 
-func NewSearchInput(s radio.SearchService, rs radio.RequestStorage, r *http.Request, requestDelay time.Duration) (*SearchInput, error) {
+func NewSearchInput(s radio.SearchService, rs radio.RequestStorage, r *http.Request, rp policy.RequestPolicy) (*SearchInput, error) {
 	const op errors.Op = "website/public.NewSearchInput"
 
-	sharedInput, err := NewSearchSharedInput(s, rs, r, requestDelay, searchPageSize)
+	sharedInput, err := NewSearchSharedInput(s, rs, r, rp, searchPageSize)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -54,7 +55,7 @@ type SearchSharedInput struct {
 	Page            *shared.Pagination
 }
 
-func NewSearchSharedInput(s radio.SearchService, rs radio.RequestStorage, r *http.Request, requestDelay time.Duration, pageSize int64) (*SearchSharedInput, error) {
+func NewSearchSharedInput(s radio.SearchService, rs radio.RequestStorage, r *http.Request, rp policy.RequestPolicy, pageSize int64) (*SearchSharedInput, error) {
 	const op errors.Op = "website/public.NewSearchSharedInput"
 	ctx := r.Context()
 
@@ -69,15 +70,11 @@ func NewSearchSharedInput(s radio.SearchService, rs radio.RequestStorage, r *htt
 		return nil, errors.E(op, err)
 	}
 
-	// TODO(wessie): check if this is the right identifier
-	identifier := r.RemoteAddr
-	lastRequest, err := rs.LastRequest(identifier)
+	cd, ok, err := rp.UserCooldown(rs, middleware.RequesterFromRequest(r))
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
 
-	cd, ok := radio.CalculateCooldown(requestDelay, lastRequest)
-
 	// we also use this input if we're making a request, in which case our url
 	// will be something other than /search that we can't use for the pagination
 	// logic. We can detect this by looking for a trackid argument and changing
@@ -108,7 +105,7 @@ func (s State) GetSearch(w http.ResponseWriter, r *http.Request) {
 		s.Search,
 		s.Storage.Request(r.Context()),
 		r,
-		time.Duration(s.Conf().UserRequestDelay),
+		policy.NewRequestPolicy(s.Config),
 	)
 	if err != nil {
 		s.errorHandler(w, r, err)
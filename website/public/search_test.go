@@ -10,7 +10,9 @@ import (
 	"time"
 
 	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/config"
 	"github.com/R-a-dio/valkyrie/mocks"
+	"github.com/R-a-dio/valkyrie/policy"
 	"github.com/R-a-dio/valkyrie/util/secret"
 	"github.com/go-chi/chi/v5"
 	"github.com/gorilla/csrf"
@@ -32,13 +34,14 @@ func TestNewSearchInput(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/search?q="+query, nil)
 
 	rs := &mocks.RequestStorageMock{
-		LastRequestFunc: func(identifier string) (time.Time, error) {
+		RequestTimesFunc: func(identifier string, since time.Time) ([]time.Time, error) {
 			assert.Equal(t, req.RemoteAddr, identifier)
-			return time.Now().Add(-time.Hour * 12), nil
+			// the last request was 12 hours ago, which is outside of the window
+			return nil, nil
 		},
 	}
 
-	input, err := NewSearchInput(ss, rs, req, time.Hour)
+	input, err := NewSearchInput(ss, rs, req, policy.NewRequestPolicy(config.TestConfig()))
 	require.NoError(t, err)
 	require.NotNil(t, input)
 
@@ -55,14 +58,14 @@ func TestNewSearchSharedInputURLFix(t *testing.T) {
 		},
 	}
 	rs := &mocks.RequestStorageMock{
-		LastRequestFunc: func(identifier string) (time.Time, error) {
-			return time.Now().Add(-time.Hour * 12), nil
+		RequestTimesFunc: func(identifier string, since time.Time) ([]time.Time, error) {
+			return nil, nil
 		},
 	}
 
 	r := httptest.NewRequest(http.MethodPost, "/v1/request?page=5&q=test&trackid=100", nil)
 
-	input, err := NewSearchSharedInput(ss, rs, r, time.Hour, searchPageSize)
+	input, err := NewSearchSharedInput(ss, rs, r, policy.NewRequestPolicy(config.TestConfig()), searchPageSize)
 	require.NoError(t, err)
 	require.NotNil(t, input)
 
@@ -0,0 +1,86 @@
+package public
+
+import (
+	"net/http"
+
+	radio "github.com/R-a-dio/valkyrie"
+	"github.com/R-a-dio/valkyrie/errors"
+	"github.com/R-a-dio/valkyrie/website/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+const songCurveLimit = 10
+
+type SongInput struct {
+	middleware.Input
+
+	Song        radio.Song
+	PlayedCount int64
+	// Retention is the listener retention over all plays of the song
+	Retention radio.ListenerRetention
+	// Curves are the listener curves of the most recent plays
+	Curves []radio.PlayCurve
+}
+
+func (SongInput) TemplateBundle() string {
+	return "song"
+}
+
+func NewSongInput(s radio.SongStorageService, r *http.Request) (*SongInput, error) {
+	const op errors.Op = "website/public.NewSongInput"
+
+	hash, err := radio.ParseSongHash(chi.URLParamFromCtx(r.Context(), "SongHash"))
+	if err != nil {
+		return nil, errors.E(op, err, errors.InvalidArgument)
+	}
+
+	ss := s.Song(r.Context())
+	song, err := ss.FromHash(hash)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	playedCount, err := ss.PlayedCount(*song)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	retention, err := ss.Retention(*song)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	curves, err := ss.ListenerCurves(*song, songCurveLimit)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return &SongInput{
+		Input:       middleware.InputFromRequest(r),
+		Song:        *song,
+		PlayedCount: playedCount,
+		Retention:   retention,
+		Curves:      curves,
+	}, nil
+}
+
+func (s State) getSong(w http.ResponseWriter, r *http.Request) error {
+	input, err := NewSongInput(s.Storage, r)
+	if err != nil {
+		return err
+	}
+
+	return s.Templates.Execute(w, r, input)
+}
+
+func (s State) GetSong(w http.ResponseWriter, r *http.Request) {
+	err := s.getSong(w, r)
+	if err != nil {
+		if errors.Is(errors.SongUnknown, err) {
+			http.Error(w, "unknown song", http.StatusNotFound)
+			return
+		}
+		s.errorHandler(w, r, err)
+		return
+	}
+}
@@ -65,6 +65,7 @@ func Route(ctx context.Context, s State) func(chi.Router) {
 		r.Get("/schedule", s.GetSchedule)
 		r.Get("/queue", s.GetQueue)
 		r.Get("/last-played", s.GetLastPlayed)
+		r.Get("/song/{SongHash:[0-9a-f]{40}}", s.GetSong)
 		r.Get("/search", s.GetSearch)
 		r.Get("/submit", s.GetSubmit)
 		r.Post("/submit", s.PostSubmit)